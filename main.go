@@ -1,6 +1,7 @@
 package main
 
 import (
+	"backend/pkg/utils"
 	"backend/server"
 	"os"
 
@@ -27,6 +28,11 @@ func main() {
 		log.Error("main:err = ", err)
 		os.Exit(1)
 	}
+	err = utils.InitCsrfKeyring()
+	if err != nil {
+		log.Error("main:err = ", err)
+		os.Exit(1)
+	}
 	opts := &server.Options{
 		LogLevel: log.DebugLevel,
 		Testing:  false,
@@ -3,6 +3,10 @@ package main
 import (
 	protoAuth "backend/microservice/auth/proto"
 	sessionRepo "backend/microservice/auth/repository/session"
+	apiTokenRepo "backend/microservice/auth/repository/apiToken"
+	attemptRepo "backend/microservice/auth/repository/attempt"
+	authEventRepo "backend/microservice/auth/repository/authEvent"
+	tokenRepo "backend/microservice/auth/repository/token"
 	userRepo "backend/microservice/auth/repository/user"
 	"backend/pkg/logger"
 	"backend/pkg/utils"
@@ -36,6 +40,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	err = utils.InitCsrfKeyring()
+	if err != nil {
+		log.Error("main:err = ", err)
+		os.Exit(1)
+	}
+
 	port := viper.GetString("auth_port")
 
 	//Подключение постгрес
@@ -63,8 +73,12 @@ func main() {
 
 	authUserRepository := userRepo.NewRepository(postDB)
 	authSessionRepository := sessionRepo.NewRepository(redisDB)
+	authTokenRepository := tokenRepo.NewRepository(redisDB)
+	authAttemptRepository := attemptRepo.NewRepository(redisDB)
+	authApiTokenRepository := apiTokenRepo.NewRepository(postDB)
+	authEventRepository := authEventRepo.NewRepository(postDB)
 
-	authService := usecase.NewService(authUserRepository, authSessionRepository)
+	authService := usecase.NewService(authUserRepository, authSessionRepository, authTokenRepository, authAttemptRepository, authApiTokenRepository, authEventRepository)
 	protoAuth.RegisterAuthServer(server, authService)
 
 	log.Info("started auth microservice on ", port)
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	"time"
+)
+
+type ApiTokenRepository interface {
+	Create(data *authServiceModels.ApiTokenData) (string, error)
+	ListByUser(userId string) ([]*authServiceModels.ApiTokenData, error)
+	GetByHash(tokenHash string) (*authServiceModels.ApiTokenData, error)
+	Delete(userId string, id string) error
+	DeleteAllByUser(userId string) error
+	UpdateLastUsed(id string, lastUsed time.Time) error
+}
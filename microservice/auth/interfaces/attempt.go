@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"time"
+)
+
+type AttemptRepository interface {
+	LockedFor(key string) (time.Duration, error)
+	Fail(key string, window time.Duration) (int64, error)
+	Lock(key string, duration time.Duration) error
+	Reset(key string) error
+}
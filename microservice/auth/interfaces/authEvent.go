@@ -0,0 +1,11 @@
+package interfaces
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+)
+
+type AuthEventRepository interface {
+	Create(data *authServiceModels.AuthEventData) error
+	ListByUser(userId string, limit int) ([]*authServiceModels.AuthEventData, error)
+	Query(filter *authServiceModels.AuthEventFilter) ([]*authServiceModels.AuthEventData, error)
+}
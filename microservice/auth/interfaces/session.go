@@ -5,9 +5,11 @@ import (
 )
 
 type SessionRepository interface {
-	Create(data *authServiceModels.SessionData) error 
+	Create(data *authServiceModels.SessionData) error
 	Check(sessionId string) (string, error)
+	Get(sessionId string) (*authServiceModels.SessionData, error)
+	Refresh(data *authServiceModels.SessionData) error
+	ListByUser(userId string) ([]*authServiceModels.SessionData, error)
 	Delete(sessionId string) error
+	DeleteAllByUser(userId string) error
 }
-
-
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+)
+
+type TokenRepository interface {
+	Create(data *authServiceModels.TokenData) error
+	Use(token string, purpose string) (string, error)
+}
@@ -6,5 +6,19 @@ import (
 
 type UserRepository interface {
 	CreateUser(user *models.User) (string, error)
-	GetUser(mail, password string) (*models.User, error)
+	GetUser(mail string) (*models.User, error)
+	UpdatePassword(userId string, password string) error
+	GetUserById(userId string) (*models.User, error)
+	VerifyEmail(userId string, mail string) error
+	SetTotpSecret(userId string, secret string) error
+	GetTotpSecret(userId string) (string, bool, error)
+	EnableTotp(userId string, recoveryCodeHashes []string) error
+	DisableTotp(userId string) error
+	UseRecoveryCode(userId string, codeHash string) error
+	GetRole(userId string) (string, bool, error)
+	SetRole(userId string, role string) error
+	SetBanned(userId string, banned bool) error
+	GetUserByIdentity(provider string, subject string) (*models.User, error)
+	LinkIdentity(userId string, provider string, subject string) error
+	DeleteUser(userId string) ([]string, error)
 }
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+//Персональный API-токен. Хранится только TokenHash, нулевой ExpiresAt - бессрочный токен
+type ApiTokenData struct {
+	ID         string
+	UserId     string
+	Name       string
+	TokenHash  string
+	Scopes     []string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+}
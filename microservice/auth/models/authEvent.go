@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+//Запись журнала безопасности
+type AuthEventData struct {
+	ID        string
+	UserId    string
+	Type      string
+	Mail      string
+	IP        string
+	UserAgent string
+	CreatedAt time.Time
+}
+
+//Фильтр журнала для администраторов: пустые поля и нулевое время не ограничивают выборку
+type AuthEventFilter struct {
+	UserId string
+	Type   string
+	Mail   string
+	IP     string
+	From   time.Time
+	To     time.Time
+	Limit  int
+}
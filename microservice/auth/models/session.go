@@ -8,4 +8,9 @@ type SessionData struct {
 	SessionId  string
 	UserId     string
 	Expiration time.Duration
-}
\ No newline at end of file
+	CreatedAt  time.Time
+	LastSeen   time.Time
+	UserAgent  string
+	IP         string
+	RememberMe bool
+}
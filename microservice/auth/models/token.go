@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+)
+
+//Одноразовые токены (сброс пароля и т.п.), Purpose разделяет их по назначению
+type TokenData struct {
+	Token      string
+	Purpose    string
+	UserId     string
+	Expiration time.Duration
+}
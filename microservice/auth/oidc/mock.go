@@ -0,0 +1,139 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+)
+
+const (
+	MockClientID     = "mock-client"
+	MockClientSecret = "mock-secret"
+	MockKeyId        = "mock-key"
+)
+
+//MockGrant - выданный провайдером code: с каким code_challenge и nonce он был запрошен
+//и какие claims попадут в ID token
+type MockGrant struct {
+	Challenge     string
+	Nonce         string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// MockServer - локальный OIDC-провайдер для тестов: discovery, JWKS и token endpoint
+type MockServer struct {
+	Server *httptest.Server
+	Key    *rsa.PrivateKey
+
+	mu     sync.Mutex
+	grants map[string]MockGrant
+}
+
+func NewMockServer() (*MockServer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	m := &MockServer{
+		Key:    key,
+		grants: map[string]MockGrant{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, m.discovery)
+	mux.HandleFunc("/keys", m.jwks)
+	mux.HandleFunc("/token", m.token)
+	m.Server = httptest.NewServer(mux)
+	return m, nil
+}
+
+func (m *MockServer) Close() {
+	m.Server.Close()
+}
+
+func (m *MockServer) Config(name string) ProviderConfig {
+	return ProviderConfig{
+		Name:         name,
+		Issuer:       m.Server.URL,
+		ClientID:     MockClientID,
+		ClientSecret: MockClientSecret,
+		RedirectURL:  "http://localhost/auth/oidc/" + name + "/callback",
+	}
+}
+
+func (m *MockServer) Grant(code string, grant MockGrant) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.grants[code] = grant
+}
+
+func (m *MockServer) discovery(w http.ResponseWriter, r *http.Request) {
+	json.NewEncoder(w).Encode(discoveryDocument{
+		Issuer:                m.Server.URL,
+		AuthorizationEndpoint: m.Server.URL + "/authorize",
+		TokenEndpoint:         m.Server.URL + "/token",
+		JwksURI:               m.Server.URL + "/keys",
+	})
+}
+
+func (m *MockServer) jwks(w http.ResponseWriter, r *http.Request) {
+	e := big.NewInt(int64(m.Key.PublicKey.E)).Bytes()
+	json.NewEncoder(w).Encode(map[string][]jsonWebKey{
+		"keys": {{
+			Kid: MockKeyId,
+			Kty: "RSA",
+			Alg: "RS256",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(m.Key.PublicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(e),
+		}},
+	})
+}
+
+func (m *MockServer) token(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	grant, ok := m.grants[r.PostFormValue("code")]
+	delete(m.grants, r.PostFormValue("code"))
+	m.mu.Unlock()
+	if !ok || r.PostFormValue("client_id") != MockClientID || r.PostFormValue("client_secret") != MockClientSecret ||
+		CodeChallenge(r.PostFormValue("code_verifier")) != grant.Challenge {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(tokenResponse{Error: "invalid_grant"})
+		return
+	}
+	idToken, err := m.IdToken(grant, m.Server.URL, MockClientID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	json.NewEncoder(w).Encode(tokenResponse{AccessToken: "access", IdToken: idToken})
+}
+
+// IdToken подписывает ID token ключом сервера; issuer и audience можно подменить в тестах
+func (m *MockServer) IdToken(grant MockGrant, issuer string, audience string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, &idTokenClaims{
+		StandardClaims: jwt.StandardClaims{
+			Issuer:    issuer,
+			Subject:   grant.Subject,
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.At(time.Now()),
+			ExpiresAt: jwt.At(time.Now().Add(time.Minute * 5)),
+		},
+		Nonce:         grant.Nonce,
+		Email:         grant.Email,
+		EmailVerified: grant.EmailVerified,
+		Name:          grant.Name,
+	})
+	token.Header["kid"] = MockKeyId
+	return token.SignedString(m.Key)
+}
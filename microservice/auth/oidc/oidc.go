@@ -0,0 +1,358 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+const (
+	logMessage       = "microservice:auth:oidc:"
+	discoveryPath    = "/.well-known/openid-configuration"
+	httpTimeout      = time.Second * 10
+	maxResponseBytes = 1 << 20
+)
+
+var (
+	ErrProviderNotFound = errors.New("oidc provider not found")
+	ErrDiscovery        = errors.New("oidc discovery failed")
+	ErrExchange         = errors.New("oidc code exchange failed")
+	ErrInvalidIdToken   = errors.New("oidc id token is invalid")
+	ErrUnknownKey       = errors.New("oidc signing key not found")
+)
+
+//Провайдер описывается в секции oidc.providers конфига. Секреты можно не хранить
+//в конфиге, а указать имя переменной окружения (client_secret_env)
+type ProviderConfig struct {
+	Name            string   `mapstructure:"-"`
+	Issuer          string   `mapstructure:"issuer"`
+	DiscoveryURL    string   `mapstructure:"discovery_url"`
+	ClientID        string   `mapstructure:"client_id"`
+	ClientIDEnv     string   `mapstructure:"client_id_env"`
+	ClientSecret    string   `mapstructure:"client_secret"`
+	ClientSecretEnv string   `mapstructure:"client_secret_env"`
+	RedirectURL     string   `mapstructure:"redirect_url"`
+	Scopes          []string `mapstructure:"scopes"`
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	IdToken     string `json:"id_token"`
+	Error       string `json:"error"`
+}
+
+//Claims - то, что нужно из ID token для входа и привязки аккаунта
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	GivenName     string
+	FamilyName    string
+}
+
+type idTokenClaims struct {
+	jwt.StandardClaims
+	Nonce         string      `json:"nonce"`
+	Email         string      `json:"email"`
+	EmailVerified interface{} `json:"email_verified"`
+	Name          string      `json:"name"`
+	GivenName     string      `json:"given_name"`
+	FamilyName    string      `json:"family_name"`
+}
+
+// Provider реализует authorization code flow с PKCE. Discovery-документ и ключи
+// подписи запрашиваются при первом обращении и кэшируются
+type Provider struct {
+	config ProviderConfig
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+	keys      map[string]*rsa.PublicKey
+}
+
+func NewProvider(config ProviderConfig, client *http.Client) *Provider {
+	if client == nil {
+		client = &http.Client{Timeout: httpTimeout}
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	if config.DiscoveryURL == "" {
+		config.DiscoveryURL = strings.TrimSuffix(config.Issuer, "/") + discoveryPath
+	}
+	return &Provider{
+		config: config,
+		client: client,
+	}
+}
+
+func fromEnv(value string, env string) string {
+	if env != "" {
+		return os.Getenv(env)
+	}
+	return value
+}
+
+// LoadProviders читает oidc.providers из конфига. Провайдеры без client_id пропускаются
+func LoadProviders() (map[string]*Provider, error) {
+	message := logMessage + "LoadProviders:"
+	var configs map[string]ProviderConfig
+	err := viper.UnmarshalKey("oidc.providers", &configs)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, err
+	}
+	providers := make(map[string]*Provider, len(configs))
+	for name, config := range configs {
+		config.Name = name
+		config.ClientID = fromEnv(config.ClientID, config.ClientIDEnv)
+		config.ClientSecret = fromEnv(config.ClientSecret, config.ClientSecretEnv)
+		if config.ClientID == "" || config.Issuer == "" {
+			log.Info(message+"provider is disabled: ", name)
+			continue
+		}
+		providers[name] = NewProvider(config, nil)
+	}
+	return providers, nil
+}
+
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+//S256: code_challenge = BASE64URL(SHA256(code_verifier))
+func CodeChallenge(verifier string) string {
+	hash := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func (p *Provider) getJSON(ctx context.Context, rawURL string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected status %d", rawURL, res.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(res.Body, maxResponseBytes)).Decode(out)
+}
+
+func (p *Provider) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	message := logMessage + "getDiscovery:"
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	doc := &discoveryDocument{}
+	err := p.getJSON(ctx, p.config.DiscoveryURL, doc)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, ErrDiscovery
+	}
+	//Документ должен описывать того же issuer, что указан в конфиге
+	if doc.Issuer != p.config.Issuer || doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JwksURI == "" {
+		log.Error(message+"unexpected document for issuer ", p.config.Issuer)
+		return nil, ErrDiscovery
+	}
+	p.discovery = doc
+	return doc, nil
+}
+
+func parseRSAKey(key jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(key.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, ErrUnknownKey
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
+
+//Ключи перечитываются, если kid не найден - так подхватывается ротация у провайдера
+func (p *Provider) getKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	message := logMessage + "getKey:"
+	doc, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	err = p.getJSON(ctx, doc.JwksURI, &jwks)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, ErrUnknownKey
+	}
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := parseRSAKey(jwk)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	p.keys = keys
+	key, ok := keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// AuthCodeURL - адрес, на который gateway перенаправляет пользователя
+func (p *Provider) AuthCodeURL(ctx context.Context, state string, nonce string, verifier string) (string, error) {
+	doc, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	values := url.Values{}
+	values.Set("response_type", "code")
+	values.Set("client_id", p.config.ClientID)
+	values.Set("redirect_uri", p.config.RedirectURL)
+	values.Set("scope", strings.Join(p.config.Scopes, " "))
+	values.Set("state", state)
+	values.Set("nonce", nonce)
+	values.Set("code_challenge", CodeChallenge(verifier))
+	values.Set("code_challenge_method", "S256")
+	separator := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return doc.AuthorizationEndpoint + separator + values.Encode(), nil
+}
+
+// Exchange обменивает code на токены и возвращает ID token
+func (p *Provider) Exchange(ctx context.Context, code string, verifier string) (string, error) {
+	message := logMessage + "Exchange:"
+	doc, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	values := url.Values{}
+	values.Set("grant_type", "authorization_code")
+	values.Set("code", code)
+	values.Set("redirect_uri", p.config.RedirectURL)
+	values.Set("client_id", p.config.ClientID)
+	values.Set("client_secret", p.config.ClientSecret)
+	values.Set("code_verifier", verifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	res, err := p.client.Do(req)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", ErrExchange
+	}
+	defer res.Body.Close()
+	tokens := &tokenResponse{}
+	err = json.NewDecoder(io.LimitReader(res.Body, maxResponseBytes)).Decode(tokens)
+	if err != nil || res.StatusCode != http.StatusOK || tokens.IdToken == "" {
+		log.Error(message+"status = ", res.StatusCode, " error = ", tokens.Error, " err = ", err)
+		return "", ErrExchange
+	}
+	return tokens.IdToken, nil
+}
+
+//Часть провайдеров отдаёт email_verified строкой
+func isVerified(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// VerifyIdToken проверяет подпись, issuer, audience, срок действия и nonce
+func (p *Provider) VerifyIdToken(ctx context.Context, rawToken string, nonce string) (*Claims, error) {
+	message := logMessage + "VerifyIdToken:"
+	doc, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims := &idTokenClaims{}
+	_, err = jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, jwt.ErrHashUnavailable
+		}
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, kid)
+	}, jwt.WithAudience(p.config.ClientID), jwt.WithIssuer(doc.Issuer), jwt.WithLeeway(time.Minute))
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return nil, ErrInvalidIdToken
+	}
+	if claims.ExpiresAt == nil || claims.Subject == "" || claims.Nonce != nonce {
+		return nil, ErrInvalidIdToken
+	}
+	return &Claims{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: isVerified(claims.EmailVerified),
+		Name:          claims.Name,
+		GivenName:     claims.GivenName,
+		FamilyName:    claims.FamilyName,
+	}, nil
+}
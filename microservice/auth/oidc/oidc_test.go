@@ -0,0 +1,125 @@
+package oidc
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthCodeURL(t *testing.T) {
+	server, err := NewMockServer()
+	assert.NoError(t, err)
+	defer server.Close()
+	provider := NewProvider(server.Config("mock"), nil)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state", "nonce", "verifier")
+	assert.NoError(t, err)
+	parsed, err := url.Parse(authURL)
+	assert.NoError(t, err)
+	query := parsed.Query()
+	assert.Equal(t, server.Server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, MockClientID, query.Get("client_id"))
+	assert.Equal(t, "state", query.Get("state"))
+	assert.Equal(t, "nonce", query.Get("nonce"))
+	assert.Equal(t, CodeChallenge("verifier"), query.Get("code_challenge"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+}
+
+func TestExchangeAndVerify(t *testing.T) {
+	server, err := NewMockServer()
+	assert.NoError(t, err)
+	defer server.Close()
+	provider := NewProvider(server.Config("mock"), nil)
+	ctx := context.Background()
+
+	grant := MockGrant{
+		Challenge:     CodeChallenge("verifier"),
+		Nonce:         "nonce",
+		Subject:       "sub",
+		Email:         "mail@mail.ru",
+		EmailVerified: true,
+		Name:          "Name",
+	}
+	server.Grant("code", grant)
+	idToken, err := provider.Exchange(ctx, "code", "verifier")
+	assert.NoError(t, err)
+	claims, err := provider.VerifyIdToken(ctx, idToken, "nonce")
+	assert.NoError(t, err)
+	assert.Equal(t, "sub", claims.Subject)
+	assert.Equal(t, "mail@mail.ru", claims.Email)
+	assert.True(t, claims.EmailVerified)
+
+	//code одноразовый
+	_, err = provider.Exchange(ctx, "code", "verifier")
+	assert.Equal(t, ErrExchange, err)
+
+	//Неверный code_verifier - PKCE не пройден
+	server.Grant("code2", grant)
+	_, err = provider.Exchange(ctx, "code2", "other")
+	assert.Equal(t, ErrExchange, err)
+}
+
+func TestVerifyIdTokenInvalid(t *testing.T) {
+	server, err := NewMockServer()
+	assert.NoError(t, err)
+	defer server.Close()
+	provider := NewProvider(server.Config("mock"), nil)
+	ctx := context.Background()
+	grant := MockGrant{Nonce: "nonce", Subject: "sub"}
+
+	var verifyTests = []struct {
+		id       int
+		issuer   string
+		audience string
+		nonce    string
+	}{
+		{1, "http://evil", MockClientID, "nonce"},
+		{2, server.Server.URL, "other-client", "nonce"},
+		{3, server.Server.URL, MockClientID, "other-nonce"},
+	}
+	for _, test := range verifyTests {
+		idToken, err := server.IdToken(grant, test.issuer, test.audience)
+		assert.NoError(t, err, test.id)
+		_, err = provider.VerifyIdToken(ctx, idToken, test.nonce)
+		assert.Equal(t, ErrInvalidIdToken, err, test.id)
+	}
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	server, err := NewMockServer()
+	assert.NoError(t, err)
+	defer server.Close()
+	config := server.Config("mock")
+	config.DiscoveryURL = server.Server.URL + discoveryPath
+	config.Issuer = "https://accounts.example.com"
+	provider := NewProvider(config, nil)
+
+	_, err = provider.AuthCodeURL(context.Background(), "state", "nonce", "verifier")
+	assert.Equal(t, ErrDiscovery, err)
+}
+
+func TestLoadProviders(t *testing.T) {
+	defer viper.Reset()
+	t.Setenv("OIDC_GOOGLE_CLIENT_ID", "google-client")
+	viper.Set("oidc.providers", map[string]interface{}{
+		"google": map[string]interface{}{
+			"issuer":        "https://accounts.google.com",
+			"client_id_env": "OIDC_GOOGLE_CLIENT_ID",
+			"redirect_url":  "http://localhost/auth/oidc/google/callback",
+		},
+		"yandex": map[string]interface{}{
+			"issuer":        "https://oauth.yandex.ru",
+			"client_id_env": "OIDC_YANDEX_CLIENT_ID",
+		},
+	})
+	providers, err := LoadProviders()
+	assert.NoError(t, err)
+	assert.Len(t, providers, 1)
+	assert.Equal(t, "google", providers["google"].Name())
+	assert.Equal(t, "https://accounts.google.com"+discoveryPath, providers["google"].config.DiscoveryURL)
+}
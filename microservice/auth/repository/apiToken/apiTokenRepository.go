@@ -0,0 +1,163 @@
+package apiToken
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	log "backend/pkg/logger"
+	error2 "backend/service/auth/error"
+	sql2 "database/sql"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	logMessage           = "service:apiToken:repository:"
+	createApiTokenQuery  = `insert into "api_token" (user_id, name, token_hash, scopes, expires_at) values($1, $2, $3, $4, $5) returning id`
+	listApiTokensQuery   = `select * from "api_token" where user_id = $1 order by id desc`
+	getApiTokenQuery     = `select * from "api_token" where token_hash = $1`
+	deleteApiTokenQuery  = `delete from "api_token" where id = $1 and user_id = $2`
+	deleteAllByUserQuery = `delete from "api_token" where user_id = $1`
+	updateLastUsedQuery  = `update "api_token" set last_used_at = $1 where id = $2`
+)
+
+type ApiToken struct {
+	ID         int            `db:"id"`
+	UserId     int            `db:"user_id"`
+	Name       string         `db:"name"`
+	TokenHash  string         `db:"token_hash"`
+	Scopes     pq.StringArray `db:"scopes"`
+	CreatedAt  time.Time      `db:"created_at"`
+	ExpiresAt  sql2.NullTime  `db:"expires_at"`
+	LastUsedAt sql2.NullTime  `db:"last_used_at"`
+}
+
+func toModelApiToken(t *ApiToken) *authServiceModels.ApiTokenData {
+	return &authServiceModels.ApiTokenData{
+		ID:         strconv.Itoa(t.ID),
+		UserId:     strconv.Itoa(t.UserId),
+		Name:       t.Name,
+		TokenHash:  t.TokenHash,
+		Scopes:     t.Scopes,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt.Time,
+		LastUsedAt: t.LastUsedAt.Time,
+	}
+}
+
+func nullTime(t time.Time) sql2.NullTime {
+	return sql2.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(database *sqlx.DB) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+func (s *Repository) Create(data *authServiceModels.ApiTokenData) (string, error) {
+	userIdInt, err := strconv.Atoi(data.UserId)
+	if err != nil {
+		return "", error2.ErrUserNotFound
+	}
+	var id int
+	err = s.db.Get(&id, createApiTokenQuery,
+		userIdInt,
+		data.Name,
+		data.TokenHash,
+		pq.StringArray(data.Scopes),
+		nullTime(data.ExpiresAt))
+	if err != nil {
+		log.Error(logMessage+"Create:err =", err)
+		return "", error2.ErrPostgres
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (s *Repository) ListByUser(userId string) ([]*authServiceModels.ApiTokenData, error) {
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return nil, error2.ErrUserNotFound
+	}
+	var tokens []ApiToken
+	err = s.db.Select(&tokens, listApiTokensQuery, userIdInt)
+	if err != nil {
+		log.Error(logMessage+"ListByUser:err =", err)
+		return nil, error2.ErrPostgres
+	}
+	result := make([]*authServiceModels.ApiTokenData, len(tokens))
+	for i := range tokens {
+		result[i] = toModelApiToken(&tokens[i])
+	}
+	return result, nil
+}
+
+func (s *Repository) GetByHash(tokenHash string) (*authServiceModels.ApiTokenData, error) {
+	token := ApiToken{}
+	err := s.db.Get(&token, getApiTokenQuery, tokenHash)
+	if err != nil {
+		if err == sql2.ErrNoRows {
+			return nil, error2.ErrInvalidToken
+		}
+		log.Error(logMessage+"GetByHash:err =", err)
+		return nil, error2.ErrPostgres
+	}
+	return toModelApiToken(&token), nil
+}
+
+// Удаляет токен, только если он принадлежит пользователю
+func (s *Repository) Delete(userId string, id string) error {
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return error2.ErrApiTokenNotFound
+	}
+	res, err := s.db.Exec(deleteApiTokenQuery, idInt, userIdInt)
+	if err != nil {
+		log.Error(logMessage+"Delete:err =", err)
+		return error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	if affected == 0 {
+		return error2.ErrApiTokenNotFound
+	}
+	return nil
+}
+
+// Отзывает все токены пользователя, например при сбросе пароля
+func (s *Repository) DeleteAllByUser(userId string) error {
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	_, err = s.db.Exec(deleteAllByUserQuery, userIdInt)
+	if err != nil {
+		log.Error(logMessage+"DeleteAllByUser:err =", err)
+		return error2.ErrPostgres
+	}
+	return nil
+}
+
+func (s *Repository) UpdateLastUsed(id string, lastUsed time.Time) error {
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return error2.ErrApiTokenNotFound
+	}
+	_, err = s.db.Exec(updateLastUsedQuery, lastUsed, idInt)
+	if err != nil {
+		log.Error(logMessage+"UpdateLastUsed:err =", err)
+		return error2.ErrPostgres
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package apiToken
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	error2 "backend/service/auth/error"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	data := &authServiceModels.ApiTokenData{
+		UserId:    "1",
+		Name:      "script",
+		TokenHash: "hash",
+		Scopes:    []string{"read"},
+	}
+	mock.ExpectQuery(createApiTokenQuery).
+		WithArgs(1, "script", "hash", pq.StringArray{"read"}, sql.NullTime{}).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	id, err := repositoryTest.Create(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "5", id)
+
+	mock.ExpectQuery(createApiTokenQuery).
+		WithArgs(1, "script", "hash", pq.StringArray{"read"}, sql.NullTime{}).
+		WillReturnError(errors.New("test error"))
+	_, err = repositoryTest.Create(data)
+	assert.Equal(t, error2.ErrPostgres, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetByHash(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	expiresAt := time.Unix(1600000000, 0)
+	rows := sqlmock.NewRows([]string{"id", "user_id", "name", "token_hash", "scopes", "created_at", "expires_at", "last_used_at"}).
+		AddRow(5, 1, "script", "hash", "{read,events:write}", time.Unix(1500000000, 0), expiresAt, nil)
+	mock.ExpectQuery(getApiTokenQuery).WithArgs("hash").WillReturnRows(rows)
+	token, err := repositoryTest.GetByHash("hash")
+	assert.NoError(t, err)
+	assert.Equal(t, "5", token.ID)
+	assert.Equal(t, "1", token.UserId)
+	assert.Equal(t, []string{"read", "events:write"}, token.Scopes)
+	assert.Equal(t, expiresAt, token.ExpiresAt)
+	assert.True(t, token.LastUsedAt.IsZero())
+
+	mock.ExpectQuery(getApiTokenQuery).WithArgs("unknown").WillReturnError(sql.ErrNoRows)
+	_, err = repositoryTest.GetByHash("unknown")
+	assert.Equal(t, error2.ErrInvalidToken, err)
+}
+
+var deleteTests = []struct {
+	id          int
+	tokenId     string
+	affected    int64
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		"5",
+		1,
+		nil,
+		nil,
+	},
+	{
+		2,
+		"5",
+		0,
+		nil,
+		error2.ErrApiTokenNotFound,
+	},
+	{
+		3,
+		"5",
+		0,
+		errors.New("test error"),
+		error2.ErrPostgres,
+	},
+}
+
+func TestDelete(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	for _, test := range deleteTests {
+		mock.ExpectExec(deleteApiTokenQuery).WithArgs(5, 1).
+			WillReturnResult(sqlmock.NewResult(0, test.affected)).
+			WillReturnError(test.postgresErr)
+		actualErr := repositoryTest.Delete("1", test.tokenId)
+		assert.Equal(t, test.outputErr, actualErr, test.id)
+	}
+}
+
+func TestDeleteAllByUser(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectExec(deleteAllByUserQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+	assert.NoError(t, repositoryTest.DeleteAllByUser("1"))
+
+	mock.ExpectExec(deleteAllByUserQuery).WithArgs(1).WillReturnError(errors.New("test error"))
+	assert.Equal(t, error2.ErrPostgres, repositoryTest.DeleteAllByUser("1"))
+
+	assert.Equal(t, error2.ErrUserNotFound, repositoryTest.DeleteAllByUser("abc"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,72 @@
+package attempt
+
+import (
+	log "backend/pkg/logger"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	logMessage       = "service:attempt:repository:"
+	attemptKeyPrefix = "login_attempts:"
+	lockKeyPrefix    = "login_lock:"
+)
+
+type Repository struct {
+	db redis.Cmdable
+}
+
+func NewRepository(database redis.Cmdable) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+func attemptKey(key string) string {
+	return attemptKeyPrefix + key
+}
+
+func lockKey(key string) string {
+	return lockKeyPrefix + key
+}
+
+//Оставшееся время блокировки, 0 - блокировки нет
+func (s *Repository) LockedFor(key string) (time.Duration, error) {
+	ttl, err := s.db.TTL(lockKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	//-2: ключа нет, -1: ключ без TTL (не должно случаться)
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+//Увеличивает счётчик неудачных попыток. Окно отсчитывается от первой неудачи
+func (s *Repository) Fail(key string, window time.Duration) (int64, error) {
+	message := logMessage + "Fail:"
+	counterKey := attemptKey(key)
+	count, err := s.db.Incr(counterKey).Result()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return 0, err
+	}
+	if count == 1 {
+		err = s.db.Expire(counterKey, window).Err()
+		if err != nil {
+			log.Error(message+"err =", err)
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+func (s *Repository) Lock(key string, duration time.Duration) error {
+	return s.db.Set(lockKey(key), 1, duration).Err()
+}
+
+func (s *Repository) Reset(key string) error {
+	return s.db.Del(attemptKey(key), lockKey(key)).Err()
+}
@@ -0,0 +1,59 @@
+package attempt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elliotchance/redismock"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	client *redis.Client
+)
+
+const key = "mail:test@mail.ru"
+
+func TestLockedFor(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("TTL", lockKey(key)).Return(redis.NewDurationResult(30*time.Second, nil))
+	mock.On("TTL", lockKey("free")).Return(redis.NewDurationResult(-2*time.Second, nil))
+
+	r := NewRepository(mock)
+	res, err := r.LockedFor(key)
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, res)
+
+	res, err = r.LockedFor("free")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), res)
+}
+
+func TestFail(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Incr", attemptKey(key)).Return(redis.NewIntResult(1, nil)).Once()
+	mock.On("Expire", attemptKey(key), time.Hour).Return(redis.NewBoolResult(true, nil)).Once()
+
+	r := NewRepository(mock)
+	count, err := r.Fail(key, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	//Окно не продлевается последующими неудачами
+	mock.On("Incr", attemptKey(key)).Return(redis.NewIntResult(2, nil)).Once()
+	count, err = r.Fail(key, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	mock.AssertNumberOfCalls(t, "Expire", 1)
+}
+
+func TestLockAndReset(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("Set", lockKey(key), 1, time.Minute).Return(redis.NewStatusResult("OK", nil))
+	mock.On("Del", []string{attemptKey(key), lockKey(key)}).Return(redis.NewIntResult(2, nil))
+
+	r := NewRepository(mock)
+	assert.NoError(t, r.Lock(key, time.Minute))
+	assert.NoError(t, r.Reset(key))
+}
@@ -0,0 +1,147 @@
+package authEvent
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	log "backend/pkg/logger"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	sql2 "database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	logMessage             = "service:authEvent:repository:"
+	selectAuthEventsQuery  = `select * from "auth_event"`
+	listAuthEventsQuery    = selectAuthEventsQuery + ` where user_id = $1 order by created_at desc, id desc limit $2`
+	authEventsOrderByQuery = ` order by created_at desc, id desc limit `
+)
+
+type AuthEvent struct {
+	ID        int64          `db:"id"`
+	UserId    sql2.NullInt64 `db:"user_id"`
+	Type      string         `db:"type"`
+	Mail      string         `db:"mail"`
+	IP        string         `db:"ip"`
+	UserAgent string         `db:"user_agent"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+func toModelAuthEvent(e *AuthEvent) *authServiceModels.AuthEventData {
+	data := &authServiceModels.AuthEventData{
+		ID:        strconv.FormatInt(e.ID, 10),
+		Type:      e.Type,
+		Mail:      e.Mail,
+		IP:        e.IP,
+		UserAgent: e.UserAgent,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.UserId.Valid {
+		data.UserId = strconv.FormatInt(e.UserId.Int64, 10)
+	}
+	return data
+}
+
+func toModelAuthEvents(events []AuthEvent) []*authServiceModels.AuthEventData {
+	result := make([]*authServiceModels.AuthEventData, len(events))
+	for i := range events {
+		result[i] = toModelAuthEvent(&events[i])
+	}
+	return result
+}
+
+type Repository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(database *sqlx.DB) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+func (s *Repository) Create(data *authServiceModels.AuthEventData) error {
+	err := utils.RecordAuthEvent(s.db, &models.AuthEvent{
+		UserId:    data.UserId,
+		Type:      data.Type,
+		Mail:      data.Mail,
+		IP:        data.IP,
+		UserAgent: data.UserAgent,
+	})
+	if err != nil {
+		log.Error(logMessage+"Create:err =", err)
+		return error2.ErrPostgres
+	}
+	return nil
+}
+
+func (s *Repository) ListByUser(userId string, limit int) ([]*authServiceModels.AuthEventData, error) {
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return nil, error2.ErrUserNotFound
+	}
+	var events []AuthEvent
+	err = s.db.Select(&events, listAuthEventsQuery, userIdInt, limit)
+	if err != nil {
+		log.Error(logMessage+"ListByUser:err =", err)
+		return nil, error2.ErrPostgres
+	}
+	return toModelAuthEvents(events), nil
+}
+
+//Условия добавляются только для заданных полей фильтра
+func buildAuthEventsQuery(filter *authServiceModels.AuthEventFilter) (string, []interface{}, error) {
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, condition+" $"+strconv.Itoa(len(args)))
+	}
+	if filter.UserId != "" {
+		userIdInt, err := strconv.Atoi(filter.UserId)
+		if err != nil {
+			return "", nil, error2.ErrUserNotFound
+		}
+		addCondition("user_id =", userIdInt)
+	}
+	if filter.Type != "" {
+		addCondition("type =", filter.Type)
+	}
+	if filter.Mail != "" {
+		addCondition("mail =", filter.Mail)
+	}
+	if filter.IP != "" {
+		addCondition("ip =", filter.IP)
+	}
+	if !filter.From.IsZero() {
+		addCondition("created_at >=", filter.From)
+	}
+	if !filter.To.IsZero() {
+		addCondition("created_at <", filter.To)
+	}
+	query := selectAuthEventsQuery
+	if len(conditions) > 0 {
+		query += " where " + strings.Join(conditions, " and ")
+	}
+	args = append(args, filter.Limit)
+	query += authEventsOrderByQuery + "$" + strconv.Itoa(len(args))
+	return query, args, nil
+}
+
+func (s *Repository) Query(filter *authServiceModels.AuthEventFilter) ([]*authServiceModels.AuthEventData, error) {
+	query, args, err := buildAuthEventsQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+	var events []AuthEvent
+	err = s.db.Select(&events, query, args...)
+	if err != nil {
+		log.Error(logMessage+"Query:err =", err)
+		return nil, error2.ErrPostgres
+	}
+	return toModelAuthEvents(events), nil
+}
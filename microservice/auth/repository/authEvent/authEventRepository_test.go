@@ -0,0 +1,93 @@
+package authEvent
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	"backend/pkg/models"
+	error2 "backend/service/auth/error"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+var authEventColumns = []string{"id", "user_id", "type", "mail", "ip", "user_agent", "created_at"}
+
+const insertAuthEventQuery = `insert into "auth_event" (user_id, type, mail, ip, user_agent) values ($1, $2, $3, $4, $5)`
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	repositoryTest := NewRepository(sqlx.NewDb(db, "sqlmock"))
+
+	data := &authServiceModels.AuthEventData{UserId: "1", Type: models.AuthEventLogout, IP: "127.0.0.1", UserAgent: "agent"}
+	mock.ExpectExec(insertAuthEventQuery).
+		WithArgs(sql.NullInt64{Int64: 1, Valid: true}, models.AuthEventLogout, "", "127.0.0.1", "agent").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	assert.NoError(t, repositoryTest.Create(data))
+
+	mock.ExpectExec(insertAuthEventQuery).
+		WithArgs(sql.NullInt64{Int64: 1, Valid: true}, models.AuthEventLogout, "", "127.0.0.1", "agent").
+		WillReturnError(errors.New("test error"))
+	assert.Equal(t, error2.ErrPostgres, repositoryTest.Create(data))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListByUser(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	repositoryTest := NewRepository(sqlx.NewDb(db, "sqlmock"))
+
+	now := time.Now()
+	mock.ExpectQuery(listAuthEventsQuery).WithArgs(1, 50).
+		WillReturnRows(sqlmock.NewRows(authEventColumns).
+			AddRow(2, 1, models.AuthEventLogin, "", "127.0.0.1", "agent", now))
+	events, err := repositoryTest.ListByUser("1", 50)
+	assert.NoError(t, err)
+	assert.Equal(t, []*authServiceModels.AuthEventData{{
+		ID:        "2",
+		UserId:    "1",
+		Type:      models.AuthEventLogin,
+		IP:        "127.0.0.1",
+		UserAgent: "agent",
+		CreatedAt: now,
+	}}, events)
+
+	_, err = repositoryTest.ListByUser("abc", 50)
+	assert.Equal(t, error2.ErrUserNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQuery(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	repositoryTest := NewRepository(sqlx.NewDb(db, "sqlmock"))
+
+	from := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`select * from "auth_event" where type = $1 and ip = $2 and created_at >= $3 order by created_at desc, id desc limit $4`).
+		WithArgs(models.AuthEventLoginFailed, "10.0.0.1", from, 100).
+		WillReturnRows(sqlmock.NewRows(authEventColumns).
+			AddRow(3, nil, models.AuthEventLoginFailed, "unknown@mail.ru", "10.0.0.1", "", from))
+	events, err := repositoryTest.Query(&authServiceModels.AuthEventFilter{
+		Type:  models.AuthEventLoginFailed,
+		IP:    "10.0.0.1",
+		From:  from,
+		Limit: 100,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "", events[0].UserId)
+	assert.Equal(t, "unknown@mail.ru", events[0].Mail)
+
+	mock.ExpectQuery(`select * from "auth_event" order by created_at desc, id desc limit $1`).WithArgs(10).
+		WillReturnError(errors.New("test error"))
+	_, err = repositoryTest.Query(&authServiceModels.AuthEventFilter{Limit: 10})
+	assert.Equal(t, error2.ErrPostgres, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,12 +1,25 @@
 package session
 
 import (
-	log "backend/pkg/logger"
 	authServiceModels "backend/microservice/auth/models"
+	log "backend/pkg/logger"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/go-redis/redis"
 )
 
-const logMessage = "service:session:repository:"
+const (
+	logMessage            = "service:session:repository:"
+	userSessionsKeyPrefix = "user_sessions:"
+	userIdField           = "user_id"
+	createdAtField        = "created_at"
+	lastSeenField         = "last_seen"
+	userAgentField        = "user_agent"
+	ipField               = "ip"
+	rememberMeField       = "remember_me"
+)
 
 type Repository struct {
 	db redis.Cmdable
@@ -18,20 +31,195 @@ func NewRepository(database redis.Cmdable) *Repository {
 	}
 }
 
+func userSessionsKey(userId string) string {
+	return userSessionsKeyPrefix + userId
+}
+
+func formatTime(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func parseTime(s string) time.Time {
+	unix, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(unix, 0)
+}
+
+//Множество сессий пользователя должно жить не меньше самой долгой из его сессий
+func (s *Repository) extendUserSessions(userId string, expiration time.Duration) error {
+	key := userSessionsKey(userId)
+	ttl, err := s.db.TTL(key).Result()
+	if err != nil {
+		return err
+	}
+	if ttl >= expiration {
+		return nil
+	}
+	return s.db.Expire(key, expiration).Err()
+}
+
+//Сессия хранится в hash: sessionId -> {user_id, created_at, last_seen, user_agent, ip, remember_me},
+//а user_sessions:<userId> - множество сессий пользователя
 func (s *Repository) Create(data *authServiceModels.SessionData) error {
-	res := s.db.Set(data.SessionId, data.UserId, data.Expiration)
+	fields := map[string]interface{}{
+		userIdField:     data.UserId,
+		createdAtField:  formatTime(data.CreatedAt),
+		lastSeenField:   formatTime(data.LastSeen),
+		userAgentField:  data.UserAgent,
+		ipField:         data.IP,
+		rememberMeField: strconv.FormatBool(data.RememberMe),
+	}
+	res := s.db.HMSet(data.SessionId, fields)
 	log.Debug(logMessage+"Create:res =", res)
-	return res.Err()
+	if res.Err() != nil {
+		return res.Err()
+	}
+	err := s.db.Expire(data.SessionId, data.Expiration).Err()
+	if err != nil {
+		return err
+	}
+	err = s.db.SAdd(userSessionsKey(data.UserId), data.SessionId).Err()
+	if err != nil {
+		return err
+	}
+	return s.extendUserSessions(data.UserId, data.Expiration)
+}
+
+//Сессии, созданные до хранения в hash, лежат строкой sessionId -> userId,
+//и команды hash на них отвечают WRONGTYPE
+func isLegacySession(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "WRONGTYPE")
+}
+
+//Старая сессия при первом обращении переписывается в hash с прежним TTL, чтобы после
+//выкладки никого не разлогинило. Когда она создана, неизвестно - считаем, что сейчас
+func (s *Repository) upgradeLegacySession(sessionId string) error {
+	userId, err := s.db.Get(sessionId).Result()
+	if err != nil {
+		return err
+	}
+	ttl, err := s.db.TTL(sessionId).Result()
+	if err != nil {
+		return err
+	}
+	//Старые сессии всегда создавались с TTL
+	if ttl <= 0 {
+		return redis.Nil
+	}
+	now := time.Now()
+	err = s.db.Del(sessionId).Err()
+	if err != nil {
+		return err
+	}
+	return s.Create(&authServiceModels.SessionData{
+		SessionId:  sessionId,
+		UserId:     userId,
+		Expiration: ttl,
+		CreatedAt:  now,
+		LastSeen:   now,
+	})
 }
 
 func (s *Repository) Check(sessionId string) (string, error) {
-	res := s.db.Get(sessionId)
+	res := s.db.HGet(sessionId, userIdField)
 	log.Debug("Check:res =", res)
+	if isLegacySession(res.Err()) {
+		err := s.upgradeLegacySession(sessionId)
+		if err != nil {
+			return "", err
+		}
+		res = s.db.HGet(sessionId, userIdField)
+	}
 	return res.Val(), res.Err()
 }
 
+func (s *Repository) Get(sessionId string) (*authServiceModels.SessionData, error) {
+	fields, err := s.db.HGetAll(sessionId).Result()
+	if isLegacySession(err) {
+		err = s.upgradeLegacySession(sessionId)
+		if err != nil {
+			return nil, err
+		}
+		fields, err = s.db.HGetAll(sessionId).Result()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, redis.Nil
+	}
+	rememberMe, _ := strconv.ParseBool(fields[rememberMeField])
+	return &authServiceModels.SessionData{
+		SessionId:  sessionId,
+		UserId:     fields[userIdField],
+		CreatedAt:  parseTime(fields[createdAtField]),
+		LastSeen:   parseTime(fields[lastSeenField]),
+		UserAgent:  fields[userAgentField],
+		IP:         fields[ipField],
+		RememberMe: rememberMe,
+	}, nil
+}
+
+//Обновляет last_seen и продлевает TTL сессии на data.Expiration
+func (s *Repository) Refresh(data *authServiceModels.SessionData) error {
+	err := s.db.HSet(data.SessionId, lastSeenField, formatTime(data.LastSeen)).Err()
+	if err != nil {
+		return err
+	}
+	err = s.db.Expire(data.SessionId, data.Expiration).Err()
+	if err != nil {
+		return err
+	}
+	return s.extendUserSessions(data.UserId, data.Expiration)
+}
+
+func (s *Repository) ListByUser(userId string) ([]*authServiceModels.SessionData, error) {
+	message := logMessage + "ListByUser:"
+	key := userSessionsKey(userId)
+	sessionIds, err := s.db.SMembers(key).Result()
+	if err != nil {
+		return nil, err
+	}
+	var result []*authServiceModels.SessionData
+	for _, sessionId := range sessionIds {
+		data, err := s.Get(sessionId)
+		if err == redis.Nil {
+			//Сессия истекла, а ссылка на неё осталась
+			s.db.SRem(key, sessionId)
+			continue
+		}
+		if err != nil {
+			log.Error(message+"err =", err)
+			return nil, err
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+//Старая сессия (строка) в множество пользователя не входит: HGet вернёт WRONGTYPE и пустой userId
 func (s *Repository) Delete(sessionId string) error {
+	userId := s.db.HGet(sessionId, userIdField).Val()
 	res := s.db.Del(sessionId)
 	log.Debug("Check:delete =", res)
+	if res.Err() != nil {
+		return res.Err()
+	}
+	if userId == "" {
+		return nil
+	}
+	return s.db.SRem(userSessionsKey(userId), sessionId).Err()
+}
+
+func (s *Repository) DeleteAllByUser(userId string) error {
+	key := userSessionsKey(userId)
+	sessions, err := s.db.SMembers(key).Result()
+	if err != nil {
+		return err
+	}
+	res := s.db.Del(append(sessions, key)...)
+	log.Debug(logMessage+"DeleteAllByUser:res =", res)
 	return res.Err()
 }
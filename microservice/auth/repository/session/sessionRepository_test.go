@@ -1,6 +1,7 @@
 package session
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/elliotchance/redismock"
 	"github.com/go-redis/redis"
 	"github.com/stretchr/testify/assert"
+	testifyMock "github.com/stretchr/testify/mock"
 )
 
 var (
@@ -21,11 +23,24 @@ var (
 )
 
 func TestCreate(t *testing.T) {
-	exp := time.Duration(0)
+	exp := time.Hour
 
 	mock := redismock.NewNiceMock(client)
 
-	mock.On("Set", key, val, exp).Return(redis.NewStatusResult("",nil))
+	now := time.Unix(1600000000, 0)
+	fields := map[string]interface{}{
+		userIdField:    val,
+		createdAtField: "1600000000",
+		lastSeenField:  "1600000000",
+		userAgentField: "Mozilla/5.0",
+		ipField:        "127.0.0.1",
+		rememberMeField: "true",
+	}
+	mock.On("HMSet", key, fields).Return(redis.NewStatusResult("OK", nil))
+	mock.On("Expire", key, exp).Return(redis.NewBoolResult(true, nil))
+	mock.On("SAdd", userSessionsKey(val), []interface{}{key}).Return(redis.NewIntResult(1, nil))
+	mock.On("TTL", userSessionsKey(val)).Return(redis.NewDurationResult(-2*time.Second, nil))
+	mock.On("Expire", userSessionsKey(val), exp).Return(redis.NewBoolResult(true, nil))
 
 	r := NewRepository(mock)
 
@@ -33,15 +48,21 @@ func TestCreate(t *testing.T) {
 		SessionId: key,
 		UserId: val,
 		Expiration: exp,
+		CreatedAt: now,
+		LastSeen: now,
+		UserAgent: "Mozilla/5.0",
+		IP: "127.0.0.1",
+		RememberMe: true,
 	}
 
 	err := r.Create(data)
 	assert.NoError(t, err)
+	mock.AssertCalled(t, "Expire", userSessionsKey(val), exp)
 }
 
 func TestCheck(t *testing.T) {
 	mock := redismock.NewNiceMock(client)
-	mock.On("Get", key).Return(redis.NewStringResult(val, nil))
+	mock.On("HGet", key, userIdField).Return(redis.NewStringResult(val, nil))
 
 	r := NewRepository(mock)
 	res, err := r.Check(key)
@@ -51,10 +72,111 @@ func TestCheck(t *testing.T) {
 func TestDelete(t *testing.T) {
 	mock := redismock.NewNiceMock(client)
 	keys := []string{key}
+	mock.On("HGet", key, userIdField).Return(redis.NewStringResult(val, nil))
 	mock.On("Del",keys).Return(redis.NewIntResult(0,nil))
+	mock.On("SRem", userSessionsKey(val), []interface{}{key}).Return(redis.NewIntResult(1, nil))
 	
 	r := NewRepository(mock)
 
 	err := r.Delete(key)
 	assert.NoError(t, err)
-}
\ No newline at end of file
+}
+
+func TestDeleteAllByUser(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	sessions := []string{"session1", "session2"}
+	mock.On("SMembers", userSessionsKey(val)).Return(redis.NewStringSliceResult(sessions, nil))
+	mock.On("Del", []string{"session1", "session2", userSessionsKey(val)}).Return(redis.NewIntResult(3, nil))
+
+	r := NewRepository(mock)
+
+	err := r.DeleteAllByUser(val)
+	assert.NoError(t, err)
+}
+
+func TestGet(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	fields := map[string]string{
+		userIdField:    val,
+		createdAtField: "1600000000",
+		lastSeenField:  "1600000100",
+		userAgentField: "Mozilla/5.0",
+		ipField:        "127.0.0.1",
+	}
+	mock.On("HGetAll", key).Return(redis.NewStringStringMapResult(fields, nil))
+	mock.On("HGetAll", "expired").Return(redis.NewStringStringMapResult(map[string]string{}, nil))
+
+	r := NewRepository(mock)
+	data, err := r.Get(key)
+	assert.NoError(t, err)
+	assert.Equal(t, val, data.UserId)
+	assert.Equal(t, time.Unix(1600000100, 0), data.LastSeen)
+	assert.Equal(t, "127.0.0.1", data.IP)
+
+	_, err = r.Get("expired")
+	assert.Equal(t, redis.Nil, err)
+}
+
+func TestRefresh(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	exp := time.Hour
+	mock.On("HSet", key, lastSeenField, "1600000000").Return(redis.NewBoolResult(false, nil))
+	mock.On("Expire", key, exp).Return(redis.NewBoolResult(true, nil))
+	mock.On("TTL", userSessionsKey(val)).Return(redis.NewDurationResult(2*time.Hour, nil))
+
+	r := NewRepository(mock)
+	data := &authServiceModels.SessionData{
+		SessionId:  key,
+		UserId:     val,
+		LastSeen:   time.Unix(1600000000, 0),
+		Expiration: exp,
+	}
+	err := r.Refresh(data)
+	assert.NoError(t, err)
+	mock.AssertNotCalled(t, "Expire", userSessionsKey(val), exp)
+}
+
+func TestListByUser(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	mock.On("SMembers", userSessionsKey(val)).Return(redis.NewStringSliceResult([]string{key, "expired"}, nil))
+	mock.On("HGetAll", key).Return(redis.NewStringStringMapResult(map[string]string{userIdField: val}, nil))
+	mock.On("HGetAll", "expired").Return(redis.NewStringStringMapResult(map[string]string{}, nil))
+	mock.On("SRem", userSessionsKey(val), []interface{}{"expired"}).Return(redis.NewIntResult(1, nil))
+
+	r := NewRepository(mock)
+	sessions, err := r.ListByUser(val)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, key, sessions[0].SessionId)
+	mock.AssertCalled(t, "SRem", userSessionsKey(val), []interface{}{"expired"})
+}
+
+func TestCheckLegacySession(t *testing.T) {
+	mock := redismock.NewNiceMock(client)
+	exp := 30 * time.Minute
+	wrongType := redis.NewStringResult("", errors.New("WRONGTYPE Operation against a key holding the wrong kind of value"))
+	mock.On("HGet", key, userIdField).Return(wrongType).Once()
+	mock.On("Get", key).Return(redis.NewStringResult(val, nil))
+	mock.On("TTL", key).Return(redis.NewDurationResult(exp, nil))
+	mock.On("Del", []string{key}).Return(redis.NewIntResult(1, nil))
+	mock.On("HMSet", key, testifyMock.Anything).Return(redis.NewStatusResult("OK", nil))
+	mock.On("Expire", key, exp).Return(redis.NewBoolResult(true, nil))
+	mock.On("SAdd", userSessionsKey(val), []interface{}{key}).Return(redis.NewIntResult(1, nil))
+	mock.On("TTL", userSessionsKey(val)).Return(redis.NewDurationResult(time.Hour, nil))
+	mock.On("HGet", key, userIdField).Return(redis.NewStringResult(val, nil)).Once()
+
+	r := NewRepository(mock)
+	res, err := r.Check(key)
+	assert.NoError(t, err)
+	assert.Equal(t, val, res)
+	//Сессия переписана в hash с прежним TTL
+	mock.AssertCalled(t, "Expire", key, exp)
+
+	//Старая сессия уже истекла
+	mock = redismock.NewNiceMock(client)
+	mock.On("HGet", key, userIdField).Return(wrongType)
+	mock.On("Get", key).Return(redis.NewStringResult("", redis.Nil))
+	r = NewRepository(mock)
+	_, err = r.Check(key)
+	assert.Equal(t, redis.Nil, err)
+}
@@ -0,0 +1,55 @@
+package token
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	log "backend/pkg/logger"
+	error2 "backend/service/auth/error"
+
+	"github.com/go-redis/redis"
+)
+
+const logMessage = "service:token:repository:"
+
+type Repository struct {
+	db redis.Cmdable
+}
+
+func NewRepository(database redis.Cmdable) *Repository {
+	return &Repository{
+		db: database,
+	}
+}
+
+func tokenKey(token string, purpose string) string {
+	return purpose + ":" + token
+}
+
+func (s *Repository) Create(data *authServiceModels.TokenData) error {
+	res := s.db.Set(tokenKey(data.Token, data.Purpose), data.UserId, data.Expiration)
+	log.Debug(logMessage+"Create:res =", res)
+	return res.Err()
+}
+
+//Use возвращает userId и удаляет токен. Del возвращает 1 только одному из
+//конкурентных запросов, поэтому токен нельзя использовать дважды
+func (s *Repository) Use(token string, purpose string) (string, error) {
+	message := logMessage + "Use:"
+	key := tokenKey(token, purpose)
+	userId, err := s.db.Get(key).Result()
+	if err == redis.Nil {
+		return "", error2.ErrInvalidToken
+	}
+	if err != nil {
+		log.Error(message+"err =", err)
+		return "", err
+	}
+	deleted, err := s.db.Del(key).Result()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return "", err
+	}
+	if deleted == 0 {
+		return "", error2.ErrInvalidToken
+	}
+	return userId, nil
+}
@@ -0,0 +1,89 @@
+package token
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	error2 "backend/service/auth/error"
+	"testing"
+	"time"
+
+	"github.com/elliotchance/redismock"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	client *redis.Client
+)
+
+var (
+	token   = "token"
+	purpose = "purpose"
+	key     = "purpose:token"
+	userId  = "1"
+)
+
+func TestCreate(t *testing.T) {
+	exp := time.Minute
+
+	mock := redismock.NewNiceMock(client)
+	mock.On("Set", key, userId, exp).Return(redis.NewStatusResult("", nil))
+
+	r := NewRepository(mock)
+
+	data := &authServiceModels.TokenData{
+		Token:      token,
+		Purpose:    purpose,
+		UserId:     userId,
+		Expiration: exp,
+	}
+
+	err := r.Create(data)
+	assert.NoError(t, err)
+}
+
+var useTests = []struct {
+	id        int
+	getVal    string
+	getErr    error
+	deleted   int64
+	outputVal string
+	outputErr error
+}{
+	{
+		1,
+		userId,
+		nil,
+		1,
+		userId,
+		nil,
+	},
+	{
+		2,
+		"",
+		redis.Nil,
+		0,
+		"",
+		error2.ErrInvalidToken,
+	},
+	{
+		3,
+		userId,
+		nil,
+		0,
+		"",
+		error2.ErrInvalidToken,
+	},
+}
+
+func TestUse(t *testing.T) {
+	for _, test := range useTests {
+		mock := redismock.NewNiceMock(client)
+		mock.On("Get", key).Return(redis.NewStringResult(test.getVal, test.getErr))
+		mock.On("Del", []string{key}).Return(redis.NewIntResult(test.deleted, nil))
+
+		r := NewRepository(mock)
+		res, err := r.Use(token, purpose)
+		assert.Equal(t, test.outputErr, err, test.id)
+		assert.Equal(t, test.outputVal, res, test.id)
+	}
+}
@@ -6,13 +6,18 @@ import (
 )
 
 type User struct {
-	ID       int    `db:"id"`
-	Name     string `db:"name"`
-	Surname  string `db:"surname"`
-	Mail     string `db:"mail"`
-	Password string `db:"password"`
-	About    string `db:"about"`
-	ImgUrl   string `db:"img_url"`
+	ID            int    `db:"id"`
+	Name          string `db:"name"`
+	Surname       string `db:"surname"`
+	Mail          string `db:"mail"`
+	Password      string `db:"password"`
+	About         string `db:"about"`
+	ImgUrl        string `db:"img_url"`
+	EmailVerified bool   `db:"email_verified"`
+	TotpSecret    string `db:"totp_secret"`
+	TotpEnabled   bool   `db:"totp_enabled"`
+	Role          string `db:"role"`
+	Banned        bool   `db:"banned"`
 }
 
 func toPostgresUser(u *models.User) *User {
@@ -28,12 +33,16 @@ func toPostgresUser(u *models.User) *User {
 
 func toModelUser(u *User) *models.User {
 	return &models.User{
-		ID:       strconv.Itoa(u.ID),
-		Name:     u.Name,
-		Surname:  u.Surname,
-		Mail:     u.Mail,
-		Password: u.Password,
-		About:    u.About,
-		ImgUrl:   u.ImgUrl,
+		ID:            strconv.Itoa(u.ID),
+		Name:          u.Name,
+		Surname:       u.Surname,
+		Mail:          u.Mail,
+		Password:      u.Password,
+		About:         u.About,
+		ImgUrl:        u.ImgUrl,
+		EmailVerified: u.EmailVerified,
+		TotpEnabled:   u.TotpEnabled,
+		Role:          u.Role,
+		Banned:        u.Banned,
 	}
 }
@@ -5,15 +5,36 @@ import (
 	"backend/pkg/models"
 	error2 "backend/service/auth/error"
 	sql2 "database/sql"
+	"github.com/jmoiron/sqlx"
 	"strconv"
 	"strings"
-	"github.com/jmoiron/sqlx"
 )
 
 const (
-	logMessage      = "service:auth:repository:postgres:"
-	createUserQuery = `insert into "user" (name, surname, mail, password, about) values($1, $2, $3, $4, $5) returning id`
-	getUserQuery    = `select * from "user" where mail = $1 and password = $2`
+	logMessage          = "service:auth:repository:postgres:"
+	createUserQuery     = `insert into "user" (name, surname, mail, password, about) values($1, $2, $3, $4, $5) returning id`
+	getUserQuery        = `select * from "user" where mail = $1`
+	updatePasswordQuery = `update "user" set password = $1 where id = $2`
+	getUserByIdQuery    = `select * from "user" where id = $1`
+	verifyEmailQuery    = `update "user" set email_verified = true where id = $1 and mail = $2`
+
+	setTotpSecretQuery       = `update "user" set totp_secret = $1 where id = $2 and totp_enabled = false`
+	getTotpSecretQuery       = `select totp_secret, totp_enabled from "user" where id = $1`
+	enableTotpQuery          = `update "user" set totp_enabled = true where id = $1 and totp_secret <> ''`
+	disableTotpQuery         = `update "user" set totp_secret = '', totp_enabled = false where id = $1`
+	deleteRecoveryCodesQuery = `delete from "recovery_code" where user_id = $1`
+	createRecoveryCodeQuery  = `insert into "recovery_code" (user_id, code_hash) values($1, $2)`
+	useRecoveryCodeQuery     = `delete from "recovery_code" where user_id = $1 and code_hash = $2`
+
+	getRoleQuery   = `select role, banned from "user" where id = $1`
+	setRoleQuery   = `update "user" set role = $1 where id = $2`
+	setBannedQuery = `update "user" set banned = $1 where id = $2`
+
+	getUserByIdentityQuery = `select u.* from "user" u join "user_identity" i on i.user_id = u.id where i.provider = $1 and i.subject = $2`
+	linkIdentityQuery      = `insert into "user_identity" (provider, subject, user_id) values($1, $2, $3) on conflict (provider, subject) do nothing`
+
+	getEventImagesQuery = `select img_url from "event" where author_id = $1 and coalesce(img_url, '') <> ''`
+	deleteUserQuery     = `delete from "user" where id = $1 returning coalesce(img_url, '')`
 )
 
 type Repository struct {
@@ -42,10 +63,10 @@ func (s *Repository) CreateUser(user *models.User) (string, error) {
 	return strconv.Itoa(userId), nil
 }
 
-func (s *Repository) GetUser(mail, password string) (*models.User, error) {
+func (s *Repository) GetUser(mail string) (*models.User, error) {
 	query := getUserQuery
 	user := User{}
-	err := s.db.Get(&user, query, mail, password)
+	err := s.db.Get(&user, query, mail)
 	if err != nil {
 		log.Error(logMessage+"GetUser:err =", err)
 		if err == sql2.ErrNoRows {
@@ -55,3 +76,310 @@ func (s *Repository) GetUser(mail, password string) (*models.User, error) {
 	}
 	return toModelUser(&user), nil
 }
+
+func (s *Repository) UpdatePassword(userId string, password string) error {
+	query := updatePasswordQuery
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	_, err = s.db.Exec(query, password, userIdInt)
+	if err != nil {
+		log.Error(logMessage+"UpdatePassword:err =", err)
+		return error2.ErrPostgres
+	}
+	return nil
+}
+
+func (s *Repository) GetUserById(userId string) (*models.User, error) {
+	query := getUserByIdQuery
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return nil, error2.ErrUserNotFound
+	}
+	user := User{}
+	err = s.db.Get(&user, query, userIdInt)
+	if err != nil {
+		log.Error(logMessage+"GetUserById:err =", err)
+		if err == sql2.ErrNoRows {
+			return nil, error2.ErrUserNotFound
+		}
+		return nil, error2.ErrPostgres
+	}
+	return toModelUser(&user), nil
+}
+
+func (s *Repository) VerifyEmail(userId string, mail string) error {
+	query := verifyEmailQuery
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	res, err := s.db.Exec(query, userIdInt, mail)
+	if err != nil {
+		log.Error(logMessage+"VerifyEmail:err =", err)
+		return error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	//Почта могла смениться после отправки письма
+	if affected == 0 {
+		return error2.ErrInvalidToken
+	}
+	return nil
+}
+
+func (s *Repository) SetTotpSecret(userId string, secret string) error {
+	query := setTotpSecretQuery
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	res, err := s.db.Exec(query, secret, userIdInt)
+	if err != nil {
+		log.Error(logMessage+"SetTotpSecret:err =", err)
+		return error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	//Пока 2FA включена, секрет можно сменить только через отключение
+	if affected == 0 {
+		return error2.ErrTotpAlreadyEnabled
+	}
+	return nil
+}
+
+func (s *Repository) GetTotpSecret(userId string) (string, bool, error) {
+	query := getTotpSecretQuery
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return "", false, error2.ErrUserNotFound
+	}
+	user := User{}
+	err = s.db.Get(&user, query, userIdInt)
+	if err != nil {
+		log.Error(logMessage+"GetTotpSecret:err =", err)
+		if err == sql2.ErrNoRows {
+			return "", false, error2.ErrUserNotFound
+		}
+		return "", false, error2.ErrPostgres
+	}
+	return user.TotpSecret, user.TotpEnabled, nil
+}
+
+//Включает 2FA и заменяет коды восстановления одной транзакцией
+func (s *Repository) EnableTotp(userId string, recoveryCodeHashes []string) error {
+	message := logMessage + "EnableTotp:"
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	res, err := tx.Exec(enableTotpQuery, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	if affected == 0 {
+		return error2.ErrTotpNotEnrolled
+	}
+	_, err = tx.Exec(deleteRecoveryCodesQuery, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return error2.ErrPostgres
+	}
+	for _, codeHash := range recoveryCodeHashes {
+		_, err = tx.Exec(createRecoveryCodeQuery, userIdInt, codeHash)
+		if err != nil {
+			log.Error(message+"err =", err)
+			return error2.ErrPostgres
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return error2.ErrPostgres
+	}
+	return nil
+}
+
+func (s *Repository) DisableTotp(userId string) error {
+	message := logMessage + "DisableTotp:"
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	_, err = tx.Exec(disableTotpQuery, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return error2.ErrPostgres
+	}
+	_, err = tx.Exec(deleteRecoveryCodesQuery, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return error2.ErrPostgres
+	}
+	return nil
+}
+
+//Код восстановления одноразовый - удаляем его при использовании
+func (s *Repository) UseRecoveryCode(userId string, codeHash string) error {
+	query := useRecoveryCodeQuery
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	res, err := s.db.Exec(query, userIdInt, codeHash)
+	if err != nil {
+		log.Error(logMessage+"UseRecoveryCode:err =", err)
+		return error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	if affected == 0 {
+		return error2.ErrInvalidTotpCode
+	}
+	return nil
+}
+
+func (s *Repository) GetRole(userId string) (string, bool, error) {
+	query := getRoleQuery
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return "", false, error2.ErrUserNotFound
+	}
+	user := User{}
+	err = s.db.Get(&user, query, userIdInt)
+	if err != nil {
+		log.Error(logMessage+"GetRole:err =", err)
+		if err == sql2.ErrNoRows {
+			return "", false, error2.ErrUserNotFound
+		}
+		return "", false, error2.ErrPostgres
+	}
+	return user.Role, user.Banned, nil
+}
+
+func (s *Repository) SetRole(userId string, role string) error {
+	return s.updateUser(logMessage+"SetRole:", setRoleQuery, role, userId)
+}
+
+func (s *Repository) SetBanned(userId string, banned bool) error {
+	return s.updateUser(logMessage+"SetBanned:", setBannedQuery, banned, userId)
+}
+
+//Выполняет update "user" ... where id = $2, 0 изменённых строк - пользователя нет
+func (s *Repository) updateUser(message string, query string, value interface{}, userId string) error {
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	res, err := s.db.Exec(query, value, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	if affected == 0 {
+		return error2.ErrUserNotFound
+	}
+	return nil
+}
+
+func (s *Repository) GetUserByIdentity(provider string, subject string) (*models.User, error) {
+	query := getUserByIdentityQuery
+	user := User{}
+	err := s.db.Get(&user, query, provider, subject)
+	if err != nil {
+		log.Error(logMessage+"GetUserByIdentity:err =", err)
+		if err == sql2.ErrNoRows {
+			return nil, error2.ErrUserNotFound
+		}
+		return nil, error2.ErrPostgres
+	}
+	return toModelUser(&user), nil
+}
+
+//Повторная привязка того же внешнего аккаунта ничего не меняет
+func (s *Repository) LinkIdentity(userId string, provider string, subject string) error {
+	query := linkIdentityQuery
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return error2.ErrUserNotFound
+	}
+	_, err = s.db.Exec(query, provider, subject, userIdInt)
+	if err != nil {
+		log.Error(logMessage+"LinkIdentity:err =", err)
+		return error2.ErrPostgres
+	}
+	return nil
+}
+
+//Удаляет пользователя и возвращает адреса его картинок (аватар и обложки его мероприятий).
+//Мероприятия, избранное, подписки и прочее удаляются каскадно по внешним ключам
+func (s *Repository) DeleteUser(userId string) ([]string, error) {
+	message := logMessage + "DeleteUser:"
+	userIdInt, err := strconv.Atoi(userId)
+	if err != nil {
+		return nil, error2.ErrUserNotFound
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return nil, error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	var imgUrls []string
+	err = tx.Select(&imgUrls, getEventImagesQuery, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return nil, error2.ErrPostgres
+	}
+	var userImgUrl string
+	err = tx.Get(&userImgUrl, deleteUserQuery, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		if err == sql2.ErrNoRows {
+			return nil, error2.ErrUserNotFound
+		}
+		return nil, error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return nil, error2.ErrPostgres
+	}
+	if userImgUrl != "" {
+		imgUrls = append(imgUrls, userImgUrl)
+	}
+	return imgUrls, nil
+}
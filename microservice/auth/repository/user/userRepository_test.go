@@ -2,6 +2,8 @@ package user
 
 import (
 	"backend/pkg/models"
+	error2 "backend/service/auth/error"
+	"database/sql"
 	"testing"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
@@ -65,7 +67,6 @@ func TestCreateUser(t *testing.T) {
 var getUserTests = []struct {
 	id          int
 	mail        string
-	password    string
 	postgresErr error
 	outputUser  *models.User
 	outputErr   error
@@ -73,7 +74,6 @@ var getUserTests = []struct {
 	{
 		1,
 		"testMail",
-		"testPassword",
 		nil,
 		&models.User{
 			ID:       "1",
@@ -88,7 +88,6 @@ var getUserTests = []struct {
 	{
 		2,
 		"testMail",
-		"testPassword",
 		errors.New("internal DB server error"),
 		&models.User{
 			ID:       "1",
@@ -103,7 +102,6 @@ var getUserTests = []struct {
 	{
 		3,
 		"testMail",
-		"testPassword",
 		errors.New("test error"),
 		&models.User{
 			ID:       "1",
@@ -126,8 +124,7 @@ func TestGetUser(t *testing.T) {
 
 	for _, test := range getUserTests {
 		mock.ExpectQuery(getUserQuery).WithArgs(
-			test.mail,
-			test.password).
+			test.mail).
 			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "surname", "mail", "password", "about"}).
 				AddRow(test.outputUser.ID,
 					test.outputUser.Name,
@@ -137,7 +134,7 @@ func TestGetUser(t *testing.T) {
 					test.outputUser.About)).
 			WillReturnError(test.postgresErr)
 
-		actualUser, actualErr := repositoryTest.GetUser(test.mail, test.password)
+		actualUser, actualErr := repositoryTest.GetUser(test.mail)
 		assert.Equal(t, test.outputErr, actualErr)
 		var expectedUser *models.User
 		if test.outputErr != nil {
@@ -147,4 +144,292 @@ func TestGetUser(t *testing.T) {
 		}
 		assert.Equal(t, expectedUser, actualUser)
 	}
-}
\ No newline at end of file
+}
+var updatePasswordTests = []struct {
+	id          int
+	userId      string
+	password    string
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		"1",
+		"testHash",
+		nil,
+		nil,
+	},
+	{
+		2,
+		"1",
+		"testHash",
+		errors.New("test error"),
+		errors.New("internal DB server error"),
+	},
+	{
+		3,
+		"wrongId",
+		"testHash",
+		nil,
+		errors.New("user not found"),
+	},
+}
+
+func TestUpdatePassword(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	for _, test := range updatePasswordTests {
+		if test.id != 3 {
+			mock.ExpectExec(updatePasswordQuery).WithArgs(test.password, 1).
+				WillReturnResult(sqlmock.NewResult(0, 1)).
+				WillReturnError(test.postgresErr)
+		}
+		actualErr := repositoryTest.UpdatePassword(test.userId, test.password)
+		assert.Equal(t, test.outputErr, actualErr)
+	}
+}
+
+func TestGetUserById(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectQuery(getUserByIdQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "mail", "email_verified"}).AddRow(1, "testMail", true))
+	actualUser, actualErr := repositoryTest.GetUserById("1")
+	assert.NoError(t, actualErr)
+	assert.Equal(t, &models.User{ID: "1", Mail: "testMail", EmailVerified: true}, actualUser)
+
+	mock.ExpectQuery(getUserByIdQuery).WithArgs(1).WillReturnError(sql.ErrNoRows)
+	actualUser, actualErr = repositoryTest.GetUserById("1")
+	assert.Nil(t, actualUser)
+	assert.Equal(t, error2.ErrUserNotFound, actualErr)
+}
+
+var verifyEmailTests = []struct {
+	id          int
+	affected    int64
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		1,
+		nil,
+		nil,
+	},
+	{
+		2,
+		0,
+		nil,
+		error2.ErrInvalidToken,
+	},
+	{
+		3,
+		0,
+		errors.New("test error"),
+		error2.ErrPostgres,
+	},
+}
+
+func TestVerifyEmail(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	for _, test := range verifyEmailTests {
+		mock.ExpectExec(verifyEmailQuery).WithArgs(1, "testMail").
+			WillReturnResult(sqlmock.NewResult(0, test.affected)).
+			WillReturnError(test.postgresErr)
+		actualErr := repositoryTest.VerifyEmail("1", "testMail")
+		assert.Equal(t, test.outputErr, actualErr, test.id)
+	}
+}
+
+func TestEnableTotp(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	hashes := []string{"hash1", "hash2"}
+	mock.ExpectBegin()
+	mock.ExpectExec(enableTotpQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteRecoveryCodesQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(createRecoveryCodeQuery).WithArgs(1, "hash1").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(createRecoveryCodeQuery).WithArgs(1, "hash2").WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+	err = repositoryTest.EnableTotp("1", hashes)
+	assert.NoError(t, err)
+
+	//Секрет не был задан - транзакция откатывается
+	mock.ExpectBegin()
+	mock.ExpectExec(enableTotpQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	err = repositoryTest.EnableTotp("1", hashes)
+	assert.Equal(t, error2.ErrTotpNotEnrolled, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetTotpSecret(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectExec(setTotpSecretQuery).WithArgs("secret", 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	err = repositoryTest.SetTotpSecret("1", "secret")
+	assert.Equal(t, error2.ErrTotpAlreadyEnabled, err)
+}
+
+var useRecoveryCodeTests = []struct {
+	id          int
+	affected    int64
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		1,
+		nil,
+		nil,
+	},
+	{
+		2,
+		0,
+		nil,
+		error2.ErrInvalidTotpCode,
+	},
+	{
+		3,
+		0,
+		errors.New("test error"),
+		error2.ErrPostgres,
+	},
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	for _, test := range useRecoveryCodeTests {
+		mock.ExpectExec(useRecoveryCodeQuery).WithArgs(1, "hash").
+			WillReturnResult(sqlmock.NewResult(0, test.affected)).
+			WillReturnError(test.postgresErr)
+		actualErr := repositoryTest.UseRecoveryCode("1", "hash")
+		assert.Equal(t, test.outputErr, actualErr, test.id)
+	}
+}
+
+func TestGetRole(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	rows := sqlmock.NewRows([]string{"role", "banned"}).AddRow("moderator", true)
+	mock.ExpectQuery(getRoleQuery).WithArgs(1).WillReturnRows(rows)
+	role, banned, err := repositoryTest.GetRole("1")
+	assert.NoError(t, err)
+	assert.Equal(t, "moderator", role)
+	assert.True(t, banned)
+
+	mock.ExpectQuery(getRoleQuery).WithArgs(2).WillReturnError(sql.ErrNoRows)
+	_, _, err = repositoryTest.GetRole("2")
+	assert.Equal(t, error2.ErrUserNotFound, err)
+}
+
+func TestSetBanned(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectExec(setBannedQuery).WithArgs(true, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	err = repositoryTest.SetBanned("1", true)
+	assert.NoError(t, err)
+
+	mock.ExpectExec(setRoleQuery).WithArgs("admin", 2).WillReturnResult(sqlmock.NewResult(0, 0))
+	err = repositoryTest.SetRole("2", "admin")
+	assert.Equal(t, error2.ErrUserNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUserByIdentity(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	rows := sqlmock.NewRows([]string{"id", "mail", "email_verified"}).AddRow(1, "mail@mail.ru", true)
+	mock.ExpectQuery(getUserByIdentityQuery).WithArgs("google", "sub").WillReturnRows(rows)
+	u, err := repositoryTest.GetUserByIdentity("google", "sub")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", u.ID)
+	assert.Equal(t, "mail@mail.ru", u.Mail)
+
+	mock.ExpectQuery(getUserByIdentityQuery).WithArgs("google", "unknown").WillReturnError(sql.ErrNoRows)
+	_, err = repositoryTest.GetUserByIdentity("google", "unknown")
+	assert.Equal(t, error2.ErrUserNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLinkIdentity(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectExec(linkIdentityQuery).WithArgs("google", "sub", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	err = repositoryTest.LinkIdentity("1", "google", "sub")
+	assert.NoError(t, err)
+
+	err = repositoryTest.LinkIdentity("abc", "google", "sub")
+	assert.Equal(t, error2.ErrUserNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteUser(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectBegin()
+	eventRows := sqlmock.NewRows([]string{"img_url"}).AddRow("event1.png").AddRow("event2.png")
+	mock.ExpectQuery(getEventImagesQuery).WithArgs(1).WillReturnRows(eventRows)
+	mock.ExpectQuery(deleteUserQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"img_url"}).AddRow("avatar.png"))
+	mock.ExpectCommit()
+	imgUrls, err := repositoryTest.DeleteUser("1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"event1.png", "event2.png", "avatar.png"}, imgUrls)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(getEventImagesQuery).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"img_url"}))
+	mock.ExpectQuery(deleteUserQuery).WithArgs(2).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	_, err = repositoryTest.DeleteUser("2")
+	assert.Equal(t, error2.ErrUserNotFound, err)
+
+	_, err = repositoryTest.DeleteUser("abc")
+	assert.Equal(t, error2.ErrUserNotFound, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	error2 "backend/service/auth/error"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	//Префикс позволяет узнать токен в логах и сканерах секретов
+	apiTokenPrefix = "bmsa_"
+	apiTokenLength = 32
+)
+
+func hashApiToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func toProtoApiTokenInfo(data *authServiceModels.ApiTokenData) *protoAuth.ApiTokenInfo {
+	return &protoAuth.ApiTokenInfo{
+		ID:         data.ID,
+		Name:       data.Name,
+		Scopes:     data.Scopes,
+		CreatedAt:  formatOptionalTime(data.CreatedAt),
+		ExpiresAt:  formatOptionalTime(data.ExpiresAt),
+		LastUsedAt: formatOptionalTime(data.LastUsedAt),
+	}
+}
+
+func normalizeScopes(scopes []string) ([]string, error) {
+	var result []string
+	seen := make(map[string]bool)
+	for _, scope := range scopes {
+		if !models.IsValidScope(scope) {
+			return nil, error2.ErrInvalidScope
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+	if len(result) == 0 {
+		return nil, error2.ErrInvalidScope
+	}
+	return result, nil
+}
+
+func (s *authService) CreateApiToken(ctx context.Context, in *protoAuth.CreateApiTokenRequest) (*protoAuth.ApiTokenInfo, error) {
+	message := logMessage + "CreateApiToken:"
+	log.Debug(message + "started")
+	if in.UserId == "" || in.Name == "" || in.ExpiresIn < 0 {
+		return &protoAuth.ApiTokenInfo{}, error2.ErrEmptyData
+	}
+	scopes, err := normalizeScopes(in.Scopes)
+	if err != nil {
+		return &protoAuth.ApiTokenInfo{}, err
+	}
+	secret, err := generateSecureToken(apiTokenLength)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.ApiTokenInfo{}, err
+	}
+	token := apiTokenPrefix + secret
+	now := time.Now()
+	data := &authServiceModels.ApiTokenData{
+		UserId:    in.UserId,
+		Name:      in.Name,
+		TokenHash: hashApiToken(token),
+		Scopes:    scopes,
+		CreatedAt: now,
+	}
+	if in.ExpiresIn > 0 {
+		data.ExpiresAt = now.Add(time.Duration(in.ExpiresIn) * time.Second)
+	}
+	data.ID, err = s.authApiTokenRepository.Create(data)
+	if err != nil {
+		return &protoAuth.ApiTokenInfo{}, err
+	}
+	//Сам токен отдаём только при создании
+	out := toProtoApiTokenInfo(data)
+	out.Token = token
+	return out, nil
+}
+
+func (s *authService) ListApiTokens(ctx context.Context, in *protoAuth.UserId) (*protoAuth.ApiTokenList, error) {
+	message := logMessage + "ListApiTokens:"
+	log.Debug(message + "started")
+	tokens, err := s.authApiTokenRepository.ListByUser(in.ID)
+	if err != nil {
+		return &protoAuth.ApiTokenList{}, err
+	}
+	result := make([]*protoAuth.ApiTokenInfo, len(tokens))
+	for i, data := range tokens {
+		result[i] = toProtoApiTokenInfo(data)
+	}
+	return &protoAuth.ApiTokenList{Tokens: result}, nil
+}
+
+func (s *authService) RevokeApiToken(ctx context.Context, in *protoAuth.RevokeApiTokenRequest) (*protoAuth.Success, error) {
+	message := logMessage + "RevokeApiToken:"
+	log.Debug(message + "started")
+	if in.UserId == "" || in.ID == "" {
+		return &protoAuth.Success{}, error2.ErrEmptyData
+	}
+	err := s.authApiTokenRepository.Delete(in.UserId, in.ID)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	return &protoAuth.Success{Ok: "success"}, nil
+}
+
+func (s *authService) CheckApiToken(ctx context.Context, in *protoAuth.ApiToken) (*protoAuth.SessionUser, error) {
+	message := logMessage + "CheckApiToken:"
+	log.Debug(message + "started")
+	if in.Token == "" {
+		return &protoAuth.SessionUser{}, error2.ErrInvalidToken
+	}
+	data, err := s.authApiTokenRepository.GetByHash(hashApiToken(in.Token))
+	if err != nil {
+		return &protoAuth.SessionUser{}, err
+	}
+	now := time.Now()
+	if !data.ExpiresAt.IsZero() && now.After(data.ExpiresAt) {
+		return &protoAuth.SessionUser{}, error2.ErrInvalidToken
+	}
+	role, banned, err := s.authUserRepository.GetRole(data.UserId)
+	if err != nil {
+		return &protoAuth.SessionUser{}, err
+	}
+	if banned {
+		return &protoAuth.SessionUser{}, error2.ErrUserBanned
+	}
+	err = s.authApiTokenRepository.UpdateLastUsed(data.ID, now)
+	if err != nil {
+		log.Error(message+"err = ", err)
+	}
+	return &protoAuth.SessionUser{
+		ID:     data.UserId,
+		Role:   role,
+		Scopes: data.Scopes,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type AuthApiTokenMock struct {
+	mock.Mock
+}
+
+func (m *AuthApiTokenMock) Create(data *authServiceModels.ApiTokenData) (string, error) {
+	args := m.Called(data)
+	return args.String(0), args.Error(1)
+}
+
+func (m *AuthApiTokenMock) ListByUser(userId string) ([]*authServiceModels.ApiTokenData, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*authServiceModels.ApiTokenData), args.Error(1)
+}
+
+func (m *AuthApiTokenMock) GetByHash(tokenHash string) (*authServiceModels.ApiTokenData, error) {
+	args := m.Called(tokenHash)
+	return args.Get(0).(*authServiceModels.ApiTokenData), args.Error(1)
+}
+
+func (m *AuthApiTokenMock) Delete(userId string, id string) error {
+	args := m.Called(userId, id)
+	return args.Error(0)
+}
+
+func (m *AuthApiTokenMock) DeleteAllByUser(userId string) error {
+	args := m.Called(userId)
+	return args.Error(0)
+}
+
+func (m *AuthApiTokenMock) UpdateLastUsed(id string, lastUsed time.Time) error {
+	args := m.Called(id, lastUsed)
+	return args.Error(0)
+}
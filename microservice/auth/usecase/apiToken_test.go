@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	error2 "backend/service/auth/error"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateApiToken(t *testing.T) {
+	apiTokenRepositoryMock := new(AuthApiTokenMock)
+	useCaseTest := NewService(nil, nil, nil, nil, apiTokenRepositoryMock, nil)
+
+	var storedHash string
+	apiTokenRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.ApiTokenData) bool {
+		storedHash = data.TokenHash
+		return data.UserId == "1" && data.Name == "script" && len(data.Scopes) == 1 &&
+			data.ExpiresAt.Sub(data.CreatedAt) == time.Hour
+	})).Return("5", nil)
+
+	in := &protoAuth.CreateApiTokenRequest{
+		UserId:    "1",
+		Name:      "script",
+		Scopes:    []string{"read", "read"},
+		ExpiresIn: 3600,
+	}
+	out, err := useCaseTest.CreateApiToken(context.Background(), in)
+	assert.NoError(t, err)
+	assert.Equal(t, "5", out.ID)
+	assert.True(t, strings.HasPrefix(out.Token, apiTokenPrefix))
+	//В базу попадает только хэш
+	assert.Equal(t, hashApiToken(out.Token), storedHash)
+	assert.NotEqual(t, out.Token, storedHash)
+
+	in.Scopes = []string{"admin"}
+	_, err = useCaseTest.CreateApiToken(context.Background(), in)
+	assert.Equal(t, error2.ErrInvalidScope, err)
+	apiTokenRepositoryMock.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestCheckApiToken(t *testing.T) {
+	apiTokenRepositoryMock := new(AuthApiTokenMock)
+	authRepositoryMock := new(AuthRepoMock)
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, apiTokenRepositoryMock, nil)
+
+	valid := &authServiceModels.ApiTokenData{
+		ID:     "5",
+		UserId: "1",
+		Scopes: []string{"read"},
+	}
+	expired := &authServiceModels.ApiTokenData{
+		ID:        "6",
+		UserId:    "1",
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	apiTokenRepositoryMock.On("GetByHash", hashApiToken("valid")).Return(valid, nil)
+	apiTokenRepositoryMock.On("GetByHash", hashApiToken("expired")).Return(expired, nil)
+	apiTokenRepositoryMock.On("UpdateLastUsed", "5", mock.Anything).Return(nil)
+	authRepositoryMock.On("GetRole", "1").Return("user", false, nil)
+
+	out, err := useCaseTest.CheckApiToken(context.Background(), &protoAuth.ApiToken{Token: "valid"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", out.ID)
+	assert.Equal(t, "user", out.Role)
+	assert.Equal(t, []string{"read"}, out.Scopes)
+
+	_, err = useCaseTest.CheckApiToken(context.Background(), &protoAuth.ApiToken{Token: "expired"})
+	assert.Equal(t, error2.ErrInvalidToken, err)
+	apiTokenRepositoryMock.AssertNotCalled(t, "UpdateLastUsed", "6", mock.Anything)
+}
+
+func TestRevokeApiToken(t *testing.T) {
+	apiTokenRepositoryMock := new(AuthApiTokenMock)
+	useCaseTest := NewService(nil, nil, nil, nil, apiTokenRepositoryMock, nil)
+	apiTokenRepositoryMock.On("Delete", "1", "5").Return(error2.ErrApiTokenNotFound)
+
+	_, err := useCaseTest.RevokeApiToken(context.Background(), &protoAuth.RevokeApiTokenRequest{UserId: "1", ID: "5"})
+	assert.Equal(t, error2.ErrApiTokenNotFound, err)
+}
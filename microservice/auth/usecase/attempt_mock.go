@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type AuthAttemptMock struct {
+	mock.Mock
+}
+
+func (m *AuthAttemptMock) LockedFor(key string) (time.Duration, error) {
+	args := m.Called(key)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (m *AuthAttemptMock) Fail(key string, window time.Duration) (int64, error) {
+	args := m.Called(key, window)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *AuthAttemptMock) Lock(key string, duration time.Duration) error {
+	args := m.Called(key, duration)
+	return args.Error(0)
+}
+
+func (m *AuthAttemptMock) Reset(key string) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
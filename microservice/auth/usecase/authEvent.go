@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	securityLogLimit           = 100
+	defaultAuthEventQueryLimit = 100
+	maxAuthEventQueryLimit     = 1000
+)
+
+func toProtoAuthEvent(data *authServiceModels.AuthEventData) *protoAuth.AuthEvent {
+	return &protoAuth.AuthEvent{
+		ID:        data.ID,
+		UserId:    data.UserId,
+		Type:      data.Type,
+		Mail:      data.Mail,
+		IP:        data.IP,
+		UserAgent: data.UserAgent,
+		CreatedAt: data.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toProtoAuthEventList(events []*authServiceModels.AuthEventData) *protoAuth.AuthEventList {
+	result := make([]*protoAuth.AuthEvent, len(events))
+	for i, data := range events {
+		result[i] = toProtoAuthEvent(data)
+	}
+	return &protoAuth.AuthEventList{Events: result}
+}
+
+//Ошибка записи в журнал не должна мешать входу или выходу, поэтому только логируем её.
+//IP и User-Agent, если не заданы, берутся из метаданных, которые передаёт gateway
+func (s *authService) recordAuthEvent(ctx context.Context, data *authServiceModels.AuthEventData) {
+	message := logMessage + "recordAuthEvent:"
+	if s.authEventRepository == nil {
+		return
+	}
+	ip, userAgent := utils.ClientFromContext(ctx)
+	if data.IP == "" {
+		data.IP = ip
+	}
+	if data.UserAgent == "" {
+		data.UserAgent = userAgent
+	}
+	err := s.authEventRepository.Create(data)
+	if err != nil {
+		log.Error(message+"err = ", err)
+	}
+}
+
+//Владелец сессии для записи в журнал; пустая строка, если журнал не ведётся или сессии нет
+func (s *authService) sessionOwner(sessionId string) string {
+	if s.authEventRepository == nil || sessionId == "" {
+		return ""
+	}
+	userId, err := s.authSessionRepository.Check(sessionId)
+	if err != nil {
+		return ""
+	}
+	return userId
+}
+
+//Последние события пользователя для страницы безопасности в профиле
+func (s *authService) ListAuthEvents(ctx context.Context, in *protoAuth.UserId) (*protoAuth.AuthEventList, error) {
+	message := logMessage + "ListAuthEvents:"
+	log.Debug(message + "started")
+	if in.ID == "" {
+		return &protoAuth.AuthEventList{}, error2.ErrEmptyData
+	}
+	events, err := s.authEventRepository.ListByUser(in.ID, securityLogLimit)
+	if err != nil {
+		return &protoAuth.AuthEventList{}, err
+	}
+	return toProtoAuthEventList(events), nil
+}
+
+func parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+//Поиск по журналу для администраторов: по пользователю, типу, почте, IP и периоду [From, To)
+func (s *authService) QueryAuthEvents(ctx context.Context, in *protoAuth.AuthEventQuery) (*protoAuth.AuthEventList, error) {
+	message := logMessage + "QueryAuthEvents:"
+	log.Debug(message + "started")
+	from, err := parseOptionalTime(in.From)
+	if err != nil {
+		return &protoAuth.AuthEventList{}, error2.ErrInvalidAuthEventFilter
+	}
+	to, err := parseOptionalTime(in.To)
+	if err != nil {
+		return &protoAuth.AuthEventList{}, error2.ErrInvalidAuthEventFilter
+	}
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultAuthEventQueryLimit
+	}
+	if limit > maxAuthEventQueryLimit {
+		limit = maxAuthEventQueryLimit
+	}
+	events, err := s.authEventRepository.Query(&authServiceModels.AuthEventFilter{
+		UserId: in.UserId,
+		Type:   in.Type,
+		Mail:   in.Mail,
+		IP:     in.IP,
+		From:   from,
+		To:     to,
+		Limit:  limit,
+	})
+	if err != nil {
+		return &protoAuth.AuthEventList{}, err
+	}
+	return toProtoAuthEventList(events), nil
+}
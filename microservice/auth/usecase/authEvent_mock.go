@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type AuthEventMock struct {
+	mock.Mock
+}
+
+func (m *AuthEventMock) Create(data *authServiceModels.AuthEventData) error {
+	args := m.Called(data)
+	return args.Error(0)
+}
+
+func (m *AuthEventMock) ListByUser(userId string, limit int) ([]*authServiceModels.AuthEventData, error) {
+	args := m.Called(userId, limit)
+	return args.Get(0).([]*authServiceModels.AuthEventData), args.Error(1)
+}
+
+func (m *AuthEventMock) Query(filter *authServiceModels.AuthEventFilter) ([]*authServiceModels.AuthEventData, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]*authServiceModels.AuthEventData), args.Error(1)
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+)
+
+//Метаданные, которые gateway передаёт в исходящем контексте, микросервис видит во входящем
+func clientTestContext(ip string, userAgent string) context.Context {
+	md, _ := metadata.FromOutgoingContext(utils.ClientContext(context.Background(), ip, userAgent))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestSignInFailureRecorded(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	authEventRepositoryMock := new(AuthEventMock)
+	useCaseTest := NewService(authRepositoryMock, nil, nil, newAttemptMock(), nil, authEventRepositoryMock)
+
+	authRepositoryMock.On("GetUser", "unknown@mail.ru").Return((*models.User)(nil), error2.ErrUserNotFound)
+	authEventRepositoryMock.On("Create", &authServiceModels.AuthEventData{
+		Type:      models.AuthEventLoginFailed,
+		Mail:      "unknown@mail.ru",
+		IP:        "10.0.0.1",
+		UserAgent: "Mozilla/5.0",
+	}).Return(nil)
+	ctx := clientTestContext("10.0.0.1", "Mozilla/5.0")
+	_, err := useCaseTest.SignIn(ctx, &protoAuth.SignInRequest{Mail: "unknown@mail.ru", Password: "12345678"})
+	assert.Equal(t, error2.ErrUserNotFound, err)
+	authEventRepositoryMock.AssertExpectations(t)
+}
+
+func TestSessionEventsRecorded(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+	authEventRepositoryMock := new(AuthEventMock)
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, authEventRepositoryMock)
+
+	//Успешный вход: IP и User-Agent берутся из запроса на создание сессии
+	sessionRepositoryMock.On("Create", mock.Anything).Return(nil)
+	authEventRepositoryMock.On("Create", &authServiceModels.AuthEventData{
+		UserId:    "1",
+		Type:      models.AuthEventLogin,
+		IP:        "127.0.0.1",
+		UserAgent: "Mozilla/5.0",
+	}).Return(nil)
+	_, err := useCaseTest.CreateSession(context.Background(), &protoAuth.CreateSessionRequest{
+		UserId:    "1",
+		UserAgent: "Mozilla/5.0",
+		IP:        "127.0.0.1",
+	})
+	assert.NoError(t, err)
+
+	//Ошибка записи в журнал не мешает выходу
+	sessionRepositoryMock.On("Check", "session").Return("1", nil)
+	sessionRepositoryMock.On("Delete", "session").Return(nil)
+	authEventRepositoryMock.On("Create", &authServiceModels.AuthEventData{
+		UserId:    "1",
+		Type:      models.AuthEventLogout,
+		IP:        "10.0.0.1",
+		UserAgent: "curl",
+	}).Return(error2.ErrPostgres)
+	_, err = useCaseTest.DeleteSession(clientTestContext("10.0.0.1", "curl"), &protoAuth.Session{Session: "session"})
+	assert.NoError(t, err)
+	sessionRepositoryMock.AssertExpectations(t)
+	authEventRepositoryMock.AssertExpectations(t)
+}
+
+func TestListAuthEvents(t *testing.T) {
+	authEventRepositoryMock := new(AuthEventMock)
+	useCaseTest := NewService(nil, nil, nil, nil, nil, authEventRepositoryMock)
+
+	createdAt := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	authEventRepositoryMock.On("ListByUser", "1", securityLogLimit).Return([]*authServiceModels.AuthEventData{{
+		ID:        "2",
+		UserId:    "1",
+		Type:      models.AuthEventLogin,
+		IP:        "127.0.0.1",
+		CreatedAt: createdAt,
+	}}, nil)
+	out, err := useCaseTest.ListAuthEvents(context.Background(), &protoAuth.UserId{ID: "1"})
+	assert.NoError(t, err)
+	assert.Len(t, out.Events, 1)
+	assert.Equal(t, "2022-05-01T12:00:00Z", out.Events[0].CreatedAt)
+	assert.Equal(t, models.AuthEventLogin, out.Events[0].Type)
+
+	_, err = useCaseTest.ListAuthEvents(context.Background(), &protoAuth.UserId{})
+	assert.Equal(t, error2.ErrEmptyData, err)
+}
+
+func TestQueryAuthEvents(t *testing.T) {
+	authEventRepositoryMock := new(AuthEventMock)
+	useCaseTest := NewService(nil, nil, nil, nil, nil, authEventRepositoryMock)
+
+	from := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	authEventRepositoryMock.On("Query", &authServiceModels.AuthEventFilter{
+		Type:  models.AuthEventLoginFailed,
+		From:  from,
+		Limit: maxAuthEventQueryLimit,
+	}).Return([]*authServiceModels.AuthEventData{}, nil)
+	out, err := useCaseTest.QueryAuthEvents(context.Background(), &protoAuth.AuthEventQuery{
+		Type:  models.AuthEventLoginFailed,
+		From:  "2022-05-01T00:00:00Z",
+		Limit: 5000,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, out.Events, 0)
+
+	authEventRepositoryMock.On("Query", &authServiceModels.AuthEventFilter{
+		UserId: "1",
+		Limit:  defaultAuthEventQueryLimit,
+	}).Return([]*authServiceModels.AuthEventData{}, nil)
+	_, err = useCaseTest.QueryAuthEvents(context.Background(), &protoAuth.AuthEventQuery{UserId: "1"})
+	assert.NoError(t, err)
+
+	_, err = useCaseTest.QueryAuthEvents(context.Background(), &protoAuth.AuthEventQuery{To: "yesterday"})
+	assert.Equal(t, error2.ErrInvalidAuthEventFilter, err)
+	authEventRepositoryMock.AssertExpectations(t)
+}
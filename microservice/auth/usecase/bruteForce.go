@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	//С одного IP пробуют много почт (NAT, общежитие), поэтому лимит выше
+	maxMailAttempts = 5
+	maxIPAttempts   = 20
+	attemptsWindow  = time.Hour
+	baseLockout     = time.Second * 30
+	maxLockout      = time.Hour
+)
+
+type attemptKey struct {
+	key   string
+	limit int64
+}
+
+func loginAttemptKeys(ctx context.Context, mail string) []attemptKey {
+	keys := []attemptKey{
+		{key: "mail:" + strings.ToLower(mail), limit: maxMailAttempts},
+	}
+	ip, _ := utils.ClientFromContext(ctx)
+	if ip != "" {
+		keys = append(keys, attemptKey{key: "ip:" + ip, limit: maxIPAttempts})
+	}
+	return keys
+}
+
+//Блокировка удваивается с каждой попыткой сверх лимита: 30s, 1m, 2m, ... до maxLockout
+func lockoutDuration(failures int64, limit int64) time.Duration {
+	lockout := baseLockout
+	for i := limit; i < failures; i++ {
+		lockout *= 2
+		if lockout >= maxLockout {
+			return maxLockout
+		}
+	}
+	return lockout
+}
+
+//Отдельный gRPC-код и RetryInfo, чтобы gateway мог ответить 429 с Retry-After
+func lockoutError(retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, error2.ErrTooManyAttempts.Error())
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+//Ошибки redis не должны мешать входу, поэтому только логируем их
+func (s *authService) checkLockout(keys []attemptKey) error {
+	message := logMessage + "checkLockout:"
+	var retryAfter time.Duration
+	for _, k := range keys {
+		lockedFor, err := s.authAttemptRepository.LockedFor(k.key)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			continue
+		}
+		if lockedFor > retryAfter {
+			retryAfter = lockedFor
+		}
+	}
+	if retryAfter > 0 {
+		return lockoutError(retryAfter)
+	}
+	return nil
+}
+
+func (s *authService) registerFailedLogin(keys []attemptKey) {
+	message := logMessage + "registerFailedLogin:"
+	for _, k := range keys {
+		failures, err := s.authAttemptRepository.Fail(k.key, attemptsWindow)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			continue
+		}
+		if failures < k.limit {
+			continue
+		}
+		err = s.authAttemptRepository.Lock(k.key, lockoutDuration(failures, k.limit))
+		if err != nil {
+			log.Error(message+"err = ", err)
+		}
+	}
+}
+
+//После успешного входа сбрасываем только счётчик почты: IP может быть общим
+func (s *authService) resetFailedLogins(keys []attemptKey) {
+	message := logMessage + "resetFailedLogins:"
+	err := s.authAttemptRepository.Reset(keys[0].key)
+	if err != nil {
+		log.Error(message+"err = ", err)
+	}
+}
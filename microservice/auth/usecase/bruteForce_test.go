@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+//Мок без блокировок для тестов, которые не проверяют ограничение попыток
+func newAttemptMock() *AuthAttemptMock {
+	attemptRepositoryMock := new(AuthAttemptMock)
+	attemptRepositoryMock.On("LockedFor", mock.Anything).Return(time.Duration(0), nil)
+	attemptRepositoryMock.On("Fail", mock.Anything, attemptsWindow).Return(int64(1), nil)
+	attemptRepositoryMock.On("Reset", mock.Anything).Return(nil)
+	return attemptRepositoryMock
+}
+
+func TestLockoutDuration(t *testing.T) {
+	assert.Equal(t, baseLockout, lockoutDuration(maxMailAttempts, maxMailAttempts))
+	assert.Equal(t, 2*baseLockout, lockoutDuration(maxMailAttempts+1, maxMailAttempts))
+	assert.Equal(t, 8*baseLockout, lockoutDuration(maxMailAttempts+3, maxMailAttempts))
+	assert.Equal(t, maxLockout, lockoutDuration(maxMailAttempts+100, maxMailAttempts))
+}
+
+func TestLoginAttemptKeys(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(utils.ClientIPMetadataKey, "10.0.0.1"))
+	keys := loginAttemptKeys(ctx, "Test@Mail.ru")
+	assert.Equal(t, []attemptKey{
+		{key: "mail:test@mail.ru", limit: maxMailAttempts},
+		{key: "ip:10.0.0.1", limit: maxIPAttempts},
+	}, keys)
+
+	keys = loginAttemptKeys(context.Background(), "test@mail.ru")
+	assert.Len(t, keys, 1)
+}
+
+func TestSignInLocked(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	attemptRepositoryMock := new(AuthAttemptMock)
+	attemptRepositoryMock.On("LockedFor", "mail:test@mail.ru").Return(time.Duration(0), nil)
+	attemptRepositoryMock.On("LockedFor", "ip:10.0.0.1").Return(time.Minute, nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, attemptRepositoryMock, nil, nil)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(utils.ClientIPMetadataKey, "10.0.0.1"))
+	_, err := useCaseTest.SignIn(ctx, &protoAuth.SignInRequest{Mail: "test@mail.ru", Password: "12345678"})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Equal(t, error2.ErrTooManyAttempts.Error(), st.Message())
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, retryInfo.RetryDelay.AsDuration())
+	//Пароль при активной блокировке не проверяется
+	authRepositoryMock.AssertNotCalled(t, "GetUser", mock.Anything)
+}
+
+func TestSignInFailureLocks(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	attemptRepositoryMock := new(AuthAttemptMock)
+	passwordHash, err := utils.CreatePasswordHash("12345678")
+	assert.NoError(t, err)
+	authRepositoryMock.On("GetUser", "test@mail.ru").Return(&models.User{ID: "1", Password: passwordHash}, nil)
+	attemptRepositoryMock.On("LockedFor", "mail:test@mail.ru").Return(time.Duration(0), nil)
+	attemptRepositoryMock.On("Fail", "mail:test@mail.ru", attemptsWindow).Return(int64(maxMailAttempts+1), nil)
+	attemptRepositoryMock.On("Lock", "mail:test@mail.ru", 2*baseLockout).Return(nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, attemptRepositoryMock, nil, nil)
+	_, err = useCaseTest.SignIn(context.Background(), &protoAuth.SignInRequest{Mail: "test@mail.ru", Password: "wrong"})
+	assert.Equal(t, error2.ErrUserNotFound, err)
+	attemptRepositoryMock.AssertExpectations(t)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+//Удаление необратимо, поэтому требуем пароль даже при активной сессии. Пользователям,
+//вошедшим через OIDC, пароль сначала нужно задать через сброс по почте.
+//Возвращает адреса картинок - файлы удаляет gateway, который их и сохранял
+func (s *authService) DeleteAccount(ctx context.Context, in *protoAuth.DeleteAccountRequest) (*protoAuth.DeletedAccount, error) {
+	message := logMessage + "DeleteAccount:"
+	log.Debug(message + "started")
+	if in.UserId == "" || in.Password == "" {
+		return &protoAuth.DeletedAccount{}, error2.ErrEmptyData
+	}
+	u, err := s.authUserRepository.GetUserById(in.UserId)
+	if err != nil {
+		return &protoAuth.DeletedAccount{}, err
+	}
+	match, _ := utils.CheckPasswordHash(in.Password, u.Password)
+	if !match {
+		return &protoAuth.DeletedAccount{}, error2.ErrWrongPassword
+	}
+	imgUrls, err := s.authUserRepository.DeleteUser(in.UserId)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.DeletedAccount{}, err
+	}
+	err = s.authSessionRepository.DeleteAllByUser(in.UserId)
+	if err != nil {
+		//Пользователя уже нет, оставшиеся сессии отклонит CheckSession
+		log.Error(message+"err = ", err)
+	}
+	return &protoAuth.DeletedAccount{ImgUrls: imgUrls}, nil
+}
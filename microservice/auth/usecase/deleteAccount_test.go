@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var deleteAccountTests = []struct {
+	id        int
+	password  string
+	deletes   bool
+	outputErr error
+}{
+	{
+		1,
+		"password",
+		true,
+		nil,
+	},
+	{
+		2,
+		"wrong",
+		false,
+		error2.ErrWrongPassword,
+	},
+	{
+		3,
+		"",
+		false,
+		error2.ErrEmptyData,
+	},
+}
+
+func TestDeleteAccount(t *testing.T) {
+	passwordHash, err := utils.CreatePasswordHash("password")
+	assert.NoError(t, err)
+	for _, test := range deleteAccountTests {
+		authRepositoryMock := new(AuthRepoMock)
+		sessionRepositoryMock := new(AuthSessionMock)
+		useCaseTest := NewService(authRepositoryMock, sessionRepositoryMock, nil, nil, nil, nil)
+
+		imgUrls := []string{"event.png", "avatar.png"}
+		authRepositoryMock.On("GetUserById", "1").Return(&models.User{ID: "1", Password: passwordHash}, nil)
+		authRepositoryMock.On("DeleteUser", "1").Return(imgUrls, nil)
+		sessionRepositoryMock.On("DeleteAllByUser", "1").Return(nil)
+
+		in := &protoAuth.DeleteAccountRequest{
+			UserId:   "1",
+			Password: test.password,
+		}
+		out, err := useCaseTest.DeleteAccount(context.Background(), in)
+		assert.Equal(t, test.outputErr, err, test.id)
+		if test.deletes {
+			assert.Equal(t, imgUrls, out.ImgUrls, test.id)
+			authRepositoryMock.AssertExpectations(t)
+			sessionRepositoryMock.AssertExpectations(t)
+		} else {
+			authRepositoryMock.AssertNotCalled(t, "DeleteUser", "1")
+			sessionRepositoryMock.AssertNotCalled(t, "DeleteAllByUser", "1")
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	protoAuth "backend/microservice/auth/proto"
+	error2 "backend/service/auth/error"
+	"context"
+	"os"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	verificationLifeTime = time.Hour * 48
+	verifySecretEnv      = "VERIFYSECRET"
+)
+
+type verificationClaims struct {
+	Mail string `json:"mail"`
+	jwt.StandardClaims
+}
+
+//С пустым ключом ссылку подделает кто угодно, поэтому без ключа токены не выдаются и не принимаются
+func verifySecret() ([]byte, error) {
+	secret := os.Getenv(verifySecretEnv)
+	if secret == "" {
+		return nil, error2.ErrNoVerifySecret
+	}
+	return []byte(secret), nil
+}
+
+//Почта входит в подпись, поэтому ссылка перестаёт работать, если почту сменили
+func generateVerificationToken(userId string, mail string) (string, error) {
+	message := logMessage + "generateVerificationToken:"
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &verificationClaims{
+		Mail: mail,
+		StandardClaims: jwt.StandardClaims{
+			ID:        userId,
+			ExpiresAt: jwt.At(time.Now().Add(verificationLifeTime)),
+		},
+	})
+	secret, err := verifySecret()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", err
+	}
+	token, err := jwtToken.SignedString(secret)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", err
+	}
+	return token, nil
+}
+
+func parseVerificationToken(susToken string, signingKey []byte) (string, string, error) {
+	token, err := jwt.ParseWithClaims(susToken, &verificationClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrHashUnavailable
+		}
+		return signingKey, nil
+	})
+	if err != nil {
+		return "", "", error2.ErrInvalidToken
+	}
+	claims, ok := token.Claims.(*verificationClaims)
+	if !ok || !token.Valid || claims.ID == "" || claims.Mail == "" {
+		return "", "", error2.ErrInvalidToken
+	}
+	return claims.ID, claims.Mail, nil
+}
+
+func (s *authService) CreateVerificationToken(ctx context.Context, protoUserId *protoAuth.UserId) (*protoAuth.VerificationToken, error) {
+	message := logMessage + "CreateVerificationToken:"
+	log.Debug(message + "started")
+	u, err := s.authUserRepository.GetUserById(protoUserId.ID)
+	if err != nil {
+		return &protoAuth.VerificationToken{}, err
+	}
+	if u.EmailVerified {
+		return &protoAuth.VerificationToken{}, error2.ErrAlreadyVerified
+	}
+	token, err := generateVerificationToken(u.ID, u.Mail)
+	if err != nil {
+		return &protoAuth.VerificationToken{}, err
+	}
+	response := &protoAuth.VerificationToken{
+		Token: token,
+		Mail:  u.Mail,
+	}
+	return response, nil
+}
+
+func (s *authService) VerifyEmail(ctx context.Context, in *protoAuth.VerificationToken) (*protoAuth.Success, error) {
+	message := logMessage + "VerifyEmail:"
+	log.Debug(message + "started")
+	secret, err := verifySecret()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.Success{}, err
+	}
+	userId, mail, err := parseVerificationToken(in.Token, secret)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	err = s.authUserRepository.VerifyEmail(userId, mail)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	response := &protoAuth.Success{
+		Ok: "success",
+	}
+	return response, nil
+}
+
+func (s *authService) IsEmailVerified(ctx context.Context, protoUserId *protoAuth.UserId) (*protoAuth.EmailVerified, error) {
+	message := logMessage + "IsEmailVerified:"
+	log.Debug(message + "started")
+	u, err := s.authUserRepository.GetUserById(protoUserId.ID)
+	if err != nil {
+		return &protoAuth.EmailVerified{}, err
+	}
+	response := &protoAuth.EmailVerified{
+		Result: u.EmailVerified,
+	}
+	return response, nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	error2 "backend/service/auth/error"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerificationToken(t *testing.T) {
+	t.Setenv(verifySecretEnv, "verifySecret")
+	token, err := generateVerificationToken("1", "test@mail.ru")
+	assert.NoError(t, err)
+
+	userId, mail, err := parseVerificationToken(token, []byte(os.Getenv(verifySecretEnv)))
+	assert.NoError(t, err)
+	assert.Equal(t, "1", userId)
+	assert.Equal(t, "test@mail.ru", mail)
+
+	_, _, err = parseVerificationToken(token, []byte("wrongSecret"))
+	assert.Equal(t, error2.ErrInvalidToken, err)
+}
+
+var createVerificationTokenTests = []struct {
+	id        int
+	user      *models.User
+	userErr   error
+	outputErr error
+}{
+	{
+		1,
+		&models.User{ID: "1", Mail: "test@mail.ru"},
+		nil,
+		nil,
+	},
+	{
+		2,
+		&models.User{ID: "1", Mail: "test@mail.ru", EmailVerified: true},
+		nil,
+		error2.ErrAlreadyVerified,
+	},
+	{
+		3,
+		&models.User{},
+		error2.ErrUserNotFound,
+		error2.ErrUserNotFound,
+	},
+}
+
+func TestVerificationTokenNoSecret(t *testing.T) {
+	t.Setenv(verifySecretEnv, "")
+	_, err := generateVerificationToken("1", "test@mail.ru")
+	assert.Equal(t, error2.ErrNoVerifySecret, err)
+
+	//Токен, подписанный пустым ключом, не принимается
+	useCaseTest := NewService(new(AuthRepoMock), nil, nil, nil, nil, nil)
+	_, err = useCaseTest.VerifyEmail(context.Background(), &protoAuth.VerificationToken{Token: "forgedToken"})
+	assert.Equal(t, error2.ErrNoVerifySecret, err)
+}
+
+func TestCreateVerificationToken(t *testing.T) {
+	t.Setenv(verifySecretEnv, "verifySecret")
+	for _, test := range createVerificationTokenTests {
+		authRepositoryMock := new(AuthRepoMock)
+		authRepositoryMock.On("GetUserById", "1").Return(test.user, test.userErr)
+
+		useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil)
+		out, err := useCaseTest.CreateVerificationToken(context.Background(), &protoAuth.UserId{ID: "1"})
+		assert.Equal(t, test.outputErr, err, test.id)
+		if test.outputErr == nil {
+			assert.NotEmpty(t, out.Token)
+			assert.Equal(t, test.user.Mail, out.Mail)
+		}
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Setenv(verifySecretEnv, "verifySecret")
+	authRepositoryMock := new(AuthRepoMock)
+	authRepositoryMock.On("VerifyEmail", "1", "test@mail.ru").Return(nil)
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil)
+
+	token, err := generateVerificationToken("1", "test@mail.ru")
+	assert.NoError(t, err)
+	out, err := useCaseTest.VerifyEmail(context.Background(), &protoAuth.VerificationToken{Token: token})
+	assert.NoError(t, err)
+	assert.Equal(t, "success", out.Ok)
+
+	_, err = useCaseTest.VerifyEmail(context.Background(), &protoAuth.VerificationToken{Token: "wrongToken"})
+	assert.Equal(t, error2.ErrInvalidToken, err)
+	authRepositoryMock.AssertExpectations(t)
+}
+
+func TestIsEmailVerified(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	authRepositoryMock.On("GetUserById", "1").Return(&models.User{ID: "1", EmailVerified: true}, nil)
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil)
+
+	out, err := useCaseTest.IsEmailVerified(context.Background(), &protoAuth.UserId{ID: "1"})
+	assert.NoError(t, err)
+	assert.True(t, out.Result)
+}
@@ -2,46 +2,19 @@ package usecase
 
 import (
 	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/utils"
 	"context"
-	"github.com/dgrijalva/jwt-go/v4"
 	log "github.com/sirupsen/logrus"
-	"os"
-	"time"
 )
 
+//Подпись и проверка идут через общую связку ключей из pkg/utils, так что токены,
+//выпущенные gateway и микросервисом, проверяются одинаково
 func generateCsrfToken(userId string) (string, error) {
-	message := logMessage + "GenerateCsrfToken:"
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
-		ID:        userId,
-		ExpiresAt: jwt.At(time.Now().Add(time.Hour * 7 * 24)), //Week  P.S. Maybe Frontend should ask us
-	})
-	secretWord := os.Getenv("CSRFSECRET")
-	csrfToken, err := jwtToken.SignedString([]byte(secretWord))
-	if err != nil {
-		log.Error(message+"err = ", err)
-		return "", err
-	}
-	return csrfToken, err
+	return utils.GenerateCsrfToken(userId)
 }
 
-func parseToken(susToken string, signingKey []byte) (string, error) {
-	token, err := jwt.ParseWithClaims(susToken, &jwt.StandardClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, jwt.ErrHashUnavailable
-		}
-		return signingKey, nil
-	})
-
-	if err != nil {
-		return "", err
-	}
-	if claims, ok := token.Claims.(*jwt.StandardClaims); ok && token.Valid {
-		if claims.ExpiresAt.Before(time.Now()) {
-			return "", jwt.ErrHashUnavailable
-		}
-		return claims.ID, nil
-	}
-	return "", jwt.ErrHashUnavailable
+func parseToken(susToken string) (string, error) {
+	return utils.ParseCsrfToken(susToken)
 }
 
 func (s *authService) CreateToken(ctx context.Context, protoUserId *protoAuth.UserId) (*protoAuth.CSRFToken, error) {
@@ -60,9 +33,8 @@ func (s *authService) CreateToken(ctx context.Context, protoUserId *protoAuth.Us
 func (s *authService) CheckToken(ctx context.Context, protoToken *protoAuth.CSRFToken) (*protoAuth.UserId, error) {
 	message := logMessage + "CheckToken:"
 	log.Debug(message + "started")
-	secretWord := os.Getenv("CSRFSECRET")
 	susToken := protoToken.CSRFToken
-	userId, err := parseToken(susToken, []byte(secretWord))
+	userId, err := parseToken(susToken)
 	if err != nil {
 		return &protoAuth.UserId{}, err
 	}
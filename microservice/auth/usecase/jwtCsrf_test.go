@@ -9,8 +9,9 @@ import (
 )
 
 func TestCreateToken(t *testing.T) {
+	t.Setenv("CSRFSECRET", "csrfSecret")
 
-	useCaseTest := NewService(nil,nil)
+	useCaseTest := NewService(nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	protoUserId := &protoAuth.UserId{
@@ -21,7 +22,8 @@ func TestCreateToken(t *testing.T) {
 }
 
 func TestCheckToken(t *testing.T) {
-	useCaseTest := NewService(nil,nil)
+	t.Setenv("CSRFSECRET", "csrfSecret")
+	useCaseTest := NewService(nil, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	userId := "1"
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	error2 "backend/service/auth/error"
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	magicLinkPurpose     = "magic_link"
+	magicLinkTokenLength = 32
+	magicLinkLifeTime    = time.Minute * 15
+)
+
+//Токен случайный и хранится в Redis, поэтому подделать ссылку нельзя, а Use
+//гарантирует, что по ней войдут только один раз
+func (s *authService) RequestMagicLink(ctx context.Context, in *protoAuth.MagicLinkRequest) (*protoAuth.MagicLinkToken, error) {
+	message := logMessage + "RequestMagicLink:"
+	log.Debug(message + "started")
+	if in.Mail == "" {
+		return &protoAuth.MagicLinkToken{}, error2.ErrEmptyData
+	}
+	u, err := s.authUserRepository.GetUser(in.Mail)
+	if err == error2.ErrUserNotFound {
+		//Не сообщаем клиенту, что такой почты нет
+		log.Debug(message + "user not found")
+		return &protoAuth.MagicLinkToken{}, nil
+	}
+	if err != nil {
+		return &protoAuth.MagicLinkToken{}, err
+	}
+	if u.Banned {
+		return &protoAuth.MagicLinkToken{}, nil
+	}
+	token, err := generateSecureToken(magicLinkTokenLength)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.MagicLinkToken{}, err
+	}
+	err = s.authTokenRepository.Create(&authServiceModels.TokenData{
+		Token:      token,
+		Purpose:    magicLinkPurpose,
+		UserId:     u.ID,
+		Expiration: magicLinkLifeTime,
+	})
+	if err != nil {
+		return &protoAuth.MagicLinkToken{}, err
+	}
+	return &protoAuth.MagicLinkToken{Token: token}, nil
+}
+
+//Ссылка заменяет только пароль: с включённой 2FA возвращается токен подтверждения
+func (s *authService) ConfirmMagicLink(ctx context.Context, in *protoAuth.MagicLinkToken) (*protoAuth.SignInResponse, error) {
+	message := logMessage + "ConfirmMagicLink:"
+	log.Debug(message + "started")
+	if in.Token == "" {
+		return &protoAuth.SignInResponse{}, error2.ErrEmptyData
+	}
+	userId, err := s.authTokenRepository.Use(in.Token, magicLinkPurpose)
+	if err != nil {
+		return &protoAuth.SignInResponse{}, err
+	}
+	u, err := s.authUserRepository.GetUserById(userId)
+	if err != nil {
+		return &protoAuth.SignInResponse{}, err
+	}
+	if u.Banned {
+		return &protoAuth.SignInResponse{}, error2.ErrUserBanned
+	}
+	if u.TotpEnabled {
+		challengeToken, err := s.createTwoFactorChallenge(u.ID)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return &protoAuth.SignInResponse{}, err
+		}
+		return &protoAuth.SignInResponse{ChallengeToken: challengeToken}, nil
+	}
+	return &protoAuth.SignInResponse{ID: u.ID}, nil
+}
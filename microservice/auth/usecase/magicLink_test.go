@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	error2 "backend/service/auth/error"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var requestMagicLinkTests = []struct {
+	id         int
+	mail       string
+	user       *models.User
+	userErr    error
+	emptyToken bool
+	outputErr  error
+}{
+	{1, "test@mail.ru", &models.User{ID: "1", Mail: "test@mail.ru"}, nil, false, nil},
+	{2, "test@mail.ru", &models.User{}, error2.ErrUserNotFound, true, nil},
+	{3, "test@mail.ru", &models.User{ID: "1", Banned: true}, nil, true, nil},
+	{4, "", &models.User{}, nil, true, error2.ErrEmptyData},
+	{5, "test@mail.ru", &models.User{}, error2.ErrPostgres, true, error2.ErrPostgres},
+}
+
+func TestRequestMagicLink(t *testing.T) {
+	for _, test := range requestMagicLinkTests {
+		authRepositoryMock := new(AuthRepoMock)
+		tokenRepositoryMock := new(AuthTokenMock)
+		authRepositoryMock.On("GetUser", test.mail).Return(test.user, test.userErr)
+		tokenRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.TokenData) bool {
+			return data.UserId == test.user.ID && data.Purpose == magicLinkPurpose &&
+				data.Expiration == magicLinkLifeTime && data.Token != ""
+		})).Return(nil)
+
+		useCaseTest := NewService(authRepositoryMock, nil, tokenRepositoryMock, nil, nil, nil)
+		out, err := useCaseTest.RequestMagicLink(context.Background(), &protoAuth.MagicLinkRequest{Mail: test.mail})
+		assert.Equal(t, test.outputErr, err, test.id)
+		assert.Equal(t, test.emptyToken, out.Token == "", test.id)
+	}
+}
+
+var confirmMagicLinkTests = []struct {
+	id           int
+	token        string
+	useErr       error
+	user         *models.User
+	outputId     string
+	hasChallenge bool
+	outputErr    error
+}{
+	{1, "token", nil, &models.User{ID: "1"}, "1", false, nil},
+	{2, "token", nil, &models.User{ID: "1", TotpEnabled: true}, "", true, nil},
+	{3, "token", nil, &models.User{ID: "1", Banned: true}, "", false, error2.ErrUserBanned},
+	{4, "token", error2.ErrInvalidToken, &models.User{}, "", false, error2.ErrInvalidToken},
+	{5, "", nil, &models.User{}, "", false, error2.ErrEmptyData},
+}
+
+func TestConfirmMagicLink(t *testing.T) {
+	for _, test := range confirmMagicLinkTests {
+		authRepositoryMock := new(AuthRepoMock)
+		tokenRepositoryMock := new(AuthTokenMock)
+		tokenRepositoryMock.On("Use", test.token, magicLinkPurpose).Return("1", test.useErr)
+		authRepositoryMock.On("GetUserById", "1").Return(test.user, nil)
+		tokenRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.TokenData) bool {
+			return data.Purpose == twoFactorPurpose && data.UserId == "1"
+		})).Return(nil)
+
+		useCaseTest := NewService(authRepositoryMock, nil, tokenRepositoryMock, nil, nil, nil)
+		out, err := useCaseTest.ConfirmMagicLink(context.Background(), &protoAuth.MagicLinkToken{Token: test.token})
+		assert.Equal(t, test.outputErr, err, test.id)
+		assert.Equal(t, test.outputId, out.ID, test.id)
+		assert.Equal(t, test.hasChallenge, out.ChallengeToken != "", test.id)
+	}
+}
@@ -16,19 +16,34 @@ func (m *AuthClientMock) SignUp(ctx context.Context, in *protoAuth.SignUpRequest
 	return args.Get(0).(*protoAuth.UserId), args.Error(1)
 }
 
-func (m *AuthClientMock) SignIn(ctx context.Context, in *protoAuth.SignInRequest, opts ...grpc.CallOption) (*protoAuth.UserId, error) {
+func (m *AuthClientMock) SignIn(ctx context.Context, in *protoAuth.SignInRequest, opts ...grpc.CallOption) (*protoAuth.SignInResponse, error) {
 	args := m.Called(ctx, in)
-	return args.Get(0).(*protoAuth.UserId), args.Error(1)
+	return args.Get(0).(*protoAuth.SignInResponse), args.Error(1)
 }
 
-func (m *AuthClientMock) CreateSession(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.Session, error) {
+func (m *AuthClientMock) CreateSession(ctx context.Context, in *protoAuth.CreateSessionRequest, opts ...grpc.CallOption) (*protoAuth.Session, error) {
 	args := m.Called(ctx, in)
 	return args.Get(0).(*protoAuth.Session), args.Error(1)
 }
 
-func (m *AuthClientMock) CheckSession(ctx context.Context, in *protoAuth.Session, opts ...grpc.CallOption) (*protoAuth.UserId, error) {
+func (m *AuthClientMock) CheckSession(ctx context.Context, in *protoAuth.Session, opts ...grpc.CallOption) (*protoAuth.SessionUser, error) {
 	args := m.Called(ctx, in)
-	return args.Get(0).(*protoAuth.UserId), args.Error(1)
+	return args.Get(0).(*protoAuth.SessionUser), args.Error(1)
+}
+
+func (m *AuthClientMock) ListSessions(ctx context.Context, in *protoAuth.Session, opts ...grpc.CallOption) (*protoAuth.SessionList, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.SessionList), args.Error(1)
+}
+
+func (m *AuthClientMock) RevokeSession(ctx context.Context, in *protoAuth.RevokeSessionRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) RevokeAllOtherSessions(ctx context.Context, in *protoAuth.Session, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
 }
 
 func (m *AuthClientMock) DeleteSession(ctx context.Context, in *protoAuth.Session, opts ...grpc.CallOption) (*protoAuth.Success, error) {
@@ -44,4 +59,113 @@ func (m *AuthClientMock) CreateToken(ctx context.Context, in *protoAuth.UserId,
 func (m *AuthClientMock) CheckToken(ctx context.Context, in *protoAuth.CSRFToken, opts ...grpc.CallOption) (*protoAuth.UserId, error) {
 	args := m.Called(ctx, in)
 	return args.Get(0).(*protoAuth.UserId), args.Error(1)
-}
\ No newline at end of file
+}
+func (m *AuthClientMock) RequestPasswordReset(ctx context.Context, in *protoAuth.PasswordResetRequest, opts ...grpc.CallOption) (*protoAuth.PasswordResetToken, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.PasswordResetToken), args.Error(1)
+}
+
+func (m *AuthClientMock) ConfirmPasswordReset(ctx context.Context, in *protoAuth.ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) CreateVerificationToken(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.VerificationToken, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.VerificationToken), args.Error(1)
+}
+
+func (m *AuthClientMock) VerifyEmail(ctx context.Context, in *protoAuth.VerificationToken, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) IsEmailVerified(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.EmailVerified, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.EmailVerified), args.Error(1)
+}
+
+func (m *AuthClientMock) EnrollTotp(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.TotpEnrollment, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.TotpEnrollment), args.Error(1)
+}
+
+func (m *AuthClientMock) ConfirmTotp(ctx context.Context, in *protoAuth.TotpCodeRequest, opts ...grpc.CallOption) (*protoAuth.RecoveryCodes, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.RecoveryCodes), args.Error(1)
+}
+
+func (m *AuthClientMock) DisableTotp(ctx context.Context, in *protoAuth.TotpCodeRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) CompleteTwoFactorLogin(ctx context.Context, in *protoAuth.TwoFactorLoginRequest, opts ...grpc.CallOption) (*protoAuth.UserId, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.UserId), args.Error(1)
+}
+
+func (m *AuthClientMock) BanUser(ctx context.Context, in *protoAuth.BanUserRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) SetRole(ctx context.Context, in *protoAuth.SetRoleRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) CreateApiToken(ctx context.Context, in *protoAuth.CreateApiTokenRequest, opts ...grpc.CallOption) (*protoAuth.ApiTokenInfo, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.ApiTokenInfo), args.Error(1)
+}
+
+func (m *AuthClientMock) ListApiTokens(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.ApiTokenList, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.ApiTokenList), args.Error(1)
+}
+
+func (m *AuthClientMock) RevokeApiToken(ctx context.Context, in *protoAuth.RevokeApiTokenRequest, opts ...grpc.CallOption) (*protoAuth.Success, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.Success), args.Error(1)
+}
+
+func (m *AuthClientMock) CheckApiToken(ctx context.Context, in *protoAuth.ApiToken, opts ...grpc.CallOption) (*protoAuth.SessionUser, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.SessionUser), args.Error(1)
+}
+
+func (m *AuthClientMock) StartOidcLogin(ctx context.Context, in *protoAuth.OidcStartRequest, opts ...grpc.CallOption) (*protoAuth.OidcStartResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.OidcStartResponse), args.Error(1)
+}
+
+func (m *AuthClientMock) CompleteOidcLogin(ctx context.Context, in *protoAuth.OidcCallbackRequest, opts ...grpc.CallOption) (*protoAuth.SignInResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.SignInResponse), args.Error(1)
+}
+
+func (m *AuthClientMock) RequestMagicLink(ctx context.Context, in *protoAuth.MagicLinkRequest, opts ...grpc.CallOption) (*protoAuth.MagicLinkToken, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.MagicLinkToken), args.Error(1)
+}
+
+func (m *AuthClientMock) ConfirmMagicLink(ctx context.Context, in *protoAuth.MagicLinkToken, opts ...grpc.CallOption) (*protoAuth.SignInResponse, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.SignInResponse), args.Error(1)
+}
+
+func (m *AuthClientMock) DeleteAccount(ctx context.Context, in *protoAuth.DeleteAccountRequest, opts ...grpc.CallOption) (*protoAuth.DeletedAccount, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.DeletedAccount), args.Error(1)
+}
+
+func (m *AuthClientMock) ListAuthEvents(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.AuthEventList, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.AuthEventList), args.Error(1)
+}
+
+func (m *AuthClientMock) QueryAuthEvents(ctx context.Context, in *protoAuth.AuthEventQuery, opts ...grpc.CallOption) (*protoAuth.AuthEventList, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*protoAuth.AuthEventList), args.Error(1)
+}
@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	"backend/microservice/auth/oidc"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	oidcStatePurpose  = "oidc_state:"
+	oidcTokenLength   = 32
+	oidcStateLifeTime = time.Minute * 10
+	maxNameLength     = 50
+)
+
+//state и code_verifier хранятся одноразовым токеном: ключ - state (с провайдером в purpose),
+//значение - code_verifier
+func oidcPurpose(provider string) string {
+	return oidcStatePurpose + provider
+}
+
+//nonce выводим из state, чтобы не хранить его отдельно
+func oidcNonce(state string) string {
+	hash := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(hash[:])
+}
+
+//Провайдеры читаются из конфига при первом входе через OIDC
+func (s *authService) oidcProvider(name string) (*oidc.Provider, error) {
+	s.oidcMu.Lock()
+	defer s.oidcMu.Unlock()
+	if s.oidcProviders == nil {
+		providers, err := oidc.LoadProviders()
+		if err != nil {
+			return nil, err
+		}
+		s.oidcProviders = providers
+	}
+	provider, ok := s.oidcProviders[name]
+	if !ok {
+		return nil, oidc.ErrProviderNotFound
+	}
+	return provider, nil
+}
+
+func (s *authService) StartOidcLogin(ctx context.Context, in *protoAuth.OidcStartRequest) (*protoAuth.OidcStartResponse, error) {
+	message := logMessage + "StartOidcLogin:"
+	log.Debug(message + "started")
+	provider, err := s.oidcProvider(in.Provider)
+	if err != nil {
+		return &protoAuth.OidcStartResponse{}, err
+	}
+	state, err := generateSecureToken(oidcTokenLength)
+	if err != nil {
+		return &protoAuth.OidcStartResponse{}, err
+	}
+	verifier, err := generateSecureToken(oidcTokenLength)
+	if err != nil {
+		return &protoAuth.OidcStartResponse{}, err
+	}
+	err = s.authTokenRepository.Create(&authServiceModels.TokenData{
+		Token:      state,
+		Purpose:    oidcPurpose(in.Provider),
+		UserId:     verifier,
+		Expiration: oidcStateLifeTime,
+	})
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.OidcStartResponse{}, err
+	}
+	authURL, err := provider.AuthCodeURL(ctx, state, oidcNonce(state), verifier)
+	if err != nil {
+		return &protoAuth.OidcStartResponse{}, err
+	}
+	return &protoAuth.OidcStartResponse{AuthURL: authURL, State: state}, nil
+}
+
+//Как и SignIn, при включённой 2FA возвращает токен подтверждения вместо userId
+func (s *authService) CompleteOidcLogin(ctx context.Context, in *protoAuth.OidcCallbackRequest) (*protoAuth.SignInResponse, error) {
+	message := logMessage + "CompleteOidcLogin:"
+	log.Debug(message + "started")
+	if in.State == "" || in.Code == "" {
+		return &protoAuth.SignInResponse{}, error2.ErrEmptyData
+	}
+	provider, err := s.oidcProvider(in.Provider)
+	if err != nil {
+		return &protoAuth.SignInResponse{}, err
+	}
+	verifier, err := s.authTokenRepository.Use(in.State, oidcPurpose(in.Provider))
+	if err != nil {
+		return &protoAuth.SignInResponse{}, err
+	}
+	idToken, err := provider.Exchange(ctx, in.Code, verifier)
+	if err != nil {
+		return &protoAuth.SignInResponse{}, err
+	}
+	claims, err := provider.VerifyIdToken(ctx, idToken, oidcNonce(in.State))
+	if err != nil {
+		return &protoAuth.SignInResponse{}, err
+	}
+	u, err := s.oidcUser(in.Provider, claims)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.SignInResponse{}, err
+	}
+	if u.Banned {
+		return &protoAuth.SignInResponse{}, error2.ErrUserBanned
+	}
+	if u.TotpEnabled {
+		challengeToken, err := s.createTwoFactorChallenge(u.ID)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return &protoAuth.SignInResponse{}, err
+		}
+		return &protoAuth.SignInResponse{ChallengeToken: challengeToken}, nil
+	}
+	return &protoAuth.SignInResponse{ID: u.ID}, nil
+}
+
+//Ищет пользователя по привязанному внешнему аккаунту, затем по подтверждённой провайдером
+//почте; если такого нет - регистрирует нового. Внешний аккаунт привязывается к найденному
+func (s *authService) oidcUser(provider string, claims *oidc.Claims) (*models.User, error) {
+	u, err := s.authUserRepository.GetUserByIdentity(provider, claims.Subject)
+	if err == nil {
+		return u, nil
+	}
+	if err != error2.ErrUserNotFound {
+		return nil, err
+	}
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, error2.ErrOidcEmailNotVerified
+	}
+	u, err = s.authUserRepository.GetUser(claims.Email)
+	switch {
+	case err == error2.ErrUserNotFound:
+		u, err = s.createOidcUser(claims)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case !u.EmailVerified:
+		err = s.claimUnverifiedUser(u)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = s.authUserRepository.LinkIdentity(u.ID, provider, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+//Почту неподтверждённого аккаунта мог указать кто угодно. Владелец почты подтвердил её
+//у провайдера, поэтому сбрасываем пароль и сессии - иначе зарегистрировавший аккаунт заранее
+//сохранил бы к нему доступ
+func (s *authService) claimUnverifiedUser(u *models.User) error {
+	password, err := generateSecureToken(oidcTokenLength)
+	if err != nil {
+		return err
+	}
+	passwordHash, err := utils.CreatePasswordHash(password)
+	if err != nil {
+		return err
+	}
+	err = s.authUserRepository.UpdatePassword(u.ID, passwordHash)
+	if err != nil {
+		return err
+	}
+	err = s.authSessionRepository.DeleteAllByUser(u.ID)
+	if err != nil {
+		return err
+	}
+	err = s.authApiTokenRepository.DeleteAllByUser(u.ID)
+	if err != nil {
+		return err
+	}
+	err = s.authUserRepository.VerifyEmail(u.ID, u.Mail)
+	if err != nil {
+		return err
+	}
+	u.EmailVerified = true
+	return nil
+}
+
+func truncateName(name string) string {
+	runes := []rune(strings.TrimSpace(name))
+	if len(runes) > maxNameLength {
+		runes = runes[:maxNameLength]
+	}
+	return string(runes)
+}
+
+//Пароль случайный: войти можно через провайдера или после сброса пароля по почте
+func (s *authService) createOidcUser(claims *oidc.Claims) (*models.User, error) {
+	name := claims.GivenName
+	if name == "" {
+		name = claims.Name
+	}
+	if name == "" {
+		name = strings.Split(claims.Email, "@")[0]
+	}
+	password, err := generateSecureToken(oidcTokenLength)
+	if err != nil {
+		return nil, err
+	}
+	passwordHash, err := utils.CreatePasswordHash(password)
+	if err != nil {
+		return nil, err
+	}
+	u := &models.User{
+		Name:     truncateName(name),
+		Surname:  truncateName(claims.FamilyName),
+		Mail:     claims.Email,
+		Password: passwordHash,
+	}
+	u.ID, err = s.authUserRepository.CreateUser(u)
+	if err != nil {
+		return nil, err
+	}
+	err = s.authUserRepository.VerifyEmail(u.ID, u.Mail)
+	if err != nil {
+		return nil, err
+	}
+	u.EmailVerified = true
+	return u, nil
+}
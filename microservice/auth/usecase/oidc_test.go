@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	"backend/microservice/auth/oidc"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	error2 "backend/service/auth/error"
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const oidcTestProvider = "mock"
+
+func newOidcTestService(t *testing.T, userRepo *AuthRepoMock, sessionRepo *AuthSessionMock, tokenRepo *AuthTokenMock) (*authService, *oidc.MockServer) {
+	server, err := oidc.NewMockServer()
+	assert.NoError(t, err)
+	t.Cleanup(server.Close)
+	useCaseTest := NewService(userRepo, sessionRepo, tokenRepo, nil, nil, nil)
+	useCaseTest.oidcProviders = map[string]*oidc.Provider{
+		oidcTestProvider: oidc.NewProvider(server.Config(oidcTestProvider), nil),
+	}
+	return useCaseTest, server
+}
+
+//Проходит вход целиком: StartOidcLogin, выдача code провайдером и CompleteOidcLogin
+func completeOidcTestLogin(t *testing.T, useCaseTest *authService, server *oidc.MockServer, tokenRepo *AuthTokenMock, grant oidc.MockGrant) (*protoAuth.SignInResponse, error) {
+	var verifier string
+	tokenRepo.On("Create", mock.MatchedBy(func(data *authServiceModels.TokenData) bool {
+		if data.Purpose != oidcPurpose(oidcTestProvider) {
+			return false
+		}
+		verifier = data.UserId
+		return data.Expiration == oidcStateLifeTime
+	})).Return(nil).Once()
+	start, err := useCaseTest.StartOidcLogin(context.Background(), &protoAuth.OidcStartRequest{Provider: oidcTestProvider})
+	assert.NoError(t, err)
+
+	grant.Challenge = oidc.CodeChallenge(verifier)
+	grant.Nonce = oidcNonce(start.State)
+	server.Grant("code", grant)
+	tokenRepo.On("Use", start.State, oidcPurpose(oidcTestProvider)).Return(verifier, nil).Once()
+	in := &protoAuth.OidcCallbackRequest{
+		Provider: oidcTestProvider,
+		State:    start.State,
+		Code:     "code",
+	}
+	return useCaseTest.CompleteOidcLogin(context.Background(), in)
+}
+
+func TestStartOidcLogin(t *testing.T) {
+	tokenRepositoryMock := new(AuthTokenMock)
+	useCaseTest, _ := newOidcTestService(t, nil, nil, tokenRepositoryMock)
+
+	var state, verifier string
+	tokenRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.TokenData) bool {
+		state, verifier = data.Token, data.UserId
+		return data.Purpose == oidcPurpose(oidcTestProvider)
+	})).Return(nil)
+	out, err := useCaseTest.StartOidcLogin(context.Background(), &protoAuth.OidcStartRequest{Provider: oidcTestProvider})
+	assert.NoError(t, err)
+	assert.Equal(t, state, out.State)
+	authURL, err := url.Parse(out.AuthURL)
+	assert.NoError(t, err)
+	assert.Equal(t, state, authURL.Query().Get("state"))
+	assert.Equal(t, oidcNonce(state), authURL.Query().Get("nonce"))
+	assert.Equal(t, oidc.CodeChallenge(verifier), authURL.Query().Get("code_challenge"))
+	assert.NotEqual(t, state, verifier)
+
+	_, err = useCaseTest.StartOidcLogin(context.Background(), &protoAuth.OidcStartRequest{Provider: "unknown"})
+	assert.Equal(t, oidc.ErrProviderNotFound, err)
+	tokenRepositoryMock.AssertExpectations(t)
+}
+
+func TestCompleteOidcLoginNewUser(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	tokenRepositoryMock := new(AuthTokenMock)
+	useCaseTest, server := newOidcTestService(t, authRepositoryMock, nil, tokenRepositoryMock)
+
+	mail := "new@mail.ru"
+	authRepositoryMock.On("GetUserByIdentity", oidcTestProvider, "sub").Return((*models.User)(nil), error2.ErrUserNotFound)
+	authRepositoryMock.On("GetUser", mail).Return((*models.User)(nil), error2.ErrUserNotFound)
+	authRepositoryMock.On("CreateUser", mock.MatchedBy(func(u *models.User) bool {
+		return u.Mail == mail && u.Name == "Ivan" && u.Password != ""
+	})).Return("5", nil)
+	authRepositoryMock.On("VerifyEmail", "5", mail).Return(nil)
+	authRepositoryMock.On("LinkIdentity", "5", oidcTestProvider, "sub").Return(nil)
+
+	out, err := completeOidcTestLogin(t, useCaseTest, server, tokenRepositoryMock, oidc.MockGrant{
+		Subject:       "sub",
+		Email:         mail,
+		EmailVerified: true,
+		Name:          "Ivan",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "5", out.ID)
+	authRepositoryMock.AssertExpectations(t)
+}
+
+func TestCompleteOidcLoginLinkedWithTotp(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	tokenRepositoryMock := new(AuthTokenMock)
+	useCaseTest, server := newOidcTestService(t, authRepositoryMock, nil, tokenRepositoryMock)
+
+	authRepositoryMock.On("GetUserByIdentity", oidcTestProvider, "sub").Return(&models.User{ID: "1", TotpEnabled: true}, nil)
+	tokenRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.TokenData) bool {
+		return data.Purpose == twoFactorPurpose && data.UserId == "1"
+	})).Return(nil)
+
+	out, err := completeOidcTestLogin(t, useCaseTest, server, tokenRepositoryMock, oidc.MockGrant{Subject: "sub"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", out.ID)
+	assert.NotEqual(t, "", out.ChallengeToken)
+	authRepositoryMock.AssertNotCalled(t, "LinkIdentity", mock.Anything, mock.Anything, mock.Anything)
+	tokenRepositoryMock.AssertExpectations(t)
+}
+
+func TestCompleteOidcLoginClaimsUnverifiedUser(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	sessionRepositoryMock := new(AuthSessionMock)
+	tokenRepositoryMock := new(AuthTokenMock)
+	apiTokenRepositoryMock := new(AuthApiTokenMock)
+	useCaseTest, server := newOidcTestService(t, authRepositoryMock, sessionRepositoryMock, tokenRepositoryMock)
+	useCaseTest.authApiTokenRepository = apiTokenRepositoryMock
+
+	mail := "old@mail.ru"
+	authRepositoryMock.On("GetUserByIdentity", oidcTestProvider, "sub").Return((*models.User)(nil), error2.ErrUserNotFound)
+	authRepositoryMock.On("GetUser", mail).Return(&models.User{ID: "2", Mail: mail}, nil)
+	authRepositoryMock.On("UpdatePassword", "2", mock.Anything).Return(nil)
+	sessionRepositoryMock.On("DeleteAllByUser", "2").Return(nil)
+	apiTokenRepositoryMock.On("DeleteAllByUser", "2").Return(nil)
+	authRepositoryMock.On("VerifyEmail", "2", mail).Return(nil)
+	authRepositoryMock.On("LinkIdentity", "2", oidcTestProvider, "sub").Return(nil)
+
+	out, err := completeOidcTestLogin(t, useCaseTest, server, tokenRepositoryMock, oidc.MockGrant{
+		Subject:       "sub",
+		Email:         mail,
+		EmailVerified: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", out.ID)
+	authRepositoryMock.AssertExpectations(t)
+	sessionRepositoryMock.AssertExpectations(t)
+	apiTokenRepositoryMock.AssertExpectations(t)
+}
+
+func TestCompleteOidcLoginErrors(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	tokenRepositoryMock := new(AuthTokenMock)
+	useCaseTest, server := newOidcTestService(t, authRepositoryMock, nil, tokenRepositoryMock)
+
+	//Почта не подтверждена провайдером - привязывать по ней нельзя
+	authRepositoryMock.On("GetUserByIdentity", oidcTestProvider, "unverified").Return((*models.User)(nil), error2.ErrUserNotFound)
+	_, err := completeOidcTestLogin(t, useCaseTest, server, tokenRepositoryMock, oidc.MockGrant{
+		Subject: "unverified",
+		Email:   "mail@mail.ru",
+	})
+	assert.Equal(t, error2.ErrOidcEmailNotVerified, err)
+	authRepositoryMock.AssertNotCalled(t, "GetUser", mock.Anything)
+
+	authRepositoryMock.On("GetUserByIdentity", oidcTestProvider, "banned").Return(&models.User{ID: "3", Banned: true}, nil)
+	_, err = completeOidcTestLogin(t, useCaseTest, server, tokenRepositoryMock, oidc.MockGrant{Subject: "banned"})
+	assert.Equal(t, error2.ErrUserBanned, err)
+
+	//state истёк или уже использован
+	tokenRepositoryMock.On("Use", "stale", oidcPurpose(oidcTestProvider)).Return("", error2.ErrInvalidToken)
+	in := &protoAuth.OidcCallbackRequest{
+		Provider: oidcTestProvider,
+		State:    "stale",
+		Code:     "code",
+	}
+	_, err = useCaseTest.CompleteOidcLogin(context.Background(), in)
+	assert.Equal(t, error2.ErrInvalidToken, err)
+
+	in.Code = ""
+	_, err = useCaseTest.CompleteOidcLogin(context.Background(), in)
+	assert.Equal(t, error2.ErrEmptyData, err)
+}
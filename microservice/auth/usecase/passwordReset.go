@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	passwordResetPurpose     = "password_reset"
+	passwordResetTokenLength = 32
+	passwordResetLifeTime    = time.Minute * 30
+)
+
+func generateSecureToken(n int) (string, error) {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (s *authService) RequestPasswordReset(ctx context.Context, in *protoAuth.PasswordResetRequest) (*protoAuth.PasswordResetToken, error) {
+	message := logMessage + "RequestPasswordReset:"
+	log.Debug(message + "started")
+	if in.Mail == "" {
+		return &protoAuth.PasswordResetToken{}, error2.ErrEmptyData
+	}
+	u, err := s.authUserRepository.GetUser(in.Mail)
+	if err == error2.ErrUserNotFound {
+		//Не сообщаем клиенту, что такой почты нет
+		log.Debug(message + "user not found")
+		return &protoAuth.PasswordResetToken{}, nil
+	}
+	if err != nil {
+		return &protoAuth.PasswordResetToken{}, err
+	}
+	token, err := generateSecureToken(passwordResetTokenLength)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.PasswordResetToken{}, err
+	}
+	err = s.authTokenRepository.Create(&authServiceModels.TokenData{
+		Token:      token,
+		Purpose:    passwordResetPurpose,
+		UserId:     u.ID,
+		Expiration: passwordResetLifeTime,
+	})
+	if err != nil {
+		return &protoAuth.PasswordResetToken{}, err
+	}
+	return &protoAuth.PasswordResetToken{Token: token}, nil
+}
+
+func (s *authService) ConfirmPasswordReset(ctx context.Context, in *protoAuth.ConfirmPasswordResetRequest) (*protoAuth.Success, error) {
+	message := logMessage + "ConfirmPasswordReset:"
+	log.Debug(message + "started")
+	if in.Token == "" || in.Password == "" {
+		return &protoAuth.Success{}, error2.ErrEmptyData
+	}
+	userId, err := s.authTokenRepository.Use(in.Token, passwordResetPurpose)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	passwordHash, err := utils.CreatePasswordHash(in.Password)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	err = s.authUserRepository.UpdatePassword(userId, passwordHash)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	err = s.authSessionRepository.DeleteAllByUser(userId)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.Success{}, err
+	}
+	//API-токены живут дольше сессий, их тоже отзываем
+	err = s.authApiTokenRepository.DeleteAllByUser(userId)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.Success{}, err
+	}
+	s.recordAuthEvent(ctx, &authServiceModels.AuthEventData{
+		UserId: userId,
+		Type:   models.AuthEventPasswordChange,
+	})
+	return &protoAuth.Success{Ok: "success"}, nil
+}
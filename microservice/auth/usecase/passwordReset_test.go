@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var requestPasswordResetTests = []struct {
+	id         int
+	mail       string
+	user       *models.User
+	userErr    error
+	emptyToken bool
+	outputErr  error
+}{
+	{
+		1,
+		"test@mail.ru",
+		&models.User{ID: "1", Mail: "test@mail.ru"},
+		nil,
+		false,
+		nil,
+	},
+	{
+		2,
+		"test@mail.ru",
+		&models.User{},
+		error2.ErrUserNotFound,
+		true,
+		nil,
+	},
+	{
+		3,
+		"",
+		&models.User{},
+		nil,
+		true,
+		error2.ErrEmptyData,
+	},
+	{
+		4,
+		"test@mail.ru",
+		&models.User{},
+		error2.ErrPostgres,
+		true,
+		error2.ErrPostgres,
+	},
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	for _, test := range requestPasswordResetTests {
+		authRepositoryMock := new(AuthRepoMock)
+		tokenRepositoryMock := new(AuthTokenMock)
+		authRepositoryMock.On("GetUser", test.mail).Return(test.user, test.userErr)
+		tokenRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.TokenData) bool {
+			return data.UserId == test.user.ID && data.Purpose == passwordResetPurpose &&
+				data.Expiration == passwordResetLifeTime && data.Token != ""
+		})).Return(nil)
+
+		useCaseTest := NewService(authRepositoryMock, nil, tokenRepositoryMock, nil, nil, nil)
+		out, err := useCaseTest.RequestPasswordReset(context.Background(), &protoAuth.PasswordResetRequest{Mail: test.mail})
+		assert.Equal(t, test.outputErr, err, test.id)
+		assert.Equal(t, test.emptyToken, out.Token == "", test.id)
+	}
+}
+
+var confirmPasswordResetTests = []struct {
+	id        int
+	token     string
+	password  string
+	useErr    error
+	updateErr error
+	deleteErr error
+	outputErr error
+}{
+	{
+		1,
+		"token",
+		"newPassword",
+		nil,
+		nil,
+		nil,
+		nil,
+	},
+	{
+		2,
+		"token",
+		"newPassword",
+		error2.ErrInvalidToken,
+		nil,
+		nil,
+		error2.ErrInvalidToken,
+	},
+	{
+		3,
+		"",
+		"newPassword",
+		nil,
+		nil,
+		nil,
+		error2.ErrEmptyData,
+	},
+	{
+		4,
+		"token",
+		"newPassword",
+		nil,
+		error2.ErrPostgres,
+		nil,
+		error2.ErrPostgres,
+	},
+	{
+		5,
+		"token",
+		"newPassword",
+		nil,
+		nil,
+		errors.New("redis error"),
+		errors.New("redis error"),
+	},
+}
+
+func TestConfirmPasswordReset(t *testing.T) {
+	for _, test := range confirmPasswordResetTests {
+		authRepositoryMock := new(AuthRepoMock)
+		sessionRepositoryMock := new(AuthSessionMock)
+		tokenRepositoryMock := new(AuthTokenMock)
+		userId := "1"
+		tokenRepositoryMock.On("Use", test.token, passwordResetPurpose).Return(userId, test.useErr)
+		authRepositoryMock.On("UpdatePassword", userId, mock.MatchedBy(func(hash string) bool {
+			match, _ := utils.CheckPasswordHash(test.password, hash)
+			return match
+		})).Return(test.updateErr)
+		sessionRepositoryMock.On("DeleteAllByUser", userId).Return(test.deleteErr)
+		apiTokenRepositoryMock := new(AuthApiTokenMock)
+		apiTokenRepositoryMock.On("DeleteAllByUser", userId).Return(nil)
+
+		useCaseTest := NewService(authRepositoryMock, sessionRepositoryMock, tokenRepositoryMock, nil, apiTokenRepositoryMock, nil)
+		in := &protoAuth.ConfirmPasswordResetRequest{
+			Token:    test.token,
+			Password: test.password,
+		}
+		out, err := useCaseTest.ConfirmPasswordReset(context.Background(), in)
+		assert.Equal(t, test.outputErr, err, test.id)
+		if test.outputErr == nil {
+			assert.Equal(t, "success", out.Ok)
+			sessionRepositoryMock.AssertExpectations(t)
+			apiTokenRepositoryMock.AssertExpectations(t)
+		}
+	}
+}
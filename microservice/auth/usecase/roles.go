@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	error2 "backend/service/auth/error"
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+//Блокирует (или разблокирует) пользователя. Модераторов и админов блокировать нельзя -
+//сначала нужно снять с них роль
+func (s *authService) BanUser(ctx context.Context, in *protoAuth.BanUserRequest) (*protoAuth.Success, error) {
+	message := logMessage + "BanUser:"
+	log.Debug(message + "started")
+	if in.UserId == "" {
+		return &protoAuth.Success{}, error2.ErrEmptyData
+	}
+	role, _, err := s.authUserRepository.GetRole(in.UserId)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	if in.Banned && role != models.RoleUser {
+		return &protoAuth.Success{}, error2.ErrForbidden
+	}
+	err = s.authUserRepository.SetBanned(in.UserId, in.Banned)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	if in.Banned {
+		err = s.authSessionRepository.DeleteAllByUser(in.UserId)
+		if err != nil {
+			//Оставшиеся сессии всё равно отклонит CheckSession
+			log.Error(message+"err = ", err)
+		}
+	}
+	return &protoAuth.Success{Ok: "success"}, nil
+}
+
+func (s *authService) SetRole(ctx context.Context, in *protoAuth.SetRoleRequest) (*protoAuth.Success, error) {
+	message := logMessage + "SetRole:"
+	log.Debug(message + "started")
+	if in.UserId == "" {
+		return &protoAuth.Success{}, error2.ErrEmptyData
+	}
+	if !models.IsValidRole(in.Role) {
+		return &protoAuth.Success{}, error2.ErrInvalidRole
+	}
+	err := s.authUserRepository.SetRole(in.UserId, in.Role)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	return &protoAuth.Success{Ok: "success"}, nil
+}
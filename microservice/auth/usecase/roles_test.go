@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	protoAuth "backend/microservice/auth/proto"
+	error2 "backend/service/auth/error"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var banUserTests = []struct {
+	id          int
+	role        string
+	banned      bool
+	outputErr   error
+	setsBanned  bool
+	dropSession bool
+}{
+	{
+		1,
+		"user",
+		true,
+		nil,
+		true,
+		true,
+	},
+	{
+		2,
+		"moderator",
+		true,
+		error2.ErrForbidden,
+		false,
+		false,
+	},
+	{
+		3,
+		"user",
+		false,
+		nil,
+		true,
+		false,
+	},
+}
+
+func TestBanUser(t *testing.T) {
+	for _, test := range banUserTests {
+		authRepositoryMock := new(AuthRepoMock)
+		sessionRepositoryMock := new(AuthSessionMock)
+		useCaseTest := NewService(authRepositoryMock, sessionRepositoryMock, nil, nil, nil, nil)
+
+		authRepositoryMock.On("GetRole", "2").Return(test.role, false, nil)
+		authRepositoryMock.On("SetBanned", "2", test.banned).Return(nil)
+		sessionRepositoryMock.On("DeleteAllByUser", "2").Return(nil)
+
+		in := &protoAuth.BanUserRequest{
+			UserId: "2",
+			Banned: test.banned,
+		}
+		_, err := useCaseTest.BanUser(context.Background(), in)
+		assert.Equal(t, test.outputErr, err, test.id)
+		if test.setsBanned {
+			authRepositoryMock.AssertCalled(t, "SetBanned", "2", test.banned)
+		} else {
+			authRepositoryMock.AssertNotCalled(t, "SetBanned", "2", test.banned)
+		}
+		if test.dropSession {
+			sessionRepositoryMock.AssertCalled(t, "DeleteAllByUser", "2")
+		} else {
+			sessionRepositoryMock.AssertNotCalled(t, "DeleteAllByUser", "2")
+		}
+	}
+}
+
+func TestSetRole(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil)
+	authRepositoryMock.On("SetRole", "2", "moderator").Return(nil)
+
+	_, err := useCaseTest.SetRole(context.Background(), &protoAuth.SetRoleRequest{UserId: "2", Role: "moderator"})
+	assert.NoError(t, err)
+
+	_, err = useCaseTest.SetRole(context.Background(), &protoAuth.SetRoleRequest{UserId: "2", Role: "superuser"})
+	assert.Equal(t, error2.ErrInvalidRole, err)
+	authRepositoryMock.AssertNumberOfCalls(t, "SetRole", 1)
+}
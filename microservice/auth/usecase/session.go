@@ -3,12 +3,18 @@ package usecase
 import (
 	authServiceModels "backend/microservice/auth/models"
 	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	error2 "backend/service/auth/error"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"math/rand"
-	"time"
+	"sort"
 	"strconv"
+	"time"
 )
 
 var (
@@ -28,19 +34,74 @@ func generateSessionId(n int) string {
 	return string(b)
 }
 
+//Наружу отдаём не сам sessionId (его можно использовать как cookie), а его хэш
+func publicSessionId(sessionId string) string {
+	hash := sha256.Sum256([]byte(sessionId))
+	return hex.EncodeToString(hash[:16])
+}
+
+func toProtoSessionInfo(data *authServiceModels.SessionData, currentSessionId string) *protoAuth.SessionInfo {
+	return &protoAuth.SessionInfo{
+		ID:        publicSessionId(data.SessionId),
+		CreatedAt: data.CreatedAt.Format(time.RFC3339),
+		LastSeen:  data.LastSeen.Format(time.RFC3339),
+		UserAgent: data.UserAgent,
+		IP:        data.IP,
+		Current:   data.SessionId == currentSessionId,
+	}
+}
+
 const (
 	sessionIdLength = 16
-	sessionLifeTime = time.Hour * 24
+
+	defaultIdleLifetime               = time.Hour * 2
+	defaultAbsoluteLifetime           = time.Hour * 24
+	defaultRememberMeIdleLifetime     = time.Hour * 24 * 14
+	defaultRememberMeAbsoluteLifetime = time.Hour * 24 * 30
 )
 
-func (s *authService) CreateSession(ctx context.Context, protoUserId *protoAuth.UserId) (*protoAuth.Session, error) {
+func durationFromConfig(key string, defaultValue time.Duration) time.Duration {
+	value := viper.GetDuration(key)
+	if value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+//Idle - сколько сессия живёт без активности, absolute - максимальный срок жизни сессии
+func sessionLifetimes(rememberMe bool) (time.Duration, time.Duration) {
+	if rememberMe {
+		return durationFromConfig("session.remember_me_idle_lifetime", defaultRememberMeIdleLifetime),
+			durationFromConfig("session.remember_me_absolute_lifetime", defaultRememberMeAbsoluteLifetime)
+	}
+	return durationFromConfig("session.idle_lifetime", defaultIdleLifetime),
+		durationFromConfig("session.absolute_lifetime", defaultAbsoluteLifetime)
+}
+
+//TTL сессии в redis: idle, но не дольше, чем осталось до абсолютного истечения
+func sessionExpiration(data *authServiceModels.SessionData, now time.Time) time.Duration {
+	idle, absolute := sessionLifetimes(data.RememberMe)
+	remaining := data.CreatedAt.Add(absolute).Sub(now)
+	if remaining < idle {
+		return remaining
+	}
+	return idle
+}
+
+func (s *authService) CreateSession(ctx context.Context, in *protoAuth.CreateSessionRequest) (*protoAuth.Session, error) {
 	message := logMessage + "CreateSession:"
 	log.Debug(message + "started")
+	now := time.Now()
 	sessionData := &authServiceModels.SessionData{
 		SessionId:  generateSessionId(sessionIdLength),
-		UserId:     protoUserId.ID,
-		Expiration: sessionLifeTime,
+		UserId:     in.UserId,
+		CreatedAt:  now,
+		LastSeen:   now,
+		UserAgent:  in.UserAgent,
+		IP:         in.IP,
+		RememberMe: in.RememberMe,
 	}
+	sessionData.Expiration = sessionExpiration(sessionData, now)
 	id, _ := strconv.Atoi(sessionData.UserId)
 	if id <= 0 {
 		sessionData.SessionId = ""
@@ -49,25 +110,67 @@ func (s *authService) CreateSession(ctx context.Context, protoUserId *protoAuth.
 	if err != nil {
 		return &protoAuth.Session{}, err
 	}
+	//Сессия создаётся после любого способа входа, поэтому успешный вход пишем здесь
+	if sessionData.SessionId != "" {
+		s.recordAuthEvent(ctx, &authServiceModels.AuthEventData{
+			UserId:    sessionData.UserId,
+			Type:      models.AuthEventLogin,
+			IP:        sessionData.IP,
+			UserAgent: sessionData.UserAgent,
+		})
+	}
 	response := &protoAuth.Session{
 		Session: sessionData.SessionId,
 	}
+	//Cookie без remember me живёт до закрытия браузера
+	if sessionData.RememberMe {
+		_, absolute := sessionLifetimes(true)
+		response.MaxAge = int64(absolute.Seconds())
+	}
 	return response, err
 }
 
-func (s *authService) CheckSession(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.UserId, error) {
+func (s *authService) CheckSession(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.SessionUser, error) {
 	message := logMessage + "CheckSession:"
 	log.Debug(message + "started")
 	sessionId := protoSession.Session
 	if sessionId == "" {
-		return &protoAuth.UserId{}, ErrEmptySessionId
+		return &protoAuth.SessionUser{}, ErrEmptySessionId
 	}
-	userId, err := s.authSessionRepository.Check(sessionId)
+	sessionData, err := s.authSessionRepository.Get(sessionId)
+	if err != nil {
+		return &protoAuth.SessionUser{}, err
+	}
+	now := time.Now()
+	expiration := sessionExpiration(sessionData, now)
+	if expiration <= 0 {
+		err = s.authSessionRepository.Delete(sessionId)
+		if err != nil {
+			log.Error(message+"err = ", err)
+		}
+		return &protoAuth.SessionUser{}, error2.ErrSessionExpired
+	}
+	//Роль читаем при каждой проверке, чтобы её смена действовала сразу
+	role, banned, err := s.authUserRepository.GetRole(sessionData.UserId)
+	if err != nil {
+		return &protoAuth.SessionUser{}, err
+	}
+	if banned {
+		err = s.authSessionRepository.Delete(sessionId)
+		if err != nil {
+			log.Error(message+"err = ", err)
+		}
+		return &protoAuth.SessionUser{}, error2.ErrUserBanned
+	}
+	sessionData.LastSeen = now
+	sessionData.Expiration = expiration
+	err = s.authSessionRepository.Refresh(sessionData)
 	if err != nil {
-		return &protoAuth.UserId{}, err
+		log.Error(message+"err = ", err)
 	}
-	response := &protoAuth.UserId{
-		ID: userId,
+	response := &protoAuth.SessionUser{
+		ID:   sessionData.UserId,
+		Role: role,
 	}
 	return response, nil
 }
@@ -75,12 +178,112 @@ func (s *authService) CheckSession(ctx context.Context, protoSession *protoAuth.
 func (s *authService) DeleteSession(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.Success, error) {
 	message := logMessage + "DeleteSession:"
 	log.Debug(message + "started")
+	//Владельца узнаём до удаления, потом сессии уже не будет
+	userId := s.sessionOwner(protoSession.Session)
 	err := s.authSessionRepository.Delete(protoSession.Session)
 	if err != nil {
 		return &protoAuth.Success{}, err
 	}
+	if userId != "" {
+		s.recordAuthEvent(ctx, &authServiceModels.AuthEventData{
+			UserId: userId,
+			Type:   models.AuthEventLogout,
+		})
+	}
 	response := &protoAuth.Success{
 		Ok: "success",
 	}
 	return response, nil
 }
+
+func (s *authService) ListSessions(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.SessionList, error) {
+	message := logMessage + "ListSessions:"
+	log.Debug(message + "started")
+	sessionId := protoSession.Session
+	if sessionId == "" {
+		return &protoAuth.SessionList{}, ErrEmptySessionId
+	}
+	userId, err := s.authSessionRepository.Check(sessionId)
+	if err != nil {
+		return &protoAuth.SessionList{}, err
+	}
+	sessions, err := s.authSessionRepository.ListByUser(userId)
+	if err != nil {
+		return &protoAuth.SessionList{}, err
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen.After(sessions[j].LastSeen)
+	})
+	result := make([]*protoAuth.SessionInfo, len(sessions))
+	for i, data := range sessions {
+		result[i] = toProtoSessionInfo(data, sessionId)
+	}
+	return &protoAuth.SessionList{Sessions: result}, nil
+}
+
+func (s *authService) RevokeSession(ctx context.Context, in *protoAuth.RevokeSessionRequest) (*protoAuth.Success, error) {
+	message := logMessage + "RevokeSession:"
+	log.Debug(message + "started")
+	if in.Session == "" {
+		return &protoAuth.Success{}, ErrEmptySessionId
+	}
+	if in.ID == "" {
+		return &protoAuth.Success{}, error2.ErrEmptyData
+	}
+	userId, err := s.authSessionRepository.Check(in.Session)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	sessions, err := s.authSessionRepository.ListByUser(userId)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	for _, data := range sessions {
+		if publicSessionId(data.SessionId) != in.ID {
+			continue
+		}
+		err = s.authSessionRepository.Delete(data.SessionId)
+		if err != nil {
+			return &protoAuth.Success{}, err
+		}
+		s.recordAuthEvent(ctx, &authServiceModels.AuthEventData{
+			UserId: userId,
+			Type:   models.AuthEventSessionRevoke,
+		})
+		return &protoAuth.Success{Ok: "success"}, nil
+	}
+	return &protoAuth.Success{}, error2.ErrSessionNotFound
+}
+
+func (s *authService) RevokeAllOtherSessions(ctx context.Context, protoSession *protoAuth.Session) (*protoAuth.Success, error) {
+	message := logMessage + "RevokeAllOtherSessions:"
+	log.Debug(message + "started")
+	sessionId := protoSession.Session
+	if sessionId == "" {
+		return &protoAuth.Success{}, ErrEmptySessionId
+	}
+	userId, err := s.authSessionRepository.Check(sessionId)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	sessions, err := s.authSessionRepository.ListByUser(userId)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	for _, data := range sessions {
+		if data.SessionId == sessionId {
+			continue
+		}
+		err = s.authSessionRepository.Delete(data.SessionId)
+		if err != nil {
+			return &protoAuth.Success{}, err
+		}
+	}
+	if len(sessions) > 1 {
+		s.recordAuthEvent(ctx, &authServiceModels.AuthEventData{
+			UserId: userId,
+			Type:   models.AuthEventSessionRevoke,
+		})
+	}
+	return &protoAuth.Success{Ok: "success"}, nil
+}
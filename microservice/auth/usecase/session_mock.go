@@ -24,6 +24,26 @@ func (m *AuthSessionMock) Delete(sessionId string) (error) {
 	return args.Error(0)
 }
 
+func (m *AuthSessionMock) DeleteAllByUser(userId string) error {
+	args := m.Called(userId)
+	return args.Error(0)
+}
+
+func (m *AuthSessionMock) Get(sessionId string) (*authServiceModels.SessionData, error) {
+	args := m.Called(sessionId)
+	return args.Get(0).(*authServiceModels.SessionData), args.Error(1)
+}
+
+func (m *AuthSessionMock) Refresh(data *authServiceModels.SessionData) error {
+	args := m.Called(data)
+	return args.Error(0)
+}
+
+func (m *AuthSessionMock) ListByUser(userId string) ([]*authServiceModels.SessionData, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*authServiceModels.SessionData), args.Error(1)
+}
+
 /*
 func (m *AuthClientMock) CreateToken(ctx context.Context, in *protoAuth.UserId, opts ...grpc.CallOption) (*protoAuth.CSRFToken, error) {
 	args := m.Called(ctx, in)
@@ -3,32 +3,54 @@ package usecase
 import (
 	authServiceModels "backend/microservice/auth/models"
 	protoAuth "backend/microservice/auth/proto"
+	error2 "backend/service/auth/error"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 
 func TestCreateSession(t *testing.T) {
 	sessionRepositoryMock := new(AuthSessionMock)
 	authRepositoryMock := new(AuthRepoMock)
-	useCaseTest := NewService(authRepositoryMock,sessionRepositoryMock)
+	useCaseTest := NewService(authRepositoryMock, sessionRepositoryMock, nil, nil, nil, nil)
 	userId := "-1"
-	sessionData := &authServiceModels.SessionData{
-		SessionId: "",
-		UserId: userId,
-		Expiration: sessionLifeTime,
-	}
-	sessionRepositoryMock.On("Create",sessionData).Return(nil)
+	sessionRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.SessionData) bool {
+		return data.SessionId == "" && data.UserId == userId && data.Expiration == defaultIdleLifetime &&
+			data.UserAgent == "Mozilla/5.0" && data.IP == "127.0.0.1" && !data.CreatedAt.IsZero()
+	})).Return(nil)
 	ctx := context.Background()
-	protoUserId := &protoAuth.UserId{
-		ID: userId,
+	in := &protoAuth.CreateSessionRequest{
+		UserId:    userId,
+		UserAgent: "Mozilla/5.0",
+		IP:        "127.0.0.1",
 	}
-	protoSession, err := useCaseTest.CreateSession(ctx,protoUserId)
+	protoSession, err := useCaseTest.CreateSession(ctx, in)
 
 	assert.Equal(t, len(protoSession.Session), 0)
+	assert.Equal(t, int64(0), protoSession.MaxAge)
+	assert.NoError(t, err)
+	sessionRepositoryMock.AssertExpectations(t)
+}
+
+func TestCreateSessionRememberMe(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil)
+	sessionRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.SessionData) bool {
+		return len(data.SessionId) == sessionIdLength && data.RememberMe &&
+			data.Expiration == defaultRememberMeIdleLifetime
+	})).Return(nil)
+	in := &protoAuth.CreateSessionRequest{
+		UserId:     "1",
+		RememberMe: true,
+	}
+	protoSession, err := useCaseTest.CreateSession(context.Background(), in)
+
 	assert.NoError(t, err)
+	assert.Equal(t, int64(defaultRememberMeAbsoluteLifetime.Seconds()), protoSession.MaxAge)
 	sessionRepositoryMock.AssertExpectations(t)
 }
 
@@ -38,9 +60,20 @@ func TestCheckSession(t *testing.T) {
 
 	sessionId := "1111111111111111"
 	expUserId := "1"
-	sessionRepositoryMock.On("Check", sessionId).Return(expUserId, nil)
+	sessionData := &authServiceModels.SessionData{
+		SessionId: sessionId,
+		UserId:    expUserId,
+		CreatedAt: time.Now().Add(-defaultAbsoluteLifetime + time.Hour),
+	}
+	sessionRepositoryMock.On("Get", sessionId).Return(sessionData, nil)
+	sessionRepositoryMock.On("Refresh", mock.MatchedBy(func(data *authServiceModels.SessionData) bool {
+		//До абсолютного истечения остался час - TTL не должен его превышать
+		return data.Expiration <= time.Hour && data.Expiration > 0 && !data.LastSeen.IsZero()
+	})).Return(nil)
+	authRepositoryMock := new(AuthRepoMock)
+	authRepositoryMock.On("GetRole", expUserId).Return("moderator", false, nil)
 
-	useCaseTest := NewService(nil,sessionRepositoryMock)
+	useCaseTest := NewService(authRepositoryMock, sessionRepositoryMock, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	protoSession := &protoAuth.Session{
@@ -49,10 +82,51 @@ func TestCheckSession(t *testing.T) {
 	protoUserId, err := useCaseTest.CheckSession(ctx,protoSession)
 	userId := protoUserId.ID
 	assert.Equal(t, "1",userId)
+	assert.Equal(t, "moderator", protoUserId.Role)
 	assert.NoError(t, err)
 	sessionRepositoryMock.AssertExpectations(t)
 }
 
+func TestCheckSessionBanned(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+	authRepositoryMock := new(AuthRepoMock)
+
+	sessionId := "1111111111111111"
+	sessionData := &authServiceModels.SessionData{
+		SessionId: sessionId,
+		UserId:    "1",
+		CreatedAt: time.Now(),
+	}
+	sessionRepositoryMock.On("Get", sessionId).Return(sessionData, nil)
+	sessionRepositoryMock.On("Delete", sessionId).Return(nil)
+	authRepositoryMock.On("GetRole", "1").Return("user", true, nil)
+
+	useCaseTest := NewService(authRepositoryMock, sessionRepositoryMock, nil, nil, nil, nil)
+	_, err := useCaseTest.CheckSession(context.Background(), &protoAuth.Session{Session: sessionId})
+	assert.Equal(t, error2.ErrUserBanned, err)
+	sessionRepositoryMock.AssertNotCalled(t, "Refresh", mock.Anything)
+	sessionRepositoryMock.AssertExpectations(t)
+}
+
+func TestCheckSessionExpired(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+
+	sessionId := "1111111111111111"
+	sessionData := &authServiceModels.SessionData{
+		SessionId: sessionId,
+		UserId:    "1",
+		CreatedAt: time.Now().Add(-defaultAbsoluteLifetime - time.Minute),
+	}
+	sessionRepositoryMock.On("Get", sessionId).Return(sessionData, nil)
+	sessionRepositoryMock.On("Delete", sessionId).Return(nil)
+
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil)
+	_, err := useCaseTest.CheckSession(context.Background(), &protoAuth.Session{Session: sessionId})
+	assert.Equal(t, error2.ErrSessionExpired, err)
+	sessionRepositoryMock.AssertNotCalled(t, "Refresh", mock.Anything)
+	sessionRepositoryMock.AssertExpectations(t)
+}
+
 func TestDeleteSession(t *testing.T) {
 	sessionRepositoryMock := new(AuthSessionMock)
 
@@ -60,7 +134,7 @@ func TestDeleteSession(t *testing.T) {
 
 	sessionRepositoryMock.On("Delete", sessionId).Return(nil)
 
-	useCaseTest := NewService(nil,sessionRepositoryMock)
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	protoSession := &protoAuth.Session{
@@ -71,4 +145,79 @@ func TestDeleteSession(t *testing.T) {
 	assert.Equal(t,"success",success)
 	assert.NoError(t, err)
 	sessionRepositoryMock.AssertExpectations(t)
-}
\ No newline at end of file
+}
+
+func testUserSessions() []*authServiceModels.SessionData {
+	now := time.Now()
+	return []*authServiceModels.SessionData{
+		{
+			SessionId: "1111111111111111",
+			UserId:    "1",
+			CreatedAt: now.Add(-time.Hour),
+			LastSeen:  now.Add(-time.Hour),
+			UserAgent: "Firefox",
+			IP:        "10.0.0.1",
+		},
+		{
+			SessionId: "2222222222222222",
+			UserId:    "1",
+			CreatedAt: now.Add(-time.Hour),
+			LastSeen:  now,
+			UserAgent: "Chrome",
+			IP:        "10.0.0.2",
+		},
+	}
+}
+
+func TestListSessions(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+	sessionId := "1111111111111111"
+	sessionRepositoryMock.On("Check", sessionId).Return("1", nil)
+	sessionRepositoryMock.On("ListByUser", "1").Return(testUserSessions(), nil)
+
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil)
+	list, err := useCaseTest.ListSessions(context.Background(), &protoAuth.Session{Session: sessionId})
+	assert.NoError(t, err)
+	assert.Len(t, list.Sessions, 2)
+	assert.Equal(t, "Chrome", list.Sessions[0].UserAgent)
+	assert.False(t, list.Sessions[0].Current)
+	assert.True(t, list.Sessions[1].Current)
+	assert.Equal(t, publicSessionId(sessionId), list.Sessions[1].ID)
+	assert.NotEqual(t, sessionId, list.Sessions[1].ID)
+	sessionRepositoryMock.AssertExpectations(t)
+}
+
+func TestRevokeSession(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+	sessionId := "1111111111111111"
+	sessionRepositoryMock.On("Check", sessionId).Return("1", nil)
+	sessionRepositoryMock.On("ListByUser", "1").Return(testUserSessions(), nil)
+	sessionRepositoryMock.On("Delete", "2222222222222222").Return(nil)
+
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil)
+	in := &protoAuth.RevokeSessionRequest{
+		Session: sessionId,
+		ID:      publicSessionId("2222222222222222"),
+	}
+	_, err := useCaseTest.RevokeSession(context.Background(), in)
+	assert.NoError(t, err)
+
+	in.ID = publicSessionId("3333333333333333")
+	_, err = useCaseTest.RevokeSession(context.Background(), in)
+	assert.Equal(t, error2.ErrSessionNotFound, err)
+	sessionRepositoryMock.AssertExpectations(t)
+}
+
+func TestRevokeAllOtherSessions(t *testing.T) {
+	sessionRepositoryMock := new(AuthSessionMock)
+	sessionId := "1111111111111111"
+	sessionRepositoryMock.On("Check", sessionId).Return("1", nil)
+	sessionRepositoryMock.On("ListByUser", "1").Return(testUserSessions(), nil)
+	sessionRepositoryMock.On("Delete", "2222222222222222").Return(nil)
+
+	useCaseTest := NewService(nil, sessionRepositoryMock, nil, nil, nil, nil)
+	_, err := useCaseTest.RevokeAllOtherSessions(context.Background(), &protoAuth.Session{Session: sessionId})
+	assert.NoError(t, err)
+	sessionRepositoryMock.AssertNotCalled(t, "Delete", sessionId)
+	sessionRepositoryMock.AssertExpectations(t)
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type AuthTokenMock struct {
+	mock.Mock
+}
+
+func (m *AuthTokenMock) Create(data *authServiceModels.TokenData) error {
+	args := m.Called(data)
+	return args.Error(0)
+}
+
+func (m *AuthTokenMock) Use(token string, purpose string) (string, error) {
+	args := m.Called(token, purpose)
+	return args.String(0), args.Error(1)
+}
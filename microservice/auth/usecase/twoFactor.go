@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	totpIssuer              = "BMSTUSA"
+	twoFactorPurpose        = "2fa_challenge"
+	twoFactorTokenLength    = 32
+	twoFactorLifeTime       = time.Minute * 5
+	recoveryCodesCount      = 10
+	recoveryCodeBytesLength = 5
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+//Код вида abcd-efgh, в БД хранится только хэш
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, recoveryCodeBytesLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	code := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+	return code[:4] + "-" + code[4:], nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
+
+//Подходит либо текущий TOTP-код, либо неиспользованный код восстановления
+func (s *authService) checkSecondFactor(userId string, secret string, code string) error {
+	if utils.CheckTotpCode(secret, code, time.Now()) {
+		return nil
+	}
+	return s.authUserRepository.UseRecoveryCode(userId, hashRecoveryCode(code))
+}
+
+func (s *authService) createTwoFactorChallenge(userId string) (string, error) {
+	token, err := generateSecureToken(twoFactorTokenLength)
+	if err != nil {
+		return "", err
+	}
+	err = s.authTokenRepository.Create(&authServiceModels.TokenData{
+		Token:      token,
+		Purpose:    twoFactorPurpose,
+		UserId:     userId,
+		Expiration: twoFactorLifeTime,
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (s *authService) EnrollTotp(ctx context.Context, in *protoAuth.UserId) (*protoAuth.TotpEnrollment, error) {
+	message := logMessage + "EnrollTotp:"
+	log.Debug(message + "started")
+	u, err := s.authUserRepository.GetUserById(in.ID)
+	if err != nil {
+		return &protoAuth.TotpEnrollment{}, err
+	}
+	if u.TotpEnabled {
+		return &protoAuth.TotpEnrollment{}, error2.ErrTotpAlreadyEnabled
+	}
+	secret, err := utils.GenerateTotpSecret()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &protoAuth.TotpEnrollment{}, err
+	}
+	err = s.authUserRepository.SetTotpSecret(u.ID, secret)
+	if err != nil {
+		return &protoAuth.TotpEnrollment{}, err
+	}
+	out := &protoAuth.TotpEnrollment{
+		Secret: secret,
+		URI:    utils.TotpURI(totpIssuer, u.Mail, secret),
+	}
+	return out, nil
+}
+
+func (s *authService) ConfirmTotp(ctx context.Context, in *protoAuth.TotpCodeRequest) (*protoAuth.RecoveryCodes, error) {
+	message := logMessage + "ConfirmTotp:"
+	log.Debug(message + "started")
+	secret, enabled, err := s.authUserRepository.GetTotpSecret(in.UserId)
+	if err != nil {
+		return &protoAuth.RecoveryCodes{}, err
+	}
+	if enabled {
+		return &protoAuth.RecoveryCodes{}, error2.ErrTotpAlreadyEnabled
+	}
+	if secret == "" {
+		return &protoAuth.RecoveryCodes{}, error2.ErrTotpNotEnrolled
+	}
+	if !utils.CheckTotpCode(secret, in.Code, time.Now()) {
+		return &protoAuth.RecoveryCodes{}, error2.ErrInvalidTotpCode
+	}
+	codes := make([]string, recoveryCodesCount)
+	hashes := make([]string, recoveryCodesCount)
+	for i := range codes {
+		codes[i], err = generateRecoveryCode()
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return &protoAuth.RecoveryCodes{}, err
+		}
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	err = s.authUserRepository.EnableTotp(in.UserId, hashes)
+	if err != nil {
+		return &protoAuth.RecoveryCodes{}, err
+	}
+	return &protoAuth.RecoveryCodes{Codes: codes}, nil
+}
+
+func (s *authService) DisableTotp(ctx context.Context, in *protoAuth.TotpCodeRequest) (*protoAuth.Success, error) {
+	message := logMessage + "DisableTotp:"
+	log.Debug(message + "started")
+	secret, enabled, err := s.authUserRepository.GetTotpSecret(in.UserId)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	if !enabled {
+		return &protoAuth.Success{}, error2.ErrTotpNotEnabled
+	}
+	err = s.checkSecondFactor(in.UserId, secret, in.Code)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	err = s.authUserRepository.DisableTotp(in.UserId)
+	if err != nil {
+		return &protoAuth.Success{}, err
+	}
+	return &protoAuth.Success{Ok: "success"}, nil
+}
+
+//Токен подтверждения одноразовый: при неверном коде нужно заново ввести пароль
+func (s *authService) CompleteTwoFactorLogin(ctx context.Context, in *protoAuth.TwoFactorLoginRequest) (*protoAuth.UserId, error) {
+	message := logMessage + "CompleteTwoFactorLogin:"
+	log.Debug(message + "started")
+	if in.ChallengeToken == "" || in.Code == "" {
+		return &protoAuth.UserId{}, error2.ErrEmptyData
+	}
+	userId, err := s.authTokenRepository.Use(in.ChallengeToken, twoFactorPurpose)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	secret, enabled, err := s.authUserRepository.GetTotpSecret(userId)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
+	if enabled {
+		err = s.checkSecondFactor(userId, secret, in.Code)
+		if err != nil {
+			return &protoAuth.UserId{}, err
+		}
+	}
+	return &protoAuth.UserId{ID: userId}, nil
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	authServiceModels "backend/microservice/auth/models"
+	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testTotpSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func currentTotpCode(t *testing.T) string {
+	code, err := utils.TotpCode(testTotpSecret, time.Now())
+	assert.NoError(t, err)
+	return code
+}
+
+func TestSignInTwoFactor(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	tokenRepositoryMock := new(AuthTokenMock)
+	passwordHash, err := utils.CreatePasswordHash("12345678")
+	assert.NoError(t, err)
+	user := &models.User{
+		ID:          "1",
+		Mail:        "test@mail.ru",
+		Password:    passwordHash,
+		TotpEnabled: true,
+	}
+	authRepositoryMock.On("GetUser", user.Mail).Return(user, nil)
+	tokenRepositoryMock.On("Create", mock.MatchedBy(func(data *authServiceModels.TokenData) bool {
+		return data.UserId == "1" && data.Purpose == twoFactorPurpose && data.Expiration == twoFactorLifeTime
+	})).Return(nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, tokenRepositoryMock, newAttemptMock(), nil, nil)
+	out, err := useCaseTest.SignIn(context.Background(), &protoAuth.SignInRequest{Mail: user.Mail, Password: "12345678"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", out.ID)
+	assert.NotEmpty(t, out.ChallengeToken)
+	tokenRepositoryMock.AssertExpectations(t)
+}
+
+func TestEnrollTotp(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	authRepositoryMock.On("GetUserById", "1").Return(&models.User{ID: "1", Mail: "test@mail.ru"}, nil)
+	authRepositoryMock.On("SetTotpSecret", "1", mock.AnythingOfType("string")).Return(nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil)
+	out, err := useCaseTest.EnrollTotp(context.Background(), &protoAuth.UserId{ID: "1"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, out.Secret)
+	assert.True(t, strings.HasPrefix(out.URI, "otpauth://totp/BMSTUSA:test@mail.ru?"))
+	authRepositoryMock.AssertExpectations(t)
+}
+
+func TestEnrollTotpAlreadyEnabled(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	authRepositoryMock.On("GetUserById", "1").Return(&models.User{ID: "1", TotpEnabled: true}, nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil)
+	_, err := useCaseTest.EnrollTotp(context.Background(), &protoAuth.UserId{ID: "1"})
+	assert.Equal(t, error2.ErrTotpAlreadyEnabled, err)
+}
+
+func TestConfirmTotp(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	authRepositoryMock.On("GetTotpSecret", "1").Return(testTotpSecret, false, nil)
+	authRepositoryMock.On("EnableTotp", "1", mock.MatchedBy(func(hashes []string) bool {
+		return len(hashes) == recoveryCodesCount
+	})).Return(nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil)
+	out, err := useCaseTest.ConfirmTotp(context.Background(), &protoAuth.TotpCodeRequest{UserId: "1", Code: currentTotpCode(t)})
+	assert.NoError(t, err)
+	assert.Len(t, out.Codes, recoveryCodesCount)
+	assert.NotEqual(t, out.Codes[0], out.Codes[1])
+	authRepositoryMock.AssertExpectations(t)
+
+	_, err = useCaseTest.ConfirmTotp(context.Background(), &protoAuth.TotpCodeRequest{UserId: "1", Code: "abc"})
+	assert.Equal(t, error2.ErrInvalidTotpCode, err)
+}
+
+var completeTwoFactorLoginTests = []struct {
+	id          int
+	code        string
+	recoveryErr error
+	outputErr   error
+}{
+	{
+		1,
+		"",
+		nil,
+		nil,
+	},
+	{
+		2,
+		"ABCD-EFGH",
+		nil,
+		nil,
+	},
+	{
+		3,
+		"abcd-efgh",
+		error2.ErrInvalidTotpCode,
+		error2.ErrInvalidTotpCode,
+	},
+}
+
+func TestCompleteTwoFactorLogin(t *testing.T) {
+	for _, test := range completeTwoFactorLoginTests {
+		authRepositoryMock := new(AuthRepoMock)
+		tokenRepositoryMock := new(AuthTokenMock)
+		code := test.code
+		if code == "" {
+			code = currentTotpCode(t)
+		}
+		tokenRepositoryMock.On("Use", "challenge", twoFactorPurpose).Return("1", nil)
+		authRepositoryMock.On("GetTotpSecret", "1").Return(testTotpSecret, true, nil)
+		authRepositoryMock.On("UseRecoveryCode", "1", hashRecoveryCode("abcdefgh")).Return(test.recoveryErr)
+
+		useCaseTest := NewService(authRepositoryMock, nil, tokenRepositoryMock, nil, nil, nil)
+		in := &protoAuth.TwoFactorLoginRequest{
+			ChallengeToken: "challenge",
+			Code:           code,
+		}
+		out, err := useCaseTest.CompleteTwoFactorLogin(context.Background(), in)
+		assert.Equal(t, test.outputErr, err, test.id)
+		if test.outputErr == nil {
+			assert.Equal(t, "1", out.ID, test.id)
+		}
+	}
+}
+
+func TestDisableTotp(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	authRepositoryMock.On("GetTotpSecret", "1").Return(testTotpSecret, true, nil)
+	authRepositoryMock.On("DisableTotp", "1").Return(nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil)
+	_, err := useCaseTest.DisableTotp(context.Background(), &protoAuth.TotpCodeRequest{UserId: "1", Code: currentTotpCode(t)})
+	assert.NoError(t, err)
+	authRepositoryMock.AssertExpectations(t)
+}
@@ -2,10 +2,14 @@ package usecase
 
 import (
 	"backend/microservice/auth/interfaces"
+	authServiceModels "backend/microservice/auth/models"
+	"backend/microservice/auth/oidc"
 	protoAuth "backend/microservice/auth/proto"
 	"backend/pkg/models"
 	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
 	"context"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -15,14 +19,25 @@ const (
 )
 
 type authService struct {
-	authUserRepository    interfaces.UserRepository
-	authSessionRepository interfaces.SessionRepository
+	authUserRepository     interfaces.UserRepository
+	authSessionRepository  interfaces.SessionRepository
+	authTokenRepository    interfaces.TokenRepository
+	authAttemptRepository  interfaces.AttemptRepository
+	authApiTokenRepository interfaces.ApiTokenRepository
+	authEventRepository    interfaces.AuthEventRepository
+
+	oidcMu        sync.Mutex
+	oidcProviders map[string]*oidc.Provider
 }
 
-func NewService(authUserRepository interfaces.UserRepository, authSessionRepository interfaces.SessionRepository) *authService {
+func NewService(authUserRepository interfaces.UserRepository, authSessionRepository interfaces.SessionRepository, authTokenRepository interfaces.TokenRepository, authAttemptRepository interfaces.AttemptRepository, authApiTokenRepository interfaces.ApiTokenRepository, authEventRepository interfaces.AuthEventRepository) *authService {
 	return &authService{
-		authUserRepository:    authUserRepository,
-		authSessionRepository: authSessionRepository,
+		authUserRepository:     authUserRepository,
+		authSessionRepository:  authSessionRepository,
+		authTokenRepository:    authTokenRepository,
+		authAttemptRepository:  authAttemptRepository,
+		authApiTokenRepository: authApiTokenRepository,
+		authEventRepository:    authEventRepository,
 	}
 }
 
@@ -32,11 +47,15 @@ func (s *authService) SignUp(ctx context.Context, in *protoAuth.SignUpRequest) (
 
 	log.Debug(message+"in = ", in)
 
+	passwordHash, err := utils.CreatePasswordHash(in.Password)
+	if err != nil {
+		return &protoAuth.UserId{}, err
+	}
 	newUser := models.User{
 		Name:     in.Name,
 		Surname:  in.Surname,
 		Mail:     in.Mail,
-		Password: utils.CreatePasswordHash(in.Password),
+		Password: passwordHash,
 	}
 
 	userId, err := s.authUserRepository.CreateUser(&newUser)
@@ -44,23 +63,82 @@ func (s *authService) SignUp(ctx context.Context, in *protoAuth.SignUpRequest) (
 		return &protoAuth.UserId{}, err
 	}
 
+	s.recordAuthEvent(ctx, &authServiceModels.AuthEventData{
+		UserId: userId,
+		Type:   models.AuthEventSignUp,
+	})
 	out := &protoAuth.UserId{ID: userId}
 	return out, nil
 }
 
-func (s *authService) SignIn(ctx context.Context, in *protoAuth.SignInRequest) (*protoAuth.UserId, error) {
+func (s *authService) SignIn(ctx context.Context, in *protoAuth.SignInRequest) (*protoAuth.SignInResponse, error) {
 	message := logMessage + "SignIn:"
 	log.Debug(message + "started")
 
 	log.Debug(message+"in = ", in)
 
-	u, err := s.authUserRepository.GetUser(in.Mail, utils.CreatePasswordHash(in.Password))
+	attemptKeys := loginAttemptKeys(ctx, in.Mail)
+	err := s.checkLockout(attemptKeys)
 	if err != nil {
-		return &protoAuth.UserId{}, err
+		return &protoAuth.SignInResponse{}, err
+	}
+	u, err := s.authUserRepository.GetUser(in.Mail)
+	if err == error2.ErrUserNotFound {
+		//Хэш считается и для несуществующей почты, иначе её выдаёт время ответа
+		utils.CheckDummyPassword(in.Password)
+		//Перебор почт тоже считаем, иначе лимит по IP легко обойти
+		s.registerFailedLogin(attemptKeys)
+		s.recordAuthEvent(ctx, &authServiceModels.AuthEventData{
+			Type: models.AuthEventLoginFailed,
+			Mail: in.Mail,
+		})
+	}
+	if err != nil {
+		return &protoAuth.SignInResponse{}, err
+	}
+	match, needsRehash := utils.CheckPasswordHash(in.Password, u.Password)
+	if !match {
+		s.registerFailedLogin(attemptKeys)
+		s.recordAuthEvent(ctx, &authServiceModels.AuthEventData{
+			UserId: u.ID,
+			Type:   models.AuthEventLoginFailed,
+			Mail:   in.Mail,
+		})
+		return &protoAuth.SignInResponse{}, error2.ErrUserNotFound
+	}
+	s.resetFailedLogins(attemptKeys)
+	if u.Banned {
+		return &protoAuth.SignInResponse{}, error2.ErrUserBanned
+	}
+	if needsRehash {
+		s.rehashPassword(u.ID, in.Password)
+	}
+
+	//С включённой 2FA сессию создаём только после CompleteTwoFactorLogin
+	if u.TotpEnabled {
+		challengeToken, err := s.createTwoFactorChallenge(u.ID)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return &protoAuth.SignInResponse{}, err
+		}
+		return &protoAuth.SignInResponse{ChallengeToken: challengeToken}, nil
 	}
 
-	out := &protoAuth.UserId{
+	out := &protoAuth.SignInResponse{
 		ID: u.ID,
 	}
 	return out, nil
 }
+
+func (s *authService) rehashPassword(userId string, password string) {
+	message := logMessage + "rehashPassword:"
+	passwordHash, err := utils.CreatePasswordHash(password)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return
+	}
+	err = s.authUserRepository.UpdatePassword(userId, passwordHash)
+	if err != nil {
+		log.Error(message+"err = ", err)
+	}
+}
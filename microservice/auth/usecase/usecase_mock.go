@@ -1,8 +1,8 @@
 package usecase
 
 import (
-	"github.com/stretchr/testify/mock"
 	"backend/pkg/models"
+	"github.com/stretchr/testify/mock"
 )
 
 type AuthRepoMock struct {
@@ -14,7 +14,77 @@ func (m *AuthRepoMock) CreateUser(data *models.User) (string, error) {
 	return args.Get(0).(string), args.Error(1)
 }
 
-func (m *AuthRepoMock) GetUser(mail, password string) (*models.User, error) {
-	args := m.Called(mail,password)
+func (m *AuthRepoMock) GetUser(mail string) (*models.User, error) {
+	args := m.Called(mail)
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *AuthRepoMock) GetUserById(userId string) (*models.User, error) {
+	args := m.Called(userId)
 	return args.Get(0).(*models.User), args.Error(1)
-}
\ No newline at end of file
+}
+
+func (m *AuthRepoMock) VerifyEmail(userId string, mail string) error {
+	args := m.Called(userId, mail)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) UpdatePassword(userId string, password string) error {
+	args := m.Called(userId, password)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) SetTotpSecret(userId string, secret string) error {
+	args := m.Called(userId, secret)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) GetTotpSecret(userId string) (string, bool, error) {
+	args := m.Called(userId)
+	return args.String(0), args.Bool(1), args.Error(2)
+}
+
+func (m *AuthRepoMock) EnableTotp(userId string, recoveryCodeHashes []string) error {
+	args := m.Called(userId, recoveryCodeHashes)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) DisableTotp(userId string) error {
+	args := m.Called(userId)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) UseRecoveryCode(userId string, codeHash string) error {
+	args := m.Called(userId, codeHash)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) GetRole(userId string) (string, bool, error) {
+	args := m.Called(userId)
+	return args.String(0), args.Bool(1), args.Error(2)
+}
+
+func (m *AuthRepoMock) SetRole(userId string, role string) error {
+	args := m.Called(userId, role)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) SetBanned(userId string, banned bool) error {
+	args := m.Called(userId, banned)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) GetUserByIdentity(provider string, subject string) (*models.User, error) {
+	args := m.Called(provider, subject)
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *AuthRepoMock) LinkIdentity(userId string, provider string, subject string) error {
+	args := m.Called(userId, provider, subject)
+	return args.Error(0)
+}
+
+func (m *AuthRepoMock) DeleteUser(userId string) ([]string, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]string), args.Error(1)
+}
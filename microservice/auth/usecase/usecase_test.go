@@ -2,64 +2,147 @@ package usecase
 
 import (
 	protoAuth "backend/microservice/auth/proto"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
 	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
 	"testing"
-	"backend/pkg/models"
+
 	"github.com/stretchr/testify/assert"
-	"backend/pkg/utils"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestSignUp(t *testing.T) {
 	authRepositoryMock := new(AuthRepoMock)
 
-	newUser := &models.User{
-		Name:     "Artyom",
-		Surname:  "Shirshov",
-		Mail:     "test@mail.ru",
-		Password: utils.CreatePasswordHash("12345678"),
-	}
+	password := "12345678"
 	expUserId := "1"
-	authRepositoryMock.On("CreateUser", newUser).Return(expUserId, nil)
+	authRepositoryMock.On("CreateUser", mock.MatchedBy(func(u *models.User) bool {
+		match, _ := utils.CheckPasswordHash(password, u.Password)
+		return u.Name == "Artyom" && u.Surname == "Shirshov" && u.Mail == "test@mail.ru" && match
+	})).Return(expUserId, nil)
 
-	useCaseTest := NewService(authRepositoryMock,nil)
+	useCaseTest := NewService(authRepositoryMock, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	protoSignUp := &protoAuth.SignUpRequest{
 		Name:     "Artyom",
 		Surname:  "Shirshov",
 		Mail:     "test@mail.ru",
-		Password: "12345678",
+		Password: password,
 	}
-	protoUserId, err := useCaseTest.SignUp(ctx,protoSignUp)
+	protoUserId, err := useCaseTest.SignUp(ctx, protoSignUp)
 	userId := protoUserId.ID
-	assert.Equal(t, "1",userId)
+	assert.Equal(t, "1", userId)
 	assert.NoError(t, err)
 	authRepositoryMock.AssertExpectations(t)
 }
 
-func TestSignIn(t* testing.T) {
+func TestSignIn(t *testing.T) {
 	authRepositoryMock := new(AuthRepoMock)
 	password := "12345678"
+	passwordHash, err := utils.CreatePasswordHash(password)
+	assert.NoError(t, err)
 	newUser := &models.User{
-		ID:		  "1",
+		ID:       "1",
 		Name:     "Artyom",
 		Surname:  "Shirshov",
 		Mail:     "test@mail.ru",
-		Password: utils.CreatePasswordHash(password),
+		Password: passwordHash,
 	}
 
-	authRepositoryMock.On("GetUser", newUser.Mail, newUser.Password).Return(newUser, nil)
+	authRepositoryMock.On("GetUser", newUser.Mail).Return(newUser, nil)
 
-	useCaseTest := NewService(authRepositoryMock,nil)
+	useCaseTest := NewService(authRepositoryMock, nil, nil, newAttemptMock(), nil, nil)
 
 	ctx := context.Background()
 	protoSignIn := &protoAuth.SignInRequest{
 		Mail:     "test@mail.ru",
 		Password: "12345678",
 	}
-	protoUserId, err := useCaseTest.SignIn(ctx,protoSignIn)
+	protoUserId, err := useCaseTest.SignIn(ctx, protoSignIn)
 	userId := protoUserId.ID
-	assert.Equal(t, "1",userId)
+	assert.Equal(t, "1", userId)
+	assert.NoError(t, err)
+	authRepositoryMock.AssertExpectations(t)
+}
+
+func TestSignInWrongPassword(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	passwordHash, err := utils.CreatePasswordHash("12345678")
 	assert.NoError(t, err)
+	newUser := &models.User{
+		ID:       "1",
+		Mail:     "test@mail.ru",
+		Password: passwordHash,
+	}
+
+	authRepositoryMock.On("GetUser", newUser.Mail).Return(newUser, nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, newAttemptMock(), nil, nil)
+
+	ctx := context.Background()
+	protoSignIn := &protoAuth.SignInRequest{
+		Mail:     "test@mail.ru",
+		Password: "87654321",
+	}
+	protoUserId, err := useCaseTest.SignIn(ctx, protoSignIn)
+	assert.Equal(t, "", protoUserId.ID)
+	assert.Equal(t, error2.ErrUserNotFound, err)
 	authRepositoryMock.AssertExpectations(t)
-}
\ No newline at end of file
+}
+
+func TestSignInBanned(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	passwordHash, err := utils.CreatePasswordHash("12345678")
+	assert.NoError(t, err)
+	newUser := &models.User{
+		ID:       "1",
+		Mail:     "test@mail.ru",
+		Password: passwordHash,
+		Banned:   true,
+	}
+
+	authRepositoryMock.On("GetUser", newUser.Mail).Return(newUser, nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, newAttemptMock(), nil, nil)
+
+	protoSignIn := &protoAuth.SignInRequest{
+		Mail:     "test@mail.ru",
+		Password: "12345678",
+	}
+	protoUserId, err := useCaseTest.SignIn(context.Background(), protoSignIn)
+	assert.Equal(t, "", protoUserId.ID)
+	assert.Equal(t, error2.ErrUserBanned, err)
+}
+
+func TestSignInLegacyHash(t *testing.T) {
+	authRepositoryMock := new(AuthRepoMock)
+	password := "12345678"
+	newUser := &models.User{
+		ID:       "1",
+		Mail:     "test@mail.ru",
+		Password: fmt.Sprintf("%x", sha256.Sum256([]byte(password))),
+	}
+
+	authRepositoryMock.On("GetUser", newUser.Mail).Return(newUser, nil)
+	authRepositoryMock.On("UpdatePassword", newUser.ID, mock.MatchedBy(func(hash string) bool {
+		match, needsRehash := utils.CheckPasswordHash(password, hash)
+		return strings.HasPrefix(hash, "$argon2id$") && match && !needsRehash
+	})).Return(nil)
+
+	useCaseTest := NewService(authRepositoryMock, nil, nil, newAttemptMock(), nil, nil)
+
+	ctx := context.Background()
+	protoSignIn := &protoAuth.SignInRequest{
+		Mail:     "test@mail.ru",
+		Password: password,
+	}
+	protoUserId, err := useCaseTest.SignIn(ctx, protoSignIn)
+	assert.Equal(t, "1", protoUserId.ID)
+	assert.NoError(t, err)
+	authRepositoryMock.AssertExpectations(t)
+}
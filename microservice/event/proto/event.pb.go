@@ -29,19 +29,27 @@ type Event struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Title       string   `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Description string   `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
-	Text        string   `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
-	City        string   `protobuf:"bytes,5,opt,name=City,proto3" json:"City,omitempty"`
-	Category    string   `protobuf:"bytes,6,opt,name=Category,proto3" json:"Category,omitempty"`
-	Viewed      int32    `protobuf:"varint,7,opt,name=Viewed,proto3" json:"Viewed,omitempty"`
-	ImgUrl      string   `protobuf:"bytes,8,opt,name=ImgUrl,proto3" json:"ImgUrl,omitempty"`
-	Tag         []string `protobuf:"bytes,9,rep,name=Tag,proto3" json:"Tag,omitempty"`
-	Date        string   `protobuf:"bytes,10,opt,name=Date,proto3" json:"Date,omitempty"`
-	Geo         string   `protobuf:"bytes,11,opt,name=Geo,proto3" json:"Geo,omitempty"`
-	Address     string   `protobuf:"bytes,12,opt,name=Address,proto3" json:"Address,omitempty"`
-	AuthorId    string   `protobuf:"bytes,13,opt,name=AuthorId,proto3" json:"AuthorId,omitempty"`
+	ID          string    `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Title       string    `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Description string    `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	Text        string    `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
+	City        string    `protobuf:"bytes,5,opt,name=City,proto3" json:"City,omitempty"`
+	Category    string    `protobuf:"bytes,6,opt,name=Category,proto3" json:"Category,omitempty"`
+	Viewed      int32     `protobuf:"varint,7,opt,name=Viewed,proto3" json:"Viewed,omitempty"`
+	ImgUrl      string    `protobuf:"bytes,8,opt,name=ImgUrl,proto3" json:"ImgUrl,omitempty"`
+	Tag         []string  `protobuf:"bytes,9,rep,name=Tag,proto3" json:"Tag,omitempty"`
+	Date        string    `protobuf:"bytes,10,opt,name=Date,proto3" json:"Date,omitempty"`
+	Geo         string    `protobuf:"bytes,11,opt,name=Geo,proto3" json:"Geo,omitempty"`
+	Address     string    `protobuf:"bytes,12,opt,name=Address,proto3" json:"Address,omitempty"`
+	AuthorId    string    `protobuf:"bytes,13,opt,name=AuthorId,proto3" json:"AuthorId,omitempty"`
+	Snippet     string    `protobuf:"bytes,14,opt,name=Snippet,proto3" json:"Snippet,omitempty"`
+	StartsAt    string    `protobuf:"bytes,15,opt,name=StartsAt,proto3" json:"StartsAt,omitempty"`
+	EndsAt      string    `protobuf:"bytes,16,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	Timezone    string    `protobuf:"bytes,17,opt,name=Timezone,proto3" json:"Timezone,omitempty"`
+	Location    *Location `protobuf:"bytes,18,opt,name=Location,proto3" json:"Location,omitempty"`
+	Capacity    int32     `protobuf:"varint,19,opt,name=Capacity,proto3" json:"Capacity,omitempty"`
+	RatingAvg   float64   `protobuf:"fixed64,20,opt,name=RatingAvg,proto3" json:"RatingAvg,omitempty"`
+	RatingCount int32     `protobuf:"varint,21,opt,name=RatingCount,proto3" json:"RatingCount,omitempty"`
 }
 
 func (x *Event) Reset() {
@@ -167,6 +175,117 @@ func (x *Event) GetAuthorId() string {
 	return ""
 }
 
+func (x *Event) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *Event) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Event) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *Event) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Event) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Event) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Event) GetRatingAvg() float64 {
+	if x != nil {
+		return x.RatingAvg
+	}
+	return 0
+}
+
+func (x *Event) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lat float64 `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon float64 `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Location) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Location) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
 type EventId struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -178,7 +297,7 @@ type EventId struct {
 func (x *EventId) Reset() {
 	*x = EventId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[1]
+		mi := &file_event_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -191,7 +310,7 @@ func (x *EventId) String() string {
 func (*EventId) ProtoMessage() {}
 
 func (x *EventId) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[1]
+	mi := &file_event_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +323,7 @@ func (x *EventId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventId.ProtoReflect.Descriptor instead.
 func (*EventId) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{1}
+	return file_event_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *EventId) GetID() string {
@@ -225,7 +344,7 @@ type AuthorId struct {
 func (x *AuthorId) Reset() {
 	*x = AuthorId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[2]
+		mi := &file_event_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -238,7 +357,7 @@ func (x *AuthorId) String() string {
 func (*AuthorId) ProtoMessage() {}
 
 func (x *AuthorId) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[2]
+	mi := &file_event_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,7 +370,7 @@ func (x *AuthorId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorId.ProtoReflect.Descriptor instead.
 func (*AuthorId) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{2}
+	return file_event_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AuthorId) GetID() string {
@@ -272,7 +391,7 @@ type UserId struct {
 func (x *UserId) Reset() {
 	*x = UserId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[3]
+		mi := &file_event_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -285,7 +404,7 @@ func (x *UserId) String() string {
 func (*UserId) ProtoMessage() {}
 
 func (x *UserId) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[3]
+	mi := &file_event_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +417,7 @@ func (x *UserId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserId.ProtoReflect.Descriptor instead.
 func (*UserId) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{3}
+	return file_event_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UserId) GetID() string {
@@ -320,7 +439,7 @@ type UpdateEventRequest struct {
 func (x *UpdateEventRequest) Reset() {
 	*x = UpdateEventRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[4]
+		mi := &file_event_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -333,7 +452,7 @@ func (x *UpdateEventRequest) String() string {
 func (*UpdateEventRequest) ProtoMessage() {}
 
 func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[4]
+	mi := &file_event_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +465,7 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{4}
+	return file_event_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateEventRequest) GetEvent() *Event {
@@ -375,7 +494,7 @@ type DeleteEventRequest struct {
 func (x *DeleteEventRequest) Reset() {
 	*x = DeleteEventRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[5]
+		mi := &file_event_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -388,7 +507,7 @@ func (x *DeleteEventRequest) String() string {
 func (*DeleteEventRequest) ProtoMessage() {}
 
 func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[5]
+	mi := &file_event_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +520,7 @@ func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{5}
+	return file_event_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteEventRequest) GetEventId() string {
@@ -423,17 +542,24 @@ type GetEventsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title    string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Category string   `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
-	City     string   `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	Date     string   `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	Tags     []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Title    string    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Category string    `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	City     string    `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Date     string    `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Tags     []string  `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Limit    int32     `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor   string    `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	From     string    `protobuf:"bytes,8,opt,name=from,proto3" json:"from,omitempty"`
+	To       string    `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`
+	When     string    `protobuf:"bytes,10,opt,name=when,proto3" json:"when,omitempty"`
+	Near     *Location `protobuf:"bytes,11,opt,name=near,proto3" json:"near,omitempty"`
+	RadiusKm float64   `protobuf:"fixed64,12,opt,name=radiusKm,proto3" json:"radiusKm,omitempty"`
 }
 
 func (x *GetEventsRequest) Reset() {
 	*x = GetEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[6]
+		mi := &file_event_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -446,7 +572,7 @@ func (x *GetEventsRequest) String() string {
 func (*GetEventsRequest) ProtoMessage() {}
 
 func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[6]
+	mi := &file_event_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +585,7 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
 func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{6}
+	return file_event_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetEventsRequest) GetTitle() string {
@@ -497,64 +623,68 @@ func (x *GetEventsRequest) GetTags() []string {
 	return nil
 }
 
-type Events struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+func (x *GetEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *Events) Reset() {
-	*x = Events{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
+	return ""
 }
 
-func (x *Events) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetEventsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
 }
 
-func (*Events) ProtoMessage() {}
-
-func (x *Events) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetEventsRequest) GetTo() string {
+	if x != nil {
+		return x.To
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Events.ProtoReflect.Descriptor instead.
-func (*Events) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{7}
+func (x *GetEventsRequest) GetWhen() string {
+	if x != nil {
+		return x.When
+	}
+	return ""
 }
 
-func (x *Events) GetEvents() []*Event {
+func (x *GetEventsRequest) GetNear() *Location {
 	if x != nil {
-		return x.Events
+		return x.Near
 	}
 	return nil
 }
 
-type VisitRequest struct {
+func (x *GetEventsRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+type BoundingBox struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
-	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	MinLat float64 `protobuf:"fixed64,1,opt,name=minLat,proto3" json:"minLat,omitempty"`
+	MinLon float64 `protobuf:"fixed64,2,opt,name=minLon,proto3" json:"minLon,omitempty"`
+	MaxLat float64 `protobuf:"fixed64,3,opt,name=maxLat,proto3" json:"maxLat,omitempty"`
+	MaxLon float64 `protobuf:"fixed64,4,opt,name=maxLon,proto3" json:"maxLon,omitempty"`
 }
 
-func (x *VisitRequest) Reset() {
-	*x = VisitRequest{}
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_event_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,13 +692,13 @@ func (x *VisitRequest) Reset() {
 	}
 }
 
-func (x *VisitRequest) String() string {
+func (x *BoundingBox) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VisitRequest) ProtoMessage() {}
+func (*BoundingBox) ProtoMessage() {}
 
-func (x *VisitRequest) ProtoReflect() protoreflect.Message {
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -580,35 +710,53 @@ func (x *VisitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VisitRequest.ProtoReflect.Descriptor instead.
-func (*VisitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *VisitRequest) GetEventId() string {
+func (x *BoundingBox) GetMinLat() float64 {
 	if x != nil {
-		return x.EventId
+		return x.MinLat
 	}
-	return ""
+	return 0
 }
 
-func (x *VisitRequest) GetUserId() string {
+func (x *BoundingBox) GetMinLon() float64 {
 	if x != nil {
-		return x.UserId
+		return x.MinLon
 	}
-	return ""
+	return 0
 }
 
-type IsVisitedRequest struct {
+func (x *BoundingBox) GetMaxLat() float64 {
+	if x != nil {
+		return x.MaxLat
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLon() float64 {
+	if x != nil {
+		return x.MaxLon
+	}
+	return 0
+}
+
+type MapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result bool `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	Box      *BoundingBox `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Category string       `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	From     string       `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To       string       `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	When     string       `protobuf:"bytes,5,opt,name=when,proto3" json:"when,omitempty"`
 }
 
-func (x *IsVisitedRequest) Reset() {
-	*x = IsVisitedRequest{}
+func (x *MapRequest) Reset() {
+	*x = MapRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_event_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -616,13 +764,13 @@ func (x *IsVisitedRequest) Reset() {
 	}
 }
 
-func (x *IsVisitedRequest) String() string {
+func (x *MapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsVisitedRequest) ProtoMessage() {}
+func (*MapRequest) ProtoMessage() {}
 
-func (x *IsVisitedRequest) ProtoReflect() protoreflect.Message {
+func (x *MapRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -634,28 +782,58 @@ func (x *IsVisitedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsVisitedRequest.ProtoReflect.Descriptor instead.
-func (*IsVisitedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MapRequest.ProtoReflect.Descriptor instead.
+func (*MapRequest) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *IsVisitedRequest) GetResult() bool {
+func (x *MapRequest) GetBox() *BoundingBox {
 	if x != nil {
-		return x.Result
+		return x.Box
 	}
-	return false
+	return nil
 }
 
-type GetCitiesRequest struct {
+func (x *MapRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *MapRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *MapRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *MapRequest) GetWhen() string {
+	if x != nil {
+		return x.When
+	}
+	return ""
+}
+
+type UserEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cities []string `protobuf:"bytes,1,rep,name=Cities,proto3" json:"Cities,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *GetCitiesRequest) Reset() {
-	*x = GetCitiesRequest{}
+func (x *UserEventsRequest) Reset() {
+	*x = UserEventsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_event_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,13 +841,13 @@ func (x *GetCitiesRequest) Reset() {
 	}
 }
 
-func (x *GetCitiesRequest) String() string {
+func (x *UserEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCitiesRequest) ProtoMessage() {}
+func (*UserEventsRequest) ProtoMessage() {}
 
-func (x *GetCitiesRequest) ProtoReflect() protoreflect.Message {
+func (x *UserEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,40 +859,57 @@ func (x *GetCitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCitiesRequest.ProtoReflect.Descriptor instead.
-func (*GetCitiesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserEventsRequest.ProtoReflect.Descriptor instead.
+func (*UserEventsRequest) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetCitiesRequest) GetCities() []string {
+func (x *UserEventsRequest) GetUserId() string {
 	if x != nil {
-		return x.Cities
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type Empty struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UserEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_event_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserEventsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
+	return ""
 }
 
-func (x *Empty) String() string {
+type Events struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events     []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextCursor string   `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+}
+
+func (x *Events) Reset() {
+	*x = Events{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Events) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*Events) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *Events) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -726,731 +921,3580 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use Events.ProtoReflect.Descriptor instead.
+func (*Events) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{11}
 }
 
-var File_event_proto protoreflect.FileDescriptor
+func (x *Events) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
 
-var file_event_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x22, 0xb1, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65,
-	0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x69,
-	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x10,
-	0x0a, 0x03, 0x54, 0x61, 0x67, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x65, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x47, 0x65, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x07,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x54, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
-	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x32,
-	0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x40, 0x0a, 0x0c, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x10, 0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x07, 0x0a, 0x05,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xa7, 0x05, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
-	0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x36, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12,
-	0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x73,
-	0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x11, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
-	0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x34,
-	0x0a, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
-	0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x07, 0x55, 0x6e, 0x76, 0x69, 0x73, 0x69, 0x74, 0x12,
-	0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x09,
-	0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x49,
-	0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x00, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x10,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x00, 0x42,
-	0x0c, 0x5a, 0x0a, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Events) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
 }
 
-var (
-	file_event_proto_rawDescOnce sync.Once
-	file_event_proto_rawDescData = file_event_proto_rawDesc
-)
+type VisitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_event_proto_rawDescGZIP() []byte {
-	file_event_proto_rawDescOnce.Do(func() {
-		file_event_proto_rawDescData = protoimpl.X.CompressGZIP(file_event_proto_rawDescData)
-	})
-	return file_event_proto_rawDescData
+	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_event_proto_goTypes = []interface{}{
-	(*Event)(nil),              // 0: eventGrpc.Event
-	(*EventId)(nil),            // 1: eventGrpc.EventId
-	(*AuthorId)(nil),           // 2: eventGrpc.AuthorId
-	(*UserId)(nil),             // 3: eventGrpc.UserId
-	(*UpdateEventRequest)(nil), // 4: eventGrpc.UpdateEventRequest
-	(*DeleteEventRequest)(nil), // 5: eventGrpc.DeleteEventRequest
-	(*GetEventsRequest)(nil),   // 6: eventGrpc.GetEventsRequest
-	(*Events)(nil),             // 7: eventGrpc.Events
-	(*VisitRequest)(nil),       // 8: eventGrpc.VisitRequest
-	(*IsVisitedRequest)(nil),   // 9: eventGrpc.IsVisitedRequest
-	(*GetCitiesRequest)(nil),   // 10: eventGrpc.GetCitiesRequest
-	(*Empty)(nil),              // 11: eventGrpc.Empty
+func (x *VisitRequest) Reset() {
+	*x = VisitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_event_proto_depIdxs = []int32{
-	0,  // 0: eventGrpc.UpdateEventRequest.event:type_name -> eventGrpc.Event
-	0,  // 1: eventGrpc.Events.events:type_name -> eventGrpc.Event
-	0,  // 2: eventGrpc.Repository.CreateEvent:input_type -> eventGrpc.Event
-	4,  // 3: eventGrpc.Repository.UpdateEvent:input_type -> eventGrpc.UpdateEventRequest
-	5,  // 4: eventGrpc.Repository.DeleteEvent:input_type -> eventGrpc.DeleteEventRequest
-	1,  // 5: eventGrpc.Repository.GetEventById:input_type -> eventGrpc.EventId
-	6,  // 6: eventGrpc.Repository.GetEvents:input_type -> eventGrpc.GetEventsRequest
-	3,  // 7: eventGrpc.Repository.GetVisitedEvents:input_type -> eventGrpc.UserId
-	3,  // 8: eventGrpc.Repository.GetCreatedEvents:input_type -> eventGrpc.UserId
-	8,  // 9: eventGrpc.Repository.Visit:input_type -> eventGrpc.VisitRequest
-	8,  // 10: eventGrpc.Repository.Unvisit:input_type -> eventGrpc.VisitRequest
-	8,  // 11: eventGrpc.Repository.IsVisited:input_type -> eventGrpc.VisitRequest
-	11, // 12: eventGrpc.Repository.GetCities:input_type -> eventGrpc.Empty
-	1,  // 13: eventGrpc.Repository.CreateEvent:output_type -> eventGrpc.EventId
-	11, // 14: eventGrpc.Repository.UpdateEvent:output_type -> eventGrpc.Empty
-	11, // 15: eventGrpc.Repository.DeleteEvent:output_type -> eventGrpc.Empty
-	0,  // 16: eventGrpc.Repository.GetEventById:output_type -> eventGrpc.Event
-	7,  // 17: eventGrpc.Repository.GetEvents:output_type -> eventGrpc.Events
-	7,  // 18: eventGrpc.Repository.GetVisitedEvents:output_type -> eventGrpc.Events
-	7,  // 19: eventGrpc.Repository.GetCreatedEvents:output_type -> eventGrpc.Events
-	11, // 20: eventGrpc.Repository.Visit:output_type -> eventGrpc.Empty
-	11, // 21: eventGrpc.Repository.Unvisit:output_type -> eventGrpc.Empty
-	9,  // 22: eventGrpc.Repository.IsVisited:output_type -> eventGrpc.IsVisitedRequest
-	10, // 23: eventGrpc.Repository.GetCities:output_type -> eventGrpc.GetCitiesRequest
-	13, // [13:24] is the sub-list for method output_type
-	2,  // [2:13] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+
+func (x *VisitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_event_proto_init() }
-func file_event_proto_init() {
-	if File_event_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_event_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventId); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorId); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*VisitRequest) ProtoMessage() {}
+
+func (x *VisitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserId); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitRequest.ProtoReflect.Descriptor instead.
+func (*VisitRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VisitRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VisitRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type IsVisitedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result bool `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *IsVisitedRequest) Reset() {
+	*x = IsVisitedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsVisitedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsVisitedRequest) ProtoMessage() {}
+
+func (x *IsVisitedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsVisitedRequest.ProtoReflect.Descriptor instead.
+func (*IsVisitedRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *IsVisitedRequest) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+type GetCitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cities []string `protobuf:"bytes,1,rep,name=Cities,proto3" json:"Cities,omitempty"`
+}
+
+func (x *GetCitiesRequest) Reset() {
+	*x = GetCitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCitiesRequest) ProtoMessage() {}
+
+func (x *GetCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEventsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Events); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VisitRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCitiesRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+type SetEventHiddenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	Hidden  bool   `protobuf:"varint,2,opt,name=hidden,proto3" json:"hidden,omitempty"`
+}
+
+func (x *SetEventHiddenRequest) Reset() {
+	*x = SetEventHiddenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEventHiddenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventHiddenRequest) ProtoMessage() {}
+
+func (x *SetEventHiddenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsVisitedRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventHiddenRequest.ProtoReflect.Descriptor instead.
+func (*SetEventHiddenRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetEventHiddenRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetEventHiddenRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type Registration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Position   int32        `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Registered int32        `protobuf:"varint,3,opt,name=registered,proto3" json:"registered,omitempty"`
+	Capacity   int32        `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	EventTitle string       `protobuf:"bytes,5,opt,name=eventTitle,proto3" json:"eventTitle,omitempty"`
+	Promoted   []*Promotion `protobuf:"bytes,6,rep,name=promoted,proto3" json:"promoted,omitempty"`
+}
+
+func (x *Registration) Reset() {
+	*x = Registration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Registration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registration) ProtoMessage() {}
+
+func (x *Registration) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCitiesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registration.ProtoReflect.Descriptor instead.
+func (*Registration) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Registration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Registration) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Registration) GetRegistered() int32 {
+	if x != nil {
+		return x.Registered
+	}
+	return 0
+}
+
+func (x *Registration) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Registration) GetEventTitle() string {
+	if x != nil {
+		return x.EventTitle
+	}
+	return ""
+}
+
+func (x *Registration) GetPromoted() []*Promotion {
+	if x != nil {
+		return x.Promoted
+	}
+	return nil
+}
+
+type Promotion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Mail   string `protobuf:"bytes,2,opt,name=mail,proto3" json:"mail,omitempty"`
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Promotion) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Promotion) GetMail() string {
+	if x != nil {
+		return x.Mail
+	}
+	return ""
+}
+
+type ViewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId   string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	VisitorId string `protobuf:"bytes,3,opt,name=visitorId,proto3" json:"visitorId,omitempty"`
+}
+
+func (x *ViewRequest) Reset() {
+	*x = ViewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewRequest) ProtoMessage() {}
+
+func (x *ViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewRequest.ProtoReflect.Descriptor instead.
+func (*ViewRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ViewRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ViewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ViewRequest) GetVisitorId() string {
+	if x != nil {
+		return x.VisitorId
+	}
+	return ""
+}
+
+type EventStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	From    string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To      string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *EventStatsRequest) Reset() {
+	*x = EventStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStatsRequest) ProtoMessage() {}
+
+func (x *EventStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStatsRequest.ProtoReflect.Descriptor instead.
+func (*EventStatsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EventStatsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventStatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EventStatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type DayStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date       string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Views      int64  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	Favourites int64  `protobuf:"varint,3,opt,name=favourites,proto3" json:"favourites,omitempty"`
+}
+
+func (x *DayStats) Reset() {
+	*x = DayStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DayStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayStats) ProtoMessage() {}
+
+func (x *DayStats) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayStats.ProtoReflect.Descriptor instead.
+func (*DayStats) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DayStats) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DayStats) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *DayStats) GetFavourites() int64 {
+	if x != nil {
+		return x.Favourites
+	}
+	return 0
+}
+
+type EventStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days []*DayStats `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *EventStats) Reset() {
+	*x = EventStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStats) ProtoMessage() {}
+
+func (x *EventStats) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStats.ProtoReflect.Descriptor instead.
+func (*EventStats) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EventStats) GetDays() []*DayStats {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type RsvpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status  string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RsvpRequest) Reset() {
+	*x = RsvpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsvpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsvpRequest) ProtoMessage() {}
+
+func (x *RsvpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsvpRequest.ProtoReflect.Descriptor instead.
+func (*RsvpRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RsvpRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RsvpRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RsvpRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Rsvp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Going      int32  `protobuf:"varint,2,opt,name=going,proto3" json:"going,omitempty"`
+	Interested int32  `protobuf:"varint,3,opt,name=interested,proto3" json:"interested,omitempty"`
+}
+
+func (x *Rsvp) Reset() {
+	*x = Rsvp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rsvp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rsvp) ProtoMessage() {}
+
+func (x *Rsvp) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rsvp.ProtoReflect.Descriptor instead.
+func (*Rsvp) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Rsvp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Rsvp) GetGoing() int32 {
+	if x != nil {
+		return x.Going
+	}
+	return 0
+}
+
+func (x *Rsvp) GetInterested() int32 {
+	if x != nil {
+		return x.Interested
+	}
+	return 0
+}
+
+type Ticket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	EventId     string `protobuf:"bytes,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId      string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	EventTitle  string `protobuf:"bytes,4,opt,name=eventTitle,proto3" json:"eventTitle,omitempty"`
+	CheckedInAt string `protobuf:"bytes,5,opt,name=checkedInAt,proto3" json:"checkedInAt,omitempty"`
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Ticket) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Ticket) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Ticket) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Ticket) GetEventTitle() string {
+	if x != nil {
+		return x.EventTitle
+	}
+	return ""
+}
+
+func (x *Ticket) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Code    string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CheckInRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type Attendance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attended   int32 `protobuf:"varint,1,opt,name=attended,proto3" json:"attended,omitempty"`
+	Registered int32 `protobuf:"varint,2,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (x *Attendance) Reset() {
+	*x = Attendance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendance) ProtoMessage() {}
+
+func (x *Attendance) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
+func (*Attendance) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Attendance) GetAttended() int32 {
+	if x != nil {
+		return x.Attended
+	}
+	return 0
+}
+
+func (x *Attendance) GetRegistered() int32 {
+	if x != nil {
+		return x.Registered
+	}
+	return 0
+}
+
+type CheckInResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string      `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	CheckedInAt string      `protobuf:"bytes,2,opt,name=checkedInAt,proto3" json:"checkedInAt,omitempty"`
+	Attendance  *Attendance `protobuf:"bytes,3,opt,name=attendance,proto3" json:"attendance,omitempty"`
+}
+
+func (x *CheckInResult) Reset() {
+	*x = CheckInResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckInResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInResult) ProtoMessage() {}
+
+func (x *CheckInResult) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInResult.ProtoReflect.Descriptor instead.
+func (*CheckInResult) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CheckInResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckInResult) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *CheckInResult) GetAttendance() *Attendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId   string `protobuf:"bytes,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId    string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	ParentId  string `protobuf:"bytes,4,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Text      string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	EditedAt  string `protobuf:"bytes,7,opt,name=editedAt,proto3" json:"editedAt,omitempty"`
+	Deleted   bool   `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Replies   int32  `protobuf:"varint,9,opt,name=replies,proto3" json:"replies,omitempty"`
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Comment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Comment) GetReplies() int32 {
+	if x != nil {
+		return x.Replies
+	}
+	return 0
+}
+
+type Comments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments   []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextCursor string     `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+}
+
+func (x *Comments) Reset() {
+	*x = Comments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comments) ProtoMessage() {}
+
+func (x *Comments) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comments.ProtoReflect.Descriptor instead.
+func (*Comments) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Comments) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *Comments) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId string `protobuf:"bytes,1,opt,name=commentId,proto3" json:"commentId,omitempty"`
+	EventId   string `protobuf:"bytes,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId    string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Text      string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *CommentRequest) Reset() {
+	*x = CommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentRequest) ProtoMessage() {}
+
+func (x *CommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentRequest.ProtoReflect.Descriptor instead.
+func (*CommentRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *CommentRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CommentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId  string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	ParentId string `protobuf:"bytes,2,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Limit    int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor   string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *CommentsRequest) Reset() {
+	*x = CommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentsRequest) ProtoMessage() {}
+
+func (x *CommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentsRequest.ProtoReflect.Descriptor instead.
+func (*CommentsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CommentsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CommentsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CommentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CommentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId   string `protobuf:"bytes,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	UserId    string `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	Rating    int32  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Text      string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Review) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type Reviews struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviews    []*Review `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	NextCursor string    `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+}
+
+func (x *Reviews) Reset() {
+	*x = Reviews{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reviews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reviews) ProtoMessage() {}
+
+func (x *Reviews) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reviews.ProtoReflect.Descriptor instead.
+func (*Reviews) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Reviews) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *Reviews) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ReviewsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	Limit   int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor  string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ReviewsRequest) Reset() {
+	*x = ReviewsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewsRequest) ProtoMessage() {}
+
+func (x *ReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReviewsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReviewsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{35}
+}
+
+var File_event_proto protoreflect.FileDescriptor
+
+var file_event_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x22, 0xa8, 0x04, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65,
+	0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x69,
+	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x10,
+	0x0a, 0x03, 0x54, 0x61, 0x67, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x65, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x47, 0x65, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53,
+	0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x67,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x76,
+	0x67, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6c, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1a,
+	0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x22, 0x54, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xab, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x04, 0x6e,
+	0x65, 0x61, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x6e, 0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4b, 0x6d,
+	0x22, 0x6d, 0x0a, 0x0b, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x6e, 0x22,
+	0x8a, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x03, 0x62, 0x6f, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x6f, 0x78, 0x52, 0x03, 0x62, 0x6f, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x22, 0x59, 0x0a, 0x11,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x52, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x0c, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2a, 0x0a,
+	0x10, 0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x43,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x5d, 0x0a, 0x0b,
+	0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x11, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x54, 0x0a, 0x08, 0x44, 0x61, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x66, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x66, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x0a,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x04, 0x64,
+	0x61, 0x79, 0x73, 0x22, 0x57, 0x0a, 0x0b, 0x52, 0x73, 0x76, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x54, 0x0a, 0x04,
+	0x52, 0x73, 0x76, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x6f, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x49, 0x6e, 0x41, 0x74, 0x22, 0x56, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x0a,
+	0x0a, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x41, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x49,
+	0x6e, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x74, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x75, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x94, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x56, 0x0a, 0x07, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x58,
+	0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x32, 0xe8, 0x0f, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x35, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x1a, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x12, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x1a,
+	0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x00, 0x12, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x07, 0x55, 0x6e, 0x76, 0x69, 0x73, 0x69,
+	0x74, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x09, 0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
+	0x2e, 0x49, 0x73, 0x56, 0x69, 0x73, 0x69, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x00, 0x12, 0x3a, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
+	0x20, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x11, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x56, 0x69, 0x65, 0x77, 0x12, 0x16, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x00, 0x12,
+	0x34, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x52, 0x73, 0x76, 0x70, 0x12, 0x16, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x73, 0x76, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x73, 0x76, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x73, 0x76, 0x70, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x73, 0x76, 0x70, 0x22, 0x00, 0x12,
+	0x35, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x73, 0x76, 0x70, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x73, 0x76, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x12, 0x19, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
+	0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64,
+	0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x12, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1a, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x47, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x19, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x22, 0x00, 0x42, 0x0c, 0x5a, 0x0a,
+	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_event_proto_rawDescOnce sync.Once
+	file_event_proto_rawDescData = file_event_proto_rawDesc
+)
+
+func file_event_proto_rawDescGZIP() []byte {
+	file_event_proto_rawDescOnce.Do(func() {
+		file_event_proto_rawDescData = protoimpl.X.CompressGZIP(file_event_proto_rawDescData)
+	})
+	return file_event_proto_rawDescData
+}
+
+var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_event_proto_goTypes = []interface{}{
+	(*Event)(nil),                 // 0: eventGrpc.Event
+	(*Location)(nil),              // 1: eventGrpc.Location
+	(*EventId)(nil),               // 2: eventGrpc.EventId
+	(*AuthorId)(nil),              // 3: eventGrpc.AuthorId
+	(*UserId)(nil),                // 4: eventGrpc.UserId
+	(*UpdateEventRequest)(nil),    // 5: eventGrpc.UpdateEventRequest
+	(*DeleteEventRequest)(nil),    // 6: eventGrpc.DeleteEventRequest
+	(*GetEventsRequest)(nil),      // 7: eventGrpc.GetEventsRequest
+	(*BoundingBox)(nil),           // 8: eventGrpc.BoundingBox
+	(*MapRequest)(nil),            // 9: eventGrpc.MapRequest
+	(*UserEventsRequest)(nil),     // 10: eventGrpc.UserEventsRequest
+	(*Events)(nil),                // 11: eventGrpc.Events
+	(*VisitRequest)(nil),          // 12: eventGrpc.VisitRequest
+	(*IsVisitedRequest)(nil),      // 13: eventGrpc.IsVisitedRequest
+	(*GetCitiesRequest)(nil),      // 14: eventGrpc.GetCitiesRequest
+	(*SetEventHiddenRequest)(nil), // 15: eventGrpc.SetEventHiddenRequest
+	(*Registration)(nil),          // 16: eventGrpc.Registration
+	(*Promotion)(nil),             // 17: eventGrpc.Promotion
+	(*ViewRequest)(nil),           // 18: eventGrpc.ViewRequest
+	(*EventStatsRequest)(nil),     // 19: eventGrpc.EventStatsRequest
+	(*DayStats)(nil),              // 20: eventGrpc.DayStats
+	(*EventStats)(nil),            // 21: eventGrpc.EventStats
+	(*RsvpRequest)(nil),           // 22: eventGrpc.RsvpRequest
+	(*Rsvp)(nil),                  // 23: eventGrpc.Rsvp
+	(*Ticket)(nil),                // 24: eventGrpc.Ticket
+	(*CheckInRequest)(nil),        // 25: eventGrpc.CheckInRequest
+	(*Attendance)(nil),            // 26: eventGrpc.Attendance
+	(*CheckInResult)(nil),         // 27: eventGrpc.CheckInResult
+	(*Comment)(nil),               // 28: eventGrpc.Comment
+	(*Comments)(nil),              // 29: eventGrpc.Comments
+	(*CommentRequest)(nil),        // 30: eventGrpc.CommentRequest
+	(*CommentsRequest)(nil),       // 31: eventGrpc.CommentsRequest
+	(*Review)(nil),                // 32: eventGrpc.Review
+	(*Reviews)(nil),               // 33: eventGrpc.Reviews
+	(*ReviewsRequest)(nil),        // 34: eventGrpc.ReviewsRequest
+	(*Empty)(nil),                 // 35: eventGrpc.Empty
+}
+var file_event_proto_depIdxs = []int32{
+	1,  // 0: eventGrpc.Event.Location:type_name -> eventGrpc.Location
+	0,  // 1: eventGrpc.UpdateEventRequest.event:type_name -> eventGrpc.Event
+	1,  // 2: eventGrpc.GetEventsRequest.near:type_name -> eventGrpc.Location
+	8,  // 3: eventGrpc.MapRequest.box:type_name -> eventGrpc.BoundingBox
+	0,  // 4: eventGrpc.Events.events:type_name -> eventGrpc.Event
+	17, // 5: eventGrpc.Registration.promoted:type_name -> eventGrpc.Promotion
+	20, // 6: eventGrpc.EventStats.days:type_name -> eventGrpc.DayStats
+	26, // 7: eventGrpc.CheckInResult.attendance:type_name -> eventGrpc.Attendance
+	28, // 8: eventGrpc.Comments.comments:type_name -> eventGrpc.Comment
+	32, // 9: eventGrpc.Reviews.reviews:type_name -> eventGrpc.Review
+	0,  // 10: eventGrpc.Repository.CreateEvent:input_type -> eventGrpc.Event
+	5,  // 11: eventGrpc.Repository.UpdateEvent:input_type -> eventGrpc.UpdateEventRequest
+	6,  // 12: eventGrpc.Repository.DeleteEvent:input_type -> eventGrpc.DeleteEventRequest
+	2,  // 13: eventGrpc.Repository.GetEventById:input_type -> eventGrpc.EventId
+	7,  // 14: eventGrpc.Repository.GetEvents:input_type -> eventGrpc.GetEventsRequest
+	10, // 15: eventGrpc.Repository.GetVisitedEvents:input_type -> eventGrpc.UserEventsRequest
+	10, // 16: eventGrpc.Repository.GetCreatedEvents:input_type -> eventGrpc.UserEventsRequest
+	12, // 17: eventGrpc.Repository.Visit:input_type -> eventGrpc.VisitRequest
+	12, // 18: eventGrpc.Repository.Unvisit:input_type -> eventGrpc.VisitRequest
+	12, // 19: eventGrpc.Repository.IsVisited:input_type -> eventGrpc.VisitRequest
+	35, // 20: eventGrpc.Repository.GetCities:input_type -> eventGrpc.Empty
+	2,  // 21: eventGrpc.Repository.ForceDeleteEvent:input_type -> eventGrpc.EventId
+	15, // 22: eventGrpc.Repository.SetEventHidden:input_type -> eventGrpc.SetEventHiddenRequest
+	4,  // 23: eventGrpc.Repository.GetAuthorEvents:input_type -> eventGrpc.UserId
+	9,  // 24: eventGrpc.Repository.GetMapEvents:input_type -> eventGrpc.MapRequest
+	18, // 25: eventGrpc.Repository.RecordView:input_type -> eventGrpc.ViewRequest
+	12, // 26: eventGrpc.Repository.Register:input_type -> eventGrpc.VisitRequest
+	12, // 27: eventGrpc.Repository.CancelRegistration:input_type -> eventGrpc.VisitRequest
+	12, // 28: eventGrpc.Repository.GetRegistration:input_type -> eventGrpc.VisitRequest
+	19, // 29: eventGrpc.Repository.GetEventStats:input_type -> eventGrpc.EventStatsRequest
+	22, // 30: eventGrpc.Repository.SetRsvp:input_type -> eventGrpc.RsvpRequest
+	12, // 31: eventGrpc.Repository.DeleteRsvp:input_type -> eventGrpc.VisitRequest
+	12, // 32: eventGrpc.Repository.GetRsvp:input_type -> eventGrpc.VisitRequest
+	12, // 33: eventGrpc.Repository.GetTicket:input_type -> eventGrpc.VisitRequest
+	25, // 34: eventGrpc.Repository.CheckIn:input_type -> eventGrpc.CheckInRequest
+	12, // 35: eventGrpc.Repository.GetAttendance:input_type -> eventGrpc.VisitRequest
+	28, // 36: eventGrpc.Repository.CreateComment:input_type -> eventGrpc.Comment
+	30, // 37: eventGrpc.Repository.UpdateComment:input_type -> eventGrpc.CommentRequest
+	30, // 38: eventGrpc.Repository.DeleteComment:input_type -> eventGrpc.CommentRequest
+	31, // 39: eventGrpc.Repository.GetComments:input_type -> eventGrpc.CommentsRequest
+	32, // 40: eventGrpc.Repository.CreateReview:input_type -> eventGrpc.Review
+	34, // 41: eventGrpc.Repository.GetReviews:input_type -> eventGrpc.ReviewsRequest
+	2,  // 42: eventGrpc.Repository.CreateEvent:output_type -> eventGrpc.EventId
+	35, // 43: eventGrpc.Repository.UpdateEvent:output_type -> eventGrpc.Empty
+	35, // 44: eventGrpc.Repository.DeleteEvent:output_type -> eventGrpc.Empty
+	0,  // 45: eventGrpc.Repository.GetEventById:output_type -> eventGrpc.Event
+	11, // 46: eventGrpc.Repository.GetEvents:output_type -> eventGrpc.Events
+	11, // 47: eventGrpc.Repository.GetVisitedEvents:output_type -> eventGrpc.Events
+	11, // 48: eventGrpc.Repository.GetCreatedEvents:output_type -> eventGrpc.Events
+	35, // 49: eventGrpc.Repository.Visit:output_type -> eventGrpc.Empty
+	35, // 50: eventGrpc.Repository.Unvisit:output_type -> eventGrpc.Empty
+	13, // 51: eventGrpc.Repository.IsVisited:output_type -> eventGrpc.IsVisitedRequest
+	14, // 52: eventGrpc.Repository.GetCities:output_type -> eventGrpc.GetCitiesRequest
+	35, // 53: eventGrpc.Repository.ForceDeleteEvent:output_type -> eventGrpc.Empty
+	35, // 54: eventGrpc.Repository.SetEventHidden:output_type -> eventGrpc.Empty
+	11, // 55: eventGrpc.Repository.GetAuthorEvents:output_type -> eventGrpc.Events
+	11, // 56: eventGrpc.Repository.GetMapEvents:output_type -> eventGrpc.Events
+	35, // 57: eventGrpc.Repository.RecordView:output_type -> eventGrpc.Empty
+	16, // 58: eventGrpc.Repository.Register:output_type -> eventGrpc.Registration
+	16, // 59: eventGrpc.Repository.CancelRegistration:output_type -> eventGrpc.Registration
+	16, // 60: eventGrpc.Repository.GetRegistration:output_type -> eventGrpc.Registration
+	21, // 61: eventGrpc.Repository.GetEventStats:output_type -> eventGrpc.EventStats
+	23, // 62: eventGrpc.Repository.SetRsvp:output_type -> eventGrpc.Rsvp
+	23, // 63: eventGrpc.Repository.DeleteRsvp:output_type -> eventGrpc.Rsvp
+	23, // 64: eventGrpc.Repository.GetRsvp:output_type -> eventGrpc.Rsvp
+	24, // 65: eventGrpc.Repository.GetTicket:output_type -> eventGrpc.Ticket
+	27, // 66: eventGrpc.Repository.CheckIn:output_type -> eventGrpc.CheckInResult
+	26, // 67: eventGrpc.Repository.GetAttendance:output_type -> eventGrpc.Attendance
+	28, // 68: eventGrpc.Repository.CreateComment:output_type -> eventGrpc.Comment
+	28, // 69: eventGrpc.Repository.UpdateComment:output_type -> eventGrpc.Comment
+	35, // 70: eventGrpc.Repository.DeleteComment:output_type -> eventGrpc.Empty
+	29, // 71: eventGrpc.Repository.GetComments:output_type -> eventGrpc.Comments
+	32, // 72: eventGrpc.Repository.CreateReview:output_type -> eventGrpc.Review
+	33, // 73: eventGrpc.Repository.GetReviews:output_type -> eventGrpc.Reviews
+	42, // [42:74] is the sub-list for method output_type
+	10, // [10:42] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_event_proto_init() }
+func file_event_proto_init() {
+	if File_event_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_event_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoundingBox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Events); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VisitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsVisitedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEventHiddenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Registration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Promotion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DayStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsvpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rsvp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ticket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckInRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attendance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckInResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Review); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reviews); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_event_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   36,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_event_proto_goTypes,
+		DependencyIndexes: file_event_proto_depIdxs,
+		MessageInfos:      file_event_proto_msgTypes,
+	}.Build()
+	File_event_proto = out.File
+	file_event_proto_rawDesc = nil
+	file_event_proto_goTypes = nil
+	file_event_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RepositoryClient is the client API for Repository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RepositoryClient interface {
+	CreateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*EventId, error)
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetEventById(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Event, error)
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*Events, error)
+	GetVisitedEvents(ctx context.Context, in *UserEventsRequest, opts ...grpc.CallOption) (*Events, error)
+	GetCreatedEvents(ctx context.Context, in *UserEventsRequest, opts ...grpc.CallOption) (*Events, error)
+	Visit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error)
+	Unvisit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error)
+	IsVisited(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*IsVisitedRequest, error)
+	GetCities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCitiesRequest, error)
+	ForceDeleteEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Empty, error)
+	SetEventHidden(ctx context.Context, in *SetEventHiddenRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetAuthorEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error)
+	GetMapEvents(ctx context.Context, in *MapRequest, opts ...grpc.CallOption) (*Events, error)
+	RecordView(ctx context.Context, in *ViewRequest, opts ...grpc.CallOption) (*Empty, error)
+	Register(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Registration, error)
+	CancelRegistration(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Registration, error)
+	GetRegistration(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Registration, error)
+	GetEventStats(ctx context.Context, in *EventStatsRequest, opts ...grpc.CallOption) (*EventStats, error)
+	SetRsvp(ctx context.Context, in *RsvpRequest, opts ...grpc.CallOption) (*Rsvp, error)
+	DeleteRsvp(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Rsvp, error)
+	GetRsvp(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Rsvp, error)
+	GetTicket(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Ticket, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResult, error)
+	GetAttendance(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Attendance, error)
+	CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error)
+	UpdateComment(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetComments(ctx context.Context, in *CommentsRequest, opts ...grpc.CallOption) (*Comments, error)
+	CreateReview(ctx context.Context, in *Review, opts ...grpc.CallOption) (*Review, error)
+	GetReviews(ctx context.Context, in *ReviewsRequest, opts ...grpc.CallOption) (*Reviews, error)
+}
+
+type repositoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRepositoryClient(cc grpc.ClientConnInterface) RepositoryClient {
+	return &repositoryClient{cc}
+}
+
+func (c *repositoryClient) CreateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*EventId, error) {
+	out := new(EventId)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/CreateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/UpdateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/DeleteEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetEventById(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetEventById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetVisitedEvents(ctx context.Context, in *UserEventsRequest, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetVisitedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetCreatedEvents(ctx context.Context, in *UserEventsRequest, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetCreatedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Visit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/Visit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Unvisit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/Unvisit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) IsVisited(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*IsVisitedRequest, error) {
+	out := new(IsVisitedRequest)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/IsVisited", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetCities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCitiesRequest, error) {
+	out := new(GetCitiesRequest)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetCities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ForceDeleteEvent(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/ForceDeleteEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) SetEventHidden(ctx context.Context, in *SetEventHiddenRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/SetEventHidden", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetAuthorEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetAuthorEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetMapEvents(ctx context.Context, in *MapRequest, opts ...grpc.CallOption) (*Events, error) {
+	out := new(Events)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetMapEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) RecordView(ctx context.Context, in *ViewRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/RecordView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Register(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Registration, error) {
+	out := new(Registration)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CancelRegistration(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Registration, error) {
+	out := new(Registration)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/CancelRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetRegistration(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Registration, error) {
+	out := new(Registration)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetEventStats(ctx context.Context, in *EventStatsRequest, opts ...grpc.CallOption) (*EventStats, error) {
+	out := new(EventStats)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetEventStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) SetRsvp(ctx context.Context, in *RsvpRequest, opts ...grpc.CallOption) (*Rsvp, error) {
+	out := new(Rsvp)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/SetRsvp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DeleteRsvp(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Rsvp, error) {
+	out := new(Rsvp)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/DeleteRsvp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetRsvp(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Rsvp, error) {
+	out := new(Rsvp)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetRsvp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetTicket(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetTicket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResult, error) {
+	out := new(CheckInResult)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetAttendance(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Attendance, error) {
+	out := new(Attendance)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetAttendance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/CreateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) UpdateComment(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/UpdateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) DeleteComment(ctx context.Context, in *CommentRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetComments(ctx context.Context, in *CommentsRequest, opts ...grpc.CallOption) (*Comments, error) {
+	out := new(Comments)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CreateReview(ctx context.Context, in *Review, opts ...grpc.CallOption) (*Review, error) {
+	out := new(Review)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/CreateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) GetReviews(ctx context.Context, in *ReviewsRequest, opts ...grpc.CallOption) (*Reviews, error) {
+	out := new(Reviews)
+	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RepositoryServer is the server API for Repository service.
+type RepositoryServer interface {
+	CreateEvent(context.Context, *Event) (*EventId, error)
+	UpdateEvent(context.Context, *UpdateEventRequest) (*Empty, error)
+	DeleteEvent(context.Context, *DeleteEventRequest) (*Empty, error)
+	GetEventById(context.Context, *EventId) (*Event, error)
+	GetEvents(context.Context, *GetEventsRequest) (*Events, error)
+	GetVisitedEvents(context.Context, *UserEventsRequest) (*Events, error)
+	GetCreatedEvents(context.Context, *UserEventsRequest) (*Events, error)
+	Visit(context.Context, *VisitRequest) (*Empty, error)
+	Unvisit(context.Context, *VisitRequest) (*Empty, error)
+	IsVisited(context.Context, *VisitRequest) (*IsVisitedRequest, error)
+	GetCities(context.Context, *Empty) (*GetCitiesRequest, error)
+	ForceDeleteEvent(context.Context, *EventId) (*Empty, error)
+	SetEventHidden(context.Context, *SetEventHiddenRequest) (*Empty, error)
+	GetAuthorEvents(context.Context, *UserId) (*Events, error)
+	GetMapEvents(context.Context, *MapRequest) (*Events, error)
+	RecordView(context.Context, *ViewRequest) (*Empty, error)
+	Register(context.Context, *VisitRequest) (*Registration, error)
+	CancelRegistration(context.Context, *VisitRequest) (*Registration, error)
+	GetRegistration(context.Context, *VisitRequest) (*Registration, error)
+	GetEventStats(context.Context, *EventStatsRequest) (*EventStats, error)
+	SetRsvp(context.Context, *RsvpRequest) (*Rsvp, error)
+	DeleteRsvp(context.Context, *VisitRequest) (*Rsvp, error)
+	GetRsvp(context.Context, *VisitRequest) (*Rsvp, error)
+	GetTicket(context.Context, *VisitRequest) (*Ticket, error)
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResult, error)
+	GetAttendance(context.Context, *VisitRequest) (*Attendance, error)
+	CreateComment(context.Context, *Comment) (*Comment, error)
+	UpdateComment(context.Context, *CommentRequest) (*Comment, error)
+	DeleteComment(context.Context, *CommentRequest) (*Empty, error)
+	GetComments(context.Context, *CommentsRequest) (*Comments, error)
+	CreateReview(context.Context, *Review) (*Review, error)
+	GetReviews(context.Context, *ReviewsRequest) (*Reviews, error)
+}
+
+// UnimplementedRepositoryServer can be embedded to have forward compatible implementations.
+type UnimplementedRepositoryServer struct {
+}
+
+func (*UnimplementedRepositoryServer) CreateEvent(context.Context, *Event) (*EventId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (*UnimplementedRepositoryServer) UpdateEvent(context.Context, *UpdateEventRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (*UnimplementedRepositoryServer) DeleteEvent(context.Context, *DeleteEventRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (*UnimplementedRepositoryServer) GetEventById(context.Context, *EventId) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventById not implemented")
+}
+func (*UnimplementedRepositoryServer) GetEvents(context.Context, *GetEventsRequest) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (*UnimplementedRepositoryServer) GetVisitedEvents(context.Context, *UserEventsRequest) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVisitedEvents not implemented")
+}
+func (*UnimplementedRepositoryServer) GetCreatedEvents(context.Context, *UserEventsRequest) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreatedEvents not implemented")
+}
+func (*UnimplementedRepositoryServer) Visit(context.Context, *VisitRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Visit not implemented")
+}
+func (*UnimplementedRepositoryServer) Unvisit(context.Context, *VisitRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unvisit not implemented")
+}
+func (*UnimplementedRepositoryServer) IsVisited(context.Context, *VisitRequest) (*IsVisitedRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsVisited not implemented")
+}
+func (*UnimplementedRepositoryServer) GetCities(context.Context, *Empty) (*GetCitiesRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCities not implemented")
+}
+func (*UnimplementedRepositoryServer) ForceDeleteEvent(context.Context, *EventId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceDeleteEvent not implemented")
+}
+func (*UnimplementedRepositoryServer) SetEventHidden(context.Context, *SetEventHiddenRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventHidden not implemented")
+}
+func (*UnimplementedRepositoryServer) GetAuthorEvents(context.Context, *UserId) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorEvents not implemented")
+}
+func (*UnimplementedRepositoryServer) GetMapEvents(context.Context, *MapRequest) (*Events, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMapEvents not implemented")
+}
+func (*UnimplementedRepositoryServer) RecordView(context.Context, *ViewRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordView not implemented")
+}
+func (*UnimplementedRepositoryServer) Register(context.Context, *VisitRequest) (*Registration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedRepositoryServer) CancelRegistration(context.Context, *VisitRequest) (*Registration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRegistration not implemented")
+}
+func (*UnimplementedRepositoryServer) GetRegistration(context.Context, *VisitRequest) (*Registration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistration not implemented")
+}
+func (*UnimplementedRepositoryServer) GetEventStats(context.Context, *EventStatsRequest) (*EventStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventStats not implemented")
+}
+func (*UnimplementedRepositoryServer) SetRsvp(context.Context, *RsvpRequest) (*Rsvp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRsvp not implemented")
+}
+func (*UnimplementedRepositoryServer) DeleteRsvp(context.Context, *VisitRequest) (*Rsvp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRsvp not implemented")
+}
+func (*UnimplementedRepositoryServer) GetRsvp(context.Context, *VisitRequest) (*Rsvp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRsvp not implemented")
+}
+func (*UnimplementedRepositoryServer) GetTicket(context.Context, *VisitRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+}
+func (*UnimplementedRepositoryServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (*UnimplementedRepositoryServer) GetAttendance(context.Context, *VisitRequest) (*Attendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendance not implemented")
+}
+func (*UnimplementedRepositoryServer) CreateComment(context.Context, *Comment) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (*UnimplementedRepositoryServer) UpdateComment(context.Context, *CommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (*UnimplementedRepositoryServer) DeleteComment(context.Context, *CommentRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (*UnimplementedRepositoryServer) GetComments(context.Context, *CommentsRequest) (*Comments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
+}
+func (*UnimplementedRepositoryServer) CreateReview(context.Context, *Review) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (*UnimplementedRepositoryServer) GetReviews(context.Context, *ReviewsRequest) (*Reviews, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviews not implemented")
+}
+
+func RegisterRepositoryServer(s *grpc.Server, srv RepositoryServer) {
+	s.RegisterService(&_Repository_serviceDesc, srv)
+}
+
+func _Repository_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Event)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).CreateEvent(ctx, in)
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_event_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_event_proto_goTypes,
-		DependencyIndexes: file_event_proto_depIdxs,
-		MessageInfos:      file_event_proto_msgTypes,
-	}.Build()
-	File_event_proto = out.File
-	file_event_proto_rawDesc = nil
-	file_event_proto_goTypes = nil
-	file_event_proto_depIdxs = nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/CreateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).CreateEvent(ctx, req.(*Event))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func _Repository_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/UpdateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _Repository_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/DeleteEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// RepositoryClient is the client API for Repository service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RepositoryClient interface {
-	CreateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*EventId, error)
-	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Empty, error)
-	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error)
-	GetEventById(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Event, error)
-	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*Events, error)
-	GetVisitedEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error)
-	GetCreatedEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error)
-	Visit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error)
-	Unvisit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error)
-	IsVisited(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*IsVisitedRequest, error)
-	GetCities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCitiesRequest, error)
+func _Repository_GetEventById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetEventById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/GetEventById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetEventById(ctx, req.(*EventId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type repositoryClient struct {
-	cc grpc.ClientConnInterface
+func _Repository_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewRepositoryClient(cc grpc.ClientConnInterface) RepositoryClient {
-	return &repositoryClient{cc}
+func _Repository_GetVisitedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetVisitedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/GetVisitedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetVisitedEvents(ctx, req.(*UserEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) CreateEvent(ctx context.Context, in *Event, opts ...grpc.CallOption) (*EventId, error) {
-	out := new(EventId)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/CreateEvent", in, out, opts...)
-	if err != nil {
+func _Repository_GetCreatedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserEventsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetCreatedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/GetCreatedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetCreatedEvents(ctx, req.(*UserEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/UpdateEvent", in, out, opts...)
-	if err != nil {
+func _Repository_Visit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).Visit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/Visit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Visit(ctx, req.(*VisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/DeleteEvent", in, out, opts...)
-	if err != nil {
+func _Repository_Unvisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).Unvisit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/Unvisit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Unvisit(ctx, req.(*VisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) GetEventById(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Event, error) {
-	out := new(Event)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetEventById", in, out, opts...)
-	if err != nil {
+func _Repository_IsVisited_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).IsVisited(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/IsVisited",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).IsVisited(ctx, req.(*VisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_GetCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/GetCities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetCities(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_ForceDeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ForceDeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/ForceDeleteEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ForceDeleteEvent(ctx, req.(*EventId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_SetEventHidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventHiddenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).SetEventHidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/SetEventHidden",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).SetEventHidden(ctx, req.(*SetEventHiddenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_GetAuthorEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserId)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetAuthorEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/GetAuthorEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetAuthorEvents(ctx, req.(*UserId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*Events, error) {
-	out := new(Events)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetEvents", in, out, opts...)
-	if err != nil {
+func _Repository_GetMapEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetMapEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/GetMapEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetMapEvents(ctx, req.(*MapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) GetVisitedEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error) {
-	out := new(Events)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetVisitedEvents", in, out, opts...)
-	if err != nil {
+func _Repository_RecordView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).RecordView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/RecordView",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).RecordView(ctx, req.(*ViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) GetCreatedEvents(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Events, error) {
-	out := new(Events)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetCreatedEvents", in, out, opts...)
-	if err != nil {
+func _Repository_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Register(ctx, req.(*VisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) Visit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/Visit", in, out, opts...)
-	if err != nil {
+func _Repository_CancelRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).CancelRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/CancelRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).CancelRegistration(ctx, req.(*VisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) Unvisit(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/Unvisit", in, out, opts...)
-	if err != nil {
+func _Repository_GetRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/GetRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetRegistration(ctx, req.(*VisitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) IsVisited(ctx context.Context, in *VisitRequest, opts ...grpc.CallOption) (*IsVisitedRequest, error) {
-	out := new(IsVisitedRequest)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/IsVisited", in, out, opts...)
-	if err != nil {
+func _Repository_GetEventStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventStatsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetEventStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/GetEventStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetEventStats(ctx, req.(*EventStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *repositoryClient) GetCities(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCitiesRequest, error) {
-	out := new(GetCitiesRequest)
-	err := c.cc.Invoke(ctx, "/eventGrpc.Repository/GetCities", in, out, opts...)
-	if err != nil {
+func _Repository_SetRsvp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsvpRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// RepositoryServer is the server API for Repository service.
-type RepositoryServer interface {
-	CreateEvent(context.Context, *Event) (*EventId, error)
-	UpdateEvent(context.Context, *UpdateEventRequest) (*Empty, error)
-	DeleteEvent(context.Context, *DeleteEventRequest) (*Empty, error)
-	GetEventById(context.Context, *EventId) (*Event, error)
-	GetEvents(context.Context, *GetEventsRequest) (*Events, error)
-	GetVisitedEvents(context.Context, *UserId) (*Events, error)
-	GetCreatedEvents(context.Context, *UserId) (*Events, error)
-	Visit(context.Context, *VisitRequest) (*Empty, error)
-	Unvisit(context.Context, *VisitRequest) (*Empty, error)
-	IsVisited(context.Context, *VisitRequest) (*IsVisitedRequest, error)
-	GetCities(context.Context, *Empty) (*GetCitiesRequest, error)
-}
-
-// UnimplementedRepositoryServer can be embedded to have forward compatible implementations.
-type UnimplementedRepositoryServer struct {
-}
-
-func (*UnimplementedRepositoryServer) CreateEvent(context.Context, *Event) (*EventId, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
-}
-func (*UnimplementedRepositoryServer) UpdateEvent(context.Context, *UpdateEventRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
-}
-func (*UnimplementedRepositoryServer) DeleteEvent(context.Context, *DeleteEventRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
-}
-func (*UnimplementedRepositoryServer) GetEventById(context.Context, *EventId) (*Event, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEventById not implemented")
-}
-func (*UnimplementedRepositoryServer) GetEvents(context.Context, *GetEventsRequest) (*Events, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
-}
-func (*UnimplementedRepositoryServer) GetVisitedEvents(context.Context, *UserId) (*Events, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVisitedEvents not implemented")
-}
-func (*UnimplementedRepositoryServer) GetCreatedEvents(context.Context, *UserId) (*Events, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCreatedEvents not implemented")
-}
-func (*UnimplementedRepositoryServer) Visit(context.Context, *VisitRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Visit not implemented")
-}
-func (*UnimplementedRepositoryServer) Unvisit(context.Context, *VisitRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unvisit not implemented")
-}
-func (*UnimplementedRepositoryServer) IsVisited(context.Context, *VisitRequest) (*IsVisitedRequest, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsVisited not implemented")
-}
-func (*UnimplementedRepositoryServer) GetCities(context.Context, *Empty) (*GetCitiesRequest, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCities not implemented")
-}
-
-func RegisterRepositoryServer(s *grpc.Server, srv RepositoryServer) {
-	s.RegisterService(&_Repository_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(RepositoryServer).SetRsvp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eventGrpc.Repository/SetRsvp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).SetRsvp(ctx, req.(*RsvpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Event)
+func _Repository_DeleteRsvp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).CreateEvent(ctx, in)
+		return srv.(RepositoryServer).DeleteRsvp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/CreateEvent",
+		FullMethod: "/eventGrpc.Repository/DeleteRsvp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).CreateEvent(ctx, req.(*Event))
+		return srv.(RepositoryServer).DeleteRsvp(ctx, req.(*VisitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEventRequest)
+func _Repository_GetRsvp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).UpdateEvent(ctx, in)
+		return srv.(RepositoryServer).GetRsvp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/UpdateEvent",
+		FullMethod: "/eventGrpc.Repository/GetRsvp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+		return srv.(RepositoryServer).GetRsvp(ctx, req.(*VisitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEventRequest)
+func _Repository_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).DeleteEvent(ctx, in)
+		return srv.(RepositoryServer).GetTicket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/DeleteEvent",
+		FullMethod: "/eventGrpc.Repository/GetTicket",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+		return srv.(RepositoryServer).GetTicket(ctx, req.(*VisitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_GetEventById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventId)
+func _Repository_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).GetEventById(ctx, in)
+		return srv.(RepositoryServer).CheckIn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/GetEventById",
+		FullMethod: "/eventGrpc.Repository/CheckIn",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetEventById(ctx, req.(*EventId))
+		return srv.(RepositoryServer).CheckIn(ctx, req.(*CheckInRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventsRequest)
+func _Repository_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).GetEvents(ctx, in)
+		return srv.(RepositoryServer).GetAttendance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/GetEvents",
+		FullMethod: "/eventGrpc.Repository/GetAttendance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetEvents(ctx, req.(*GetEventsRequest))
+		return srv.(RepositoryServer).GetAttendance(ctx, req.(*VisitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_GetVisitedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserId)
+func _Repository_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Comment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).GetVisitedEvents(ctx, in)
+		return srv.(RepositoryServer).CreateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/GetVisitedEvents",
+		FullMethod: "/eventGrpc.Repository/CreateComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetVisitedEvents(ctx, req.(*UserId))
+		return srv.(RepositoryServer).CreateComment(ctx, req.(*Comment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_GetCreatedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserId)
+func _Repository_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).GetCreatedEvents(ctx, in)
+		return srv.(RepositoryServer).UpdateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/GetCreatedEvents",
+		FullMethod: "/eventGrpc.Repository/UpdateComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetCreatedEvents(ctx, req.(*UserId))
+		return srv.(RepositoryServer).UpdateComment(ctx, req.(*CommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_Visit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VisitRequest)
+func _Repository_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).Visit(ctx, in)
+		return srv.(RepositoryServer).DeleteComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/Visit",
+		FullMethod: "/eventGrpc.Repository/DeleteComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).Visit(ctx, req.(*VisitRequest))
+		return srv.(RepositoryServer).DeleteComment(ctx, req.(*CommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_Unvisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VisitRequest)
+func _Repository_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).Unvisit(ctx, in)
+		return srv.(RepositoryServer).GetComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/Unvisit",
+		FullMethod: "/eventGrpc.Repository/GetComments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).Unvisit(ctx, req.(*VisitRequest))
+		return srv.(RepositoryServer).GetComments(ctx, req.(*CommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_IsVisited_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VisitRequest)
+func _Repository_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Review)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).IsVisited(ctx, in)
+		return srv.(RepositoryServer).CreateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/IsVisited",
+		FullMethod: "/eventGrpc.Repository/CreateReview",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).IsVisited(ctx, req.(*VisitRequest))
+		return srv.(RepositoryServer).CreateReview(ctx, req.(*Review))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_GetCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Repository_GetReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).GetCities(ctx, in)
+		return srv.(RepositoryServer).GetReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/eventGrpc.Repository/GetCities",
+		FullMethod: "/eventGrpc.Repository/GetReviews",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetCities(ctx, req.(*Empty))
+		return srv.(RepositoryServer).GetReviews(ctx, req.(*ReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1503,6 +4547,90 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCities",
 			Handler:    _Repository_GetCities_Handler,
 		},
+		{
+			MethodName: "ForceDeleteEvent",
+			Handler:    _Repository_ForceDeleteEvent_Handler,
+		},
+		{
+			MethodName: "SetEventHidden",
+			Handler:    _Repository_SetEventHidden_Handler,
+		},
+		{
+			MethodName: "GetAuthorEvents",
+			Handler:    _Repository_GetAuthorEvents_Handler,
+		},
+		{
+			MethodName: "GetMapEvents",
+			Handler:    _Repository_GetMapEvents_Handler,
+		},
+		{
+			MethodName: "RecordView",
+			Handler:    _Repository_RecordView_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _Repository_Register_Handler,
+		},
+		{
+			MethodName: "CancelRegistration",
+			Handler:    _Repository_CancelRegistration_Handler,
+		},
+		{
+			MethodName: "GetRegistration",
+			Handler:    _Repository_GetRegistration_Handler,
+		},
+		{
+			MethodName: "GetEventStats",
+			Handler:    _Repository_GetEventStats_Handler,
+		},
+		{
+			MethodName: "SetRsvp",
+			Handler:    _Repository_SetRsvp_Handler,
+		},
+		{
+			MethodName: "DeleteRsvp",
+			Handler:    _Repository_DeleteRsvp_Handler,
+		},
+		{
+			MethodName: "GetRsvp",
+			Handler:    _Repository_GetRsvp_Handler,
+		},
+		{
+			MethodName: "GetTicket",
+			Handler:    _Repository_GetTicket_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _Repository_CheckIn_Handler,
+		},
+		{
+			MethodName: "GetAttendance",
+			Handler:    _Repository_GetAttendance_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _Repository_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _Repository_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Repository_DeleteComment_Handler,
+		},
+		{
+			MethodName: "GetComments",
+			Handler:    _Repository_GetComments_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _Repository_CreateReview_Handler,
+		},
+		{
+			MethodName: "GetReviews",
+			Handler:    _Repository_GetReviews_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event.proto",
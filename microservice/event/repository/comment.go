@@ -0,0 +1,258 @@
+package eventRepository
+
+import (
+	proto "backend/microservice/event/proto"
+	log "backend/pkg/logger"
+	"backend/pkg/utils"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+	"strconv"
+	"time"
+)
+
+//Сколько после публикации автор может править комментарий
+const CommentEditWindow = 15 * time.Minute
+
+//Удалённый комментарий остаётся в ветке без текста (deleted_at не NULL), чтобы ответы
+//на него не потеряли родителя. Ответить можно только на неудалённый комментарий того же мероприятия.
+//Комментарии скрытого мероприятия не видны, их нельзя править и удалять
+const (
+	commentColumns = `c.id, c.event_id, c.user_id, c.parent_id, c.text, c.created_at, c.edited_at, c.deleted_at,
+		(select count(*) from "comment" r where r.parent_id = c.id) as replies`
+	commentFrom        = ` from "comment" c join "event" e on e.id = c.event_id and e.hidden = false`
+	getCommentQuery    = `select ` + commentColumns + commentFrom + ` where c.id = $1 and c.event_id = $2`
+	getCommentsQuery   = `select ` + commentColumns + commentFrom + ` where c.event_id = $1`
+	createCommentQuery = `insert into "comment" (event_id, user_id, parent_id, text)
+		select e.id, $2, $3, $4 from "event" e where e.id = $1 and e.hidden = false
+		and ($3::int is null or exists (select 1 from "comment" p where p.id = $3 and p.event_id = e.id and p.deleted_at is null))
+		returning id`
+	//Окно правки ($4, в секундах) проверяется в самом запросе по часам базы
+	updateCommentQuery = `update "comment" set text = $2, edited_at = now()
+		where id = $1 and user_id = $3 and deleted_at is null and created_at > now() - make_interval(secs => $4)`
+	deleteCommentQuery = `update "comment" set text = '', deleted_at = now() where id = $1 and deleted_at is null`
+)
+
+type Comment struct {
+	ID        int            `db:"id"`
+	EventId   int            `db:"event_id"`
+	UserId    int            `db:"user_id"`
+	ParentId  sql2.NullInt64 `db:"parent_id"`
+	Text      string         `db:"text"`
+	CreatedAt time.Time      `db:"created_at"`
+	EditedAt  sql2.NullTime  `db:"edited_at"`
+	DeletedAt sql2.NullTime  `db:"deleted_at"`
+	Replies   int32          `db:"replies"`
+}
+
+func toProtoComment(c *Comment) *proto.Comment {
+	out := &proto.Comment{
+		Id:        strconv.Itoa(c.ID),
+		EventId:   strconv.Itoa(c.EventId),
+		UserId:    strconv.Itoa(c.UserId),
+		Text:      c.Text,
+		CreatedAt: c.CreatedAt.UTC().Format(time.RFC3339),
+		Deleted:   c.DeletedAt.Valid,
+		Replies:   c.Replies,
+	}
+	if c.ParentId.Valid {
+		out.ParentId = strconv.FormatInt(c.ParentId.Int64, 10)
+	}
+	if c.EditedAt.Valid {
+		out.EditedAt = c.EditedAt.Time.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+//Пустой id - NULL, например комментарий верхнего уровня
+func parseOptionalId(id string) (sql2.NullInt64, error) {
+	if id == "" {
+		return sql2.NullInt64{}, nil
+	}
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return sql2.NullInt64{}, error2.ErrAtoi
+	}
+	return sql2.NullInt64{Int64: int64(idInt), Valid: true}, nil
+}
+
+//Неудалённый комментарий мероприятия
+func (s *Repository) getComment(commentId int, eventId int) (*Comment, error) {
+	var c Comment
+	err := s.db.Get(&c, getCommentQuery, commentId, eventId)
+	if err == sql2.ErrNoRows {
+		return nil, error2.ErrCommentNotFound
+	}
+	if err != nil {
+		log.Error(logMessage+"getComment:err =", err)
+		return nil, error2.ErrPostgres
+	}
+	if c.DeletedAt.Valid {
+		return nil, error2.ErrCommentNotFound
+	}
+	return &c, nil
+}
+
+func parseCommentRequest(in *proto.CommentRequest) (int, int, int, error) {
+	commentIdInt, err := strconv.Atoi(in.CommentId)
+	if err != nil {
+		return 0, 0, 0, error2.ErrAtoi
+	}
+	eventIdInt, userIdInt, err := parseVisitRequest(&proto.VisitRequest{EventId: in.EventId, UserId: in.UserId})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return commentIdInt, eventIdInt, userIdInt, nil
+}
+
+func (s *Repository) CreateComment(ctx context.Context, in *proto.Comment) (*proto.Comment, error) {
+	message := logMessage + "CreateComment:"
+	log.Debug(message + "started")
+	eventIdInt, userIdInt, err := parseVisitRequest(&proto.VisitRequest{EventId: in.EventId, UserId: in.UserId})
+	if err != nil {
+		return &proto.Comment{}, err
+	}
+	parentId, err := parseOptionalId(in.ParentId)
+	if err != nil {
+		return &proto.Comment{}, err
+	}
+	var commentId int
+	err = s.db.Get(&commentId, createCommentQuery, eventIdInt, userIdInt, parentId, in.Text)
+	if err == sql2.ErrNoRows {
+		if parentId.Valid {
+			return &proto.Comment{}, error2.ErrCommentNotFound
+		}
+		return &proto.Comment{}, error2.ErrEventNotFound
+	}
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Comment{}, error2.ErrPostgres
+	}
+	c, err := s.getComment(commentId, eventIdInt)
+	if err != nil {
+		return &proto.Comment{}, err
+	}
+	log.Debug(message + "ended")
+	return toProtoComment(c), nil
+}
+
+//Править может только автор комментария и только в течение CommentEditWindow
+func (s *Repository) UpdateComment(ctx context.Context, in *proto.CommentRequest) (*proto.Comment, error) {
+	message := logMessage + "UpdateComment:"
+	log.Debug(message + "started")
+	commentIdInt, eventIdInt, userIdInt, err := parseCommentRequest(in)
+	if err != nil {
+		return &proto.Comment{}, err
+	}
+	c, err := s.getComment(commentIdInt, eventIdInt)
+	if err != nil {
+		return &proto.Comment{}, err
+	}
+	if c.UserId != userIdInt {
+		return &proto.Comment{}, error2.ErrNotAllowed
+	}
+	res, err := s.db.Exec(updateCommentQuery, commentIdInt, in.Text, userIdInt, CommentEditWindow.Seconds())
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Comment{}, error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return &proto.Comment{}, error2.ErrPostgres
+	}
+	//Автор уже проверен: не обновилось - окно правки закрылось или комментарий удалили
+	if affected == 0 {
+		return &proto.Comment{}, error2.ErrEditWindowExpired
+	}
+	c, err = s.getComment(commentIdInt, eventIdInt)
+	if err != nil {
+		return &proto.Comment{}, err
+	}
+	log.Debug(message + "ended")
+	return toProtoComment(c), nil
+}
+
+//Удалить комментарий может его автор или автор мероприятия
+func (s *Repository) DeleteComment(ctx context.Context, in *proto.CommentRequest) (*proto.Empty, error) {
+	message := logMessage + "DeleteComment:"
+	log.Debug(message + "started")
+	commentIdInt, eventIdInt, userIdInt, err := parseCommentRequest(in)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+	c, err := s.getComment(commentIdInt, eventIdInt)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+	if c.UserId != userIdInt {
+		err = s.checkAuthor(eventIdInt, userIdInt)
+		if err != nil {
+			return &proto.Empty{}, err
+		}
+	}
+	_, err = s.db.Exec(deleteCommentQuery, commentIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Empty{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return &proto.Empty{}, nil
+}
+
+//Один уровень ветки: без parentId - комментарии верхнего уровня, иначе ответы на parentId.
+//Листается по id от новых к старым, как и остальные списки
+func (s *Repository) GetComments(ctx context.Context, in *proto.CommentsRequest) (*proto.Comments, error) {
+	message := logMessage + "GetComments:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(in.EventId)
+	if err != nil {
+		return &proto.Comments{}, error2.ErrAtoi
+	}
+	parentId, err := parseOptionalId(in.ParentId)
+	if err != nil {
+		return &proto.Comments{}, err
+	}
+	cursor, err := utils.DecodeCursor(in.Cursor)
+	if err != nil {
+		return &proto.Comments{}, err
+	}
+	limit := utils.PageLimit(int(in.Limit))
+	query := getCommentsQuery
+	args := []interface{}{eventIdInt}
+	if parentId.Valid {
+		args = append(args, parentId.Int64)
+		query += ` and c.parent_id = $` + strconv.Itoa(len(args))
+	} else {
+		query += ` and c.parent_id is null`
+	}
+	if cursor != nil {
+		args = append(args, cursor.ID)
+		query += ` and c.id < $` + strconv.Itoa(len(args))
+	}
+	args = append(args, limit+1)
+	query += ` order by c.id DESC limit $` + strconv.Itoa(len(args))
+	rows, err := s.db.Queryx(query, args...)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Comments{}, error2.ErrPostgres
+	}
+	defer rows.Close()
+	out := &proto.Comments{}
+	var lastId int
+	for rows.Next() {
+		var c Comment
+		err = rows.StructScan(&c)
+		if err != nil {
+			log.Error(message+"err =", err)
+			return &proto.Comments{}, error2.ErrPostgres
+		}
+		if len(out.Comments) == limit {
+			out.NextCursor = utils.EncodeCursor(&utils.Cursor{ID: lastId})
+			break
+		}
+		lastId = c.ID
+		out.Comments = append(out.Comments, toProtoComment(&c))
+	}
+	log.Debug(message + "ended")
+	return out, nil
+}
@@ -0,0 +1,149 @@
+package eventRepository
+
+import (
+	eventGrpc "backend/microservice/event/proto"
+	"backend/pkg/utils"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var commentRowColumns = []string{"id", "event_id", "user_id", "parent_id", "text", "created_at", "edited_at", "deleted_at", "replies"}
+
+func expectComment(mock sqlmock.Sqlmock, createdAt time.Time, deletedAt interface{}) {
+	mock.ExpectQuery(getCommentQuery).WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows(commentRowColumns).AddRow(3, 1, 5, nil, "Пойду", createdAt, nil, deletedAt, 2))
+}
+
+func TestCreateComment(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+	createdAt := time.Date(2021, 11, 13, 18, 30, 0, 0, time.UTC)
+
+	mock.ExpectQuery(createCommentQuery).WithArgs(1, 5, sql2.NullInt64{Int64: 2, Valid: true}, "Пойду").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(getCommentQuery).WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows(commentRowColumns).AddRow(3, 1, 5, 2, "Пойду", createdAt, nil, nil, 0))
+	out, err := repositoryTest.CreateComment(context.Background(), &eventGrpc.Comment{EventId: "1", UserId: "5", ParentId: "2", Text: "Пойду"})
+	require.NoError(t, err)
+	require.Equal(t, &eventGrpc.Comment{
+		Id:        "3",
+		EventId:   "1",
+		UserId:    "5",
+		ParentId:  "2",
+		Text:      "Пойду",
+		CreatedAt: "2021-11-13T18:30:00Z",
+	}, out)
+
+	//Родитель удалён или из другого мероприятия
+	mock.ExpectQuery(createCommentQuery).WithArgs(1, 5, sql2.NullInt64{Int64: 2, Valid: true}, "Пойду").
+		WillReturnError(sql2.ErrNoRows)
+	_, err = repositoryTest.CreateComment(context.Background(), &eventGrpc.Comment{EventId: "1", UserId: "5", ParentId: "2", Text: "Пойду"})
+	require.Equal(t, error2.ErrCommentNotFound, err)
+
+	mock.ExpectQuery(createCommentQuery).WithArgs(1, 5, sql2.NullInt64{}, "Пойду").WillReturnError(sql2.ErrNoRows)
+	_, err = repositoryTest.CreateComment(context.Background(), &eventGrpc.Comment{EventId: "1", UserId: "5", Text: "Пойду"})
+	require.Equal(t, error2.ErrEventNotFound, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateComment(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+	in := &eventGrpc.CommentRequest{CommentId: "3", EventId: "1", UserId: "5", Text: "Не пойду"}
+
+	expectComment(mock, time.Now(), nil)
+	mock.ExpectExec(updateCommentQuery).WithArgs(3, "Не пойду", 5, CommentEditWindow.Seconds()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(getCommentQuery).WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows(commentRowColumns).AddRow(3, 1, 5, nil, "Не пойду", time.Now(), time.Now(), nil, 2))
+	out, err := repositoryTest.UpdateComment(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, "Не пойду", out.Text)
+	require.NotEmpty(t, out.EditedAt)
+
+	//Окно правки закрылось между чтением и обновлением: решает запрос, а не проверка в Go
+	expectComment(mock, time.Now().Add(-CommentEditWindow+time.Second), nil)
+	mock.ExpectExec(updateCommentQuery).WithArgs(3, "Не пойду", 5, CommentEditWindow.Seconds()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = repositoryTest.UpdateComment(context.Background(), in)
+	require.Equal(t, error2.ErrEditWindowExpired, err)
+
+	//Мероприятие скрыто модератором - комментарий не находится
+	mock.ExpectQuery(getCommentQuery).WithArgs(3, 1).WillReturnRows(sqlmock.NewRows(commentRowColumns))
+	_, err = repositoryTest.UpdateComment(context.Background(), in)
+	require.Equal(t, error2.ErrCommentNotFound, err)
+
+	//Чужой комментарий
+	expectComment(mock, time.Now(), nil)
+	_, err = repositoryTest.UpdateComment(context.Background(), &eventGrpc.CommentRequest{CommentId: "3", EventId: "1", UserId: "9", Text: "x"})
+	require.Equal(t, error2.ErrNotAllowed, err)
+
+	expectComment(mock, time.Now(), time.Now())
+	_, err = repositoryTest.UpdateComment(context.Background(), in)
+	require.Equal(t, error2.ErrCommentNotFound, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteComment(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+
+	//Автор комментария
+	expectComment(mock, time.Now(), nil)
+	mock.ExpectExec(deleteCommentQuery).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+	_, err := repositoryTest.DeleteComment(context.Background(), &eventGrpc.CommentRequest{CommentId: "3", EventId: "1", UserId: "5"})
+	require.NoError(t, err)
+
+	//Автор мероприятия удаляет чужой комментарий, в том числе после окна правки
+	expectComment(mock, time.Now().Add(-time.Hour), nil)
+	expectAuthor(mock, 9)
+	mock.ExpectExec(deleteCommentQuery).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+	_, err = repositoryTest.DeleteComment(context.Background(), &eventGrpc.CommentRequest{CommentId: "3", EventId: "1", UserId: "9"})
+	require.NoError(t, err)
+
+	expectComment(mock, time.Now(), nil)
+	expectAuthor(mock, 9)
+	_, err = repositoryTest.DeleteComment(context.Background(), &eventGrpc.CommentRequest{CommentId: "3", EventId: "1", UserId: "7"})
+	require.Equal(t, error2.ErrNotAllowed, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetComments(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+	createdAt := time.Date(2021, 11, 13, 18, 30, 0, 0, time.UTC)
+
+	//Ответы на комментарий 2 со второй страницы, удалённый приходит без текста
+	mock.ExpectQuery(getCommentsQuery+" and c.parent_id = $2 and c.id < $3 order by c.id DESC limit $4").
+		WithArgs(1, int64(2), 10, 3).
+		WillReturnRows(sqlmock.NewRows(commentRowColumns).
+			AddRow(9, 1, 5, 2, "", createdAt, nil, createdAt, 1).
+			AddRow(8, 1, 6, 2, "Ответ", createdAt, nil, nil, 0).
+			AddRow(7, 1, 6, 2, "Ещё", createdAt, nil, nil, 0))
+	out, err := repositoryTest.GetComments(context.Background(), &eventGrpc.CommentsRequest{
+		EventId:  "1",
+		ParentId: "2",
+		Limit:    2,
+		Cursor:   utils.EncodeCursor(&utils.Cursor{ID: 10}),
+	})
+	require.NoError(t, err)
+	require.Len(t, out.Comments, 2)
+	require.True(t, out.Comments[0].Deleted)
+	require.Equal(t, int32(1), out.Comments[0].Replies)
+	require.Equal(t, "Ответ", out.Comments[1].Text)
+	require.Equal(t, utils.EncodeCursor(&utils.Cursor{ID: 8}), out.NextCursor)
+
+	mock.ExpectQuery(getCommentsQuery+" and c.parent_id is null order by c.id DESC limit $2").
+		WithArgs(1, utils.DefaultPageLimit+1).
+		WillReturnRows(sqlmock.NewRows(commentRowColumns))
+	out, err = repositoryTest.GetComments(context.Background(), &eventGrpc.CommentsRequest{EventId: "1"})
+	require.NoError(t, err)
+	require.Empty(t, out.Comments)
+	require.Empty(t, out.NextCursor)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
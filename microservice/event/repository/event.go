@@ -4,11 +4,23 @@ import (
 	proto "backend/microservice/event/proto"
 	"backend/pkg/models"
 	error2 "backend/service/event/error"
+	sql2 "database/sql"
 	"strconv"
+	"time"
+	//Часовые пояса мероприятий не должны зависеть от tzdata в образе
+	_ "time/tzdata"
 
 	"github.com/lib/pq"
 )
 
+const (
+	DefaultTimezone = "Europe/Moscow"
+	dateLayout      = "2006-01-02"
+)
+
+//Старые клиенты присылают только дату, в одном из двух форматов
+var legacyDateLayouts = []string{dateLayout, "02.01.2006"}
+
 type Event struct {
 	ID          int            `db:"id"`
 	Title       string         `db:"title"`
@@ -19,10 +31,21 @@ type Event struct {
 	Viewed      int            `db:"viewed"`
 	ImgUrl      string         `db:"img_url"`
 	Tag         pq.StringArray `db:"tag"`
-	Date        string         `db:"date"`
+	StartsAt    time.Time      `db:"starts_at"`
+	EndsAt      time.Time      `db:"ends_at"`
+	Timezone    string         `db:"timezone"`
 	Geo         string         `db:"geo"`
+	Lat         sql2.NullFloat64 `db:"lat"`
+	Lon         sql2.NullFloat64 `db:"lon"`
+	Capacity    sql2.NullInt64 `db:"capacity"`
 	Address		string         `db:"address"`
 	AuthorID    int            `db:"author_id"`
+	Hidden      bool           `db:"hidden"`
+	RatingSum   int            `db:"rating_sum"`
+	RatingCount int            `db:"rating_count"`
+	//Только в результатах полнотекстового поиска
+	Rank        float64        `db:"rank"`
+	Snippet     string         `db:"snippet"`
 }
 
 func toPostgresEvent(e *models.Event) (*Event, error) {
@@ -36,6 +59,19 @@ func toPostgresEvent(e *models.Event) (*Event, error) {
 		}
 		authorIdInt = tempAuthorId
 	}
+	startsAt, endsAt, timezone, err := parseEventTime(e)
+	if err != nil {
+		return nil, err
+	}
+	lat, lon, err := parseLocation(e.Location)
+	if err != nil {
+		return nil, err
+	}
+	//0 - мест без ограничения
+	if e.Capacity < 0 {
+		return nil, error2.ErrInvalidCapacity
+	}
+	capacity := sql2.NullInt64{Int64: int64(e.Capacity), Valid: e.Capacity > 0}
 	return &Event{
 		Title:       e.Title,
 		Description: e.Description,
@@ -45,14 +81,130 @@ func toPostgresEvent(e *models.Event) (*Event, error) {
 		Viewed:      e.Viewed,
 		ImgUrl:      e.ImgUrl,
 		Tag:         e.Tag,
-		Date:        e.Date,
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		Timezone:    timezone,
 		Geo:         e.Geo,
+		Lat:         lat,
+		Lon:         lon,
+		Capacity:    capacity,
 		Address: 	 e.Address,
 		AuthorID:    authorIdInt,
 	}, nil
 }
 
+//Мероприятие без координат хранится с NULL в lat и lon и не попадает в поиск по карте
+func parseLocation(l *models.Location) (sql2.NullFloat64, sql2.NullFloat64, error) {
+	if l == nil {
+		return sql2.NullFloat64{}, sql2.NullFloat64{}, nil
+	}
+	if !validLocation(l.Lat, l.Lon) {
+		return sql2.NullFloat64{}, sql2.NullFloat64{}, error2.ErrInvalidLocation
+	}
+	return sql2.NullFloat64{Float64: l.Lat, Valid: true}, sql2.NullFloat64{Float64: l.Lon, Valid: true}, nil
+}
+
+func validLocation(lat float64, lon float64) bool {
+	return lat >= -90 && lat <= 90 && lon >= -180 && lon <= 180
+}
+
+func toModelLocation(e *Event) *models.Location {
+	if !e.Lat.Valid || !e.Lon.Valid {
+		return nil
+	}
+	return &models.Location{Lat: e.Lat.Float64, Lon: e.Lon.Float64}
+}
+
+func toProtoLocation(l *models.Location) *proto.Location {
+	if l == nil {
+		return nil
+	}
+	return &proto.Location{Lat: l.Lat, Lon: l.Lon}
+}
+
+func fromProtoLocation(l *proto.Location) *models.Location {
+	if l == nil {
+		return nil
+	}
+	return &models.Location{Lat: l.Lat, Lon: l.Lon}
+}
+
+//Часовой пояс - имя из базы IANA, пустой - пояс по умолчанию
+func loadLocation(timezone string) (*time.Location, string, error) {
+	if timezone == "" {
+		timezone = DefaultTimezone
+	}
+	//"Local" - пояс сервера, а не мероприятия
+	if timezone == "Local" {
+		return nil, "", error2.ErrInvalidTimezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, "", error2.ErrInvalidTimezone
+	}
+	return loc, timezone, nil
+}
+
+//Начало и конец - RFC 3339; без конца мероприятие мгновенное. Если передана только
+//дата (старый формат), мероприятие идёт весь этот день в своём часовом поясе
+func parseEventTime(e *models.Event) (time.Time, time.Time, string, error) {
+	loc, timezone, err := loadLocation(e.Timezone)
+	if err != nil {
+		return time.Time{}, time.Time{}, "", err
+	}
+	var startsAt, endsAt time.Time
+	switch {
+	case e.StartsAt != "":
+		startsAt, err = time.Parse(time.RFC3339, e.StartsAt)
+		if err != nil {
+			return time.Time{}, time.Time{}, "", error2.ErrInvalidEventTime
+		}
+		endsAt = startsAt
+		if e.EndsAt != "" {
+			endsAt, err = time.Parse(time.RFC3339, e.EndsAt)
+			if err != nil {
+				return time.Time{}, time.Time{}, "", error2.ErrInvalidEventTime
+			}
+		}
+	case e.Date != "":
+		startsAt, err = parseLegacyDate(e.Date, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, "", err
+		}
+		endsAt = startsAt.AddDate(0, 0, 1)
+	default:
+		return time.Time{}, time.Time{}, "", error2.ErrInvalidEventTime
+	}
+	if endsAt.Before(startsAt) {
+		return time.Time{}, time.Time{}, "", error2.ErrInvalidEventTime
+	}
+	return startsAt, endsAt, timezone, nil
+}
+
+func parseLegacyDate(date string, loc *time.Location) (time.Time, error) {
+	for _, layout := range legacyDateLayouts {
+		t, err := time.ParseInLocation(layout, date, loc)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, error2.ErrInvalidEventTime
+}
+
+//Время отдаётся в часовом поясе мероприятия, date - день начала там же
+func formatEventTime(e *Event) (string, string, string) {
+	if e.StartsAt.IsZero() {
+		return "", "", ""
+	}
+	loc, _, err := loadLocation(e.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return e.StartsAt.In(loc).Format(time.RFC3339), e.EndsAt.In(loc).Format(time.RFC3339), e.StartsAt.In(loc).Format(dateLayout)
+}
+
 func toModelEvent(e *Event) *models.Event {
+	startsAt, endsAt, date := formatEventTime(e)
 	return &models.Event{
 		ID:          strconv.Itoa(e.ID),
 		Title:       e.Title,
@@ -63,10 +215,18 @@ func toModelEvent(e *Event) *models.Event {
 		Viewed:      e.Viewed,
 		ImgUrl:      e.ImgUrl,
 		Tag:         e.Tag,
-		Date:        e.Date,
+		Date:        date,
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		Timezone:    e.Timezone,
 		Geo:         e.Geo,
+		Location:    toModelLocation(e),
+		Capacity:    int(e.Capacity.Int64),
 		Address: 	 e.Address,
 		AuthorId:    strconv.Itoa(e.AuthorID),
+		Snippet:     e.Snippet,
+		RatingAvg:   ratingAvg(e.RatingSum, e.RatingCount),
+		RatingCount: e.RatingCount,
 	}
 }
 
@@ -82,9 +242,17 @@ func toProtoEvent(e *models.Event) *proto.Event {
 		ImgUrl:      e.ImgUrl,
 		Tag:         e.Tag,
 		Date:        e.Date,
+		StartsAt:    e.StartsAt,
+		EndsAt:      e.EndsAt,
+		Timezone:    e.Timezone,
 		Geo:         e.Geo,
+		Location:    toProtoLocation(e.Location),
+		Capacity:    int32(e.Capacity),
 		Address: 	 e.Address,
 		AuthorId:    e.AuthorId,
+		Snippet:     e.Snippet,
+		RatingAvg:   e.RatingAvg,
+		RatingCount: int32(e.RatingCount),
 	}
 }
 
@@ -100,8 +268,16 @@ func fromProtoToModel(in *proto.Event) *models.Event {
 		ImgUrl:      in.ImgUrl,
 		Tag:         in.Tag,
 		Date:        in.Date,
+		StartsAt:    in.StartsAt,
+		EndsAt:      in.EndsAt,
+		Timezone:    in.Timezone,
 		Geo:         in.Geo,
+		Location:    fromProtoLocation(in.Location),
+		Capacity:    int(in.Capacity),
 		Address: 	 in.Address,
 		AuthorId:    in.AuthorId,
+		Snippet:     in.Snippet,
+		RatingAvg:   in.RatingAvg,
+		RatingCount: int(in.RatingCount),
 	}
 }
@@ -36,12 +36,12 @@ func (m *RepositoryClientMock) GetEvents(ctx context.Context, in *proto.GetEvent
 	return args.Get(0).(*proto.Events), args.Error(1)
 }
 
-func (m *RepositoryClientMock) GetVisitedEvents(ctx context.Context, in *proto.UserId, opts ...grpc.CallOption) (*proto.Events, error) {
+func (m *RepositoryClientMock) GetVisitedEvents(ctx context.Context, in *proto.UserEventsRequest, opts ...grpc.CallOption) (*proto.Events, error) {
 	args := m.Called(ctx, in)
 	return args.Get(0).(*proto.Events), args.Error(1)
 }
 
-func (m *RepositoryClientMock) GetCreatedEvents(ctx context.Context, in *proto.UserId, opts ...grpc.CallOption) (*proto.Events, error) {
+func (m *RepositoryClientMock) GetCreatedEvents(ctx context.Context, in *proto.UserEventsRequest, opts ...grpc.CallOption) (*proto.Events, error) {
 	args := m.Called(ctx, in)
 	return args.Get(0).(*proto.Events), args.Error(1)
 }
@@ -65,3 +65,108 @@ func (m *RepositoryClientMock) GetCities(ctx context.Context, in *proto.Empty, o
 	args := m.Called(ctx, in)
 	return args.Get(0).(*proto.GetCitiesRequest), args.Error(1)
 }
+
+func (m *RepositoryClientMock) ForceDeleteEvent(ctx context.Context, in *proto.EventId, opts ...grpc.CallOption) (*proto.Empty, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Empty), args.Error(1)
+}
+
+func (m *RepositoryClientMock) SetEventHidden(ctx context.Context, in *proto.SetEventHiddenRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Empty), args.Error(1)
+}
+
+func (m *RepositoryClientMock) GetAuthorEvents(ctx context.Context, in *proto.UserId, opts ...grpc.CallOption) (*proto.Events, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Events), args.Error(1)
+}
+
+func (m *RepositoryClientMock) GetMapEvents(ctx context.Context, in *proto.MapRequest, opts ...grpc.CallOption) (*proto.Events, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Events), args.Error(1)
+}
+
+func (m *RepositoryClientMock) RecordView(ctx context.Context, in *proto.ViewRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Empty), args.Error(1)
+}
+
+func (m *RepositoryClientMock) GetEventStats(ctx context.Context, in *proto.EventStatsRequest, opts ...grpc.CallOption) (*proto.EventStats, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.EventStats), args.Error(1)
+}
+
+func (m *RepositoryClientMock) Register(ctx context.Context, in *proto.VisitRequest, opts ...grpc.CallOption) (*proto.Registration, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Registration), args.Error(1)
+}
+
+func (m *RepositoryClientMock) CancelRegistration(ctx context.Context, in *proto.VisitRequest, opts ...grpc.CallOption) (*proto.Registration, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Registration), args.Error(1)
+}
+
+func (m *RepositoryClientMock) GetRegistration(ctx context.Context, in *proto.VisitRequest, opts ...grpc.CallOption) (*proto.Registration, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Registration), args.Error(1)
+}
+
+func (m *RepositoryClientMock) SetRsvp(ctx context.Context, in *proto.RsvpRequest, opts ...grpc.CallOption) (*proto.Rsvp, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Rsvp), args.Error(1)
+}
+
+func (m *RepositoryClientMock) DeleteRsvp(ctx context.Context, in *proto.VisitRequest, opts ...grpc.CallOption) (*proto.Rsvp, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Rsvp), args.Error(1)
+}
+
+func (m *RepositoryClientMock) GetRsvp(ctx context.Context, in *proto.VisitRequest, opts ...grpc.CallOption) (*proto.Rsvp, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Rsvp), args.Error(1)
+}
+
+func (m *RepositoryClientMock) GetTicket(ctx context.Context, in *proto.VisitRequest, opts ...grpc.CallOption) (*proto.Ticket, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Ticket), args.Error(1)
+}
+
+func (m *RepositoryClientMock) CheckIn(ctx context.Context, in *proto.CheckInRequest, opts ...grpc.CallOption) (*proto.CheckInResult, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.CheckInResult), args.Error(1)
+}
+
+func (m *RepositoryClientMock) GetAttendance(ctx context.Context, in *proto.VisitRequest, opts ...grpc.CallOption) (*proto.Attendance, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Attendance), args.Error(1)
+}
+
+func (m *RepositoryClientMock) CreateComment(ctx context.Context, in *proto.Comment, opts ...grpc.CallOption) (*proto.Comment, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Comment), args.Error(1)
+}
+
+func (m *RepositoryClientMock) UpdateComment(ctx context.Context, in *proto.CommentRequest, opts ...grpc.CallOption) (*proto.Comment, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Comment), args.Error(1)
+}
+
+func (m *RepositoryClientMock) DeleteComment(ctx context.Context, in *proto.CommentRequest, opts ...grpc.CallOption) (*proto.Empty, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Empty), args.Error(1)
+}
+
+func (m *RepositoryClientMock) GetComments(ctx context.Context, in *proto.CommentsRequest, opts ...grpc.CallOption) (*proto.Comments, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Comments), args.Error(1)
+}
+
+func (m *RepositoryClientMock) CreateReview(ctx context.Context, in *proto.Review, opts ...grpc.CallOption) (*proto.Review, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Review), args.Error(1)
+}
+
+func (m *RepositoryClientMock) GetReviews(ctx context.Context, in *proto.ReviewsRequest, opts ...grpc.CallOption) (*proto.Reviews, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*proto.Reviews), args.Error(1)
+}
@@ -0,0 +1,286 @@
+package eventRepository
+
+import (
+	proto "backend/microservice/event/proto"
+	log "backend/pkg/logger"
+	"backend/pkg/models"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+	sql "github.com/jmoiron/sqlx"
+	"strconv"
+)
+
+//Запись и отмена блокируют строку мероприятия (for update), поэтому параллельные
+//запросы на одно мероприятие выполняются по очереди и не могут превысить capacity.
+//Лист ожидания упорядочен по id записи - по времени обращения.
+//На закончившееся мероприятие записаться нельзя, как и ответить going
+const (
+	getEventCapacityQuery   = `select title, capacity, ends_at <= now() as finished from "event" where id = $1 and hidden = false`
+	lockEventQuery          = getEventCapacityQuery + ` for update`
+	getRegistrationQuery    = `select id, status from "registration" where event_id = $1 and user_id = $2`
+	countRegisteredQuery    = `select count(*) from "registration" where event_id = $1 and status = 'registered'`
+	waitlistPositionQuery   = `select count(*) from "registration" where event_id = $1 and status = 'waitlisted' and id <= $2`
+	createRegistrationQuery = `insert into "registration" (event_id, user_id, status) values ($1, $2, $3) returning id`
+	deleteRegistrationQuery = `delete from "registration" where event_id = $1 and user_id = $2`
+	promoteWaitlistQuery    = `with p as (
+		update "registration" set status = 'registered', promoted_at = now() where id in (
+			select id from "registration" where event_id = $1 and status = 'waitlisted' order by id limit $2)
+		returning user_id)
+		select u.id, u.mail from "user" u join p on p.user_id = u.id order by u.id`
+)
+
+//Общее у *sql.DB и *sql.Tx
+type getter interface {
+	Get(dest interface{}, query string, args ...interface{}) error
+}
+
+type lockedEvent struct {
+	Title    string         `db:"title"`
+	Capacity sql2.NullInt64 `db:"capacity"`
+	Finished bool           `db:"finished"`
+}
+
+type registrationRow struct {
+	ID     int    `db:"id"`
+	Status string `db:"status"`
+}
+
+func parseVisitRequest(in *proto.VisitRequest) (int, int, error) {
+	eventIdInt, err := strconv.Atoi(in.EventId)
+	if err != nil {
+		return 0, 0, error2.ErrAtoi
+	}
+	userIdInt, err := strconv.Atoi(in.UserId)
+	if err != nil {
+		return 0, 0, error2.ErrAtoi
+	}
+	return eventIdInt, userIdInt, nil
+}
+
+//Повторная запись не меняет статус. Если места освободились раньше (например, автор
+//увеличил capacity), сначала переводятся ожидающие, и только потом решается,
+//куда попадёт новый участник - очередь не обгоняется
+func (s *Repository) Register(ctx context.Context, in *proto.VisitRequest) (*proto.Registration, error) {
+	message := logMessage + "Register:"
+	log.Debug(message + "started")
+	eventIdInt, userIdInt, err := parseVisitRequest(in)
+	if err != nil {
+		return &proto.Registration{}, err
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	event, err := lockEvent(tx, eventIdInt)
+	if err != nil {
+		return &proto.Registration{}, err
+	}
+	if event.Finished {
+		return &proto.Registration{}, error2.ErrEventFinished
+	}
+	promoted, err := promoteWaitlist(tx, eventIdInt, event.Capacity)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	var row registrationRow
+	err = tx.Get(&row, getRegistrationQuery, eventIdInt, userIdInt)
+	if err == sql2.ErrNoRows {
+		row.Status = models.RegistrationRegistered
+		if event.Capacity.Valid {
+			var registered int64
+			err = tx.Get(&registered, countRegisteredQuery, eventIdInt)
+			if err != nil {
+				log.Error(message+"err =", err)
+				return &proto.Registration{}, error2.ErrPostgres
+			}
+			if registered >= event.Capacity.Int64 {
+				row.Status = models.RegistrationWaitlisted
+			}
+		}
+		err = tx.Get(&row.ID, createRegistrationQuery, eventIdInt, userIdInt, row.Status)
+	}
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	out, err := registrationState(tx, eventIdInt, event, &row)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	out.Promoted = promoted
+	log.Debug(message + "ended")
+	return out, nil
+}
+
+//Освободившееся место сразу занимает первый из листа ожидания
+func (s *Repository) CancelRegistration(ctx context.Context, in *proto.VisitRequest) (*proto.Registration, error) {
+	message := logMessage + "CancelRegistration:"
+	log.Debug(message + "started")
+	eventIdInt, userIdInt, err := parseVisitRequest(in)
+	if err != nil {
+		return &proto.Registration{}, err
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	event, err := lockEvent(tx, eventIdInt)
+	if err != nil {
+		return &proto.Registration{}, err
+	}
+	res, err := tx.Exec(deleteRegistrationQuery, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	if affected == 0 {
+		return &proto.Registration{}, error2.ErrNotRegistered
+	}
+	//Без записи пользователь больше не участник
+	_, err = tx.Exec(deleteGoingQuery, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	promoted, err := promoteWaitlist(tx, eventIdInt, event.Capacity)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	out, err := registrationState(tx, eventIdInt, event, nil)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	out.Promoted = promoted
+	log.Debug(message + "ended")
+	return out, nil
+}
+
+func (s *Repository) GetRegistration(ctx context.Context, in *proto.VisitRequest) (*proto.Registration, error) {
+	message := logMessage + "GetRegistration:"
+	log.Debug(message + "started")
+	eventIdInt, userIdInt, err := parseVisitRequest(in)
+	if err != nil {
+		return &proto.Registration{}, err
+	}
+	var event lockedEvent
+	err = s.db.Get(&event, getEventCapacityQuery, eventIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		if err == sql2.ErrNoRows {
+			return &proto.Registration{}, error2.ErrEventNotFound
+		}
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	var row *registrationRow
+	var found registrationRow
+	err = s.db.Get(&found, getRegistrationQuery, eventIdInt, userIdInt)
+	if err == nil {
+		row = &found
+	} else if err != sql2.ErrNoRows {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	out, err := registrationState(s.db, eventIdInt, &event, row)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Registration{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return out, nil
+}
+
+func lockEvent(tx *sql.Tx, eventId int) (*lockedEvent, error) {
+	var event lockedEvent
+	err := tx.Get(&event, lockEventQuery, eventId)
+	if err != nil {
+		log.Error(logMessage+"lockEvent:err =", err)
+		if err == sql2.ErrNoRows {
+			return nil, error2.ErrEventNotFound
+		}
+		return nil, error2.ErrPostgres
+	}
+	return &event, nil
+}
+
+//Переводит ожидающих в участники, пока есть свободные места
+func promoteWaitlist(tx *sql.Tx, eventId int, capacity sql2.NullInt64) ([]*proto.Promotion, error) {
+	//NULL в limit - без ограничения: capacity сняли, переводятся все ожидающие
+	var free sql2.NullInt64
+	if capacity.Valid {
+		var registered int64
+		err := tx.Get(&registered, countRegisteredQuery, eventId)
+		if err != nil {
+			return nil, err
+		}
+		if registered >= capacity.Int64 {
+			return nil, nil
+		}
+		free = sql2.NullInt64{Int64: capacity.Int64 - registered, Valid: true}
+	}
+	rows, err := tx.Queryx(promoteWaitlistQuery, eventId, free)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var promoted []*proto.Promotion
+	for rows.Next() {
+		var userId int
+		var mail string
+		err = rows.Scan(&userId, &mail)
+		if err != nil {
+			return nil, err
+		}
+		promoted = append(promoted, &proto.Promotion{UserId: strconv.Itoa(userId), Mail: mail})
+	}
+	return promoted, nil
+}
+
+//row - запись пользователя, nil - пользователь не записан
+func registrationState(db getter, eventId int, event *lockedEvent, row *registrationRow) (*proto.Registration, error) {
+	out := &proto.Registration{
+		EventTitle: event.Title,
+		Capacity:   int32(event.Capacity.Int64),
+	}
+	var registered int64
+	err := db.Get(&registered, countRegisteredQuery, eventId)
+	if err != nil {
+		return nil, err
+	}
+	out.Registered = int32(registered)
+	if row == nil {
+		return out, nil
+	}
+	out.Status = row.Status
+	if row.Status == models.RegistrationWaitlisted {
+		var position int64
+		err = db.Get(&position, waitlistPositionQuery, eventId, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		out.Position = int32(position)
+	}
+	return out, nil
+}
@@ -0,0 +1,141 @@
+package eventRepository
+
+import (
+	eventGrpc "backend/microservice/event/proto"
+	"backend/pkg/models"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func newRegistrationTest(t *testing.T) (*Repository, sqlmock.Sqlmock, func()) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	return NewRepository(sqlx.NewDb(db, "sqlmock")), mock, func() { db.Close() }
+}
+
+func expectLockEvent(mock sqlmock.Sqlmock, capacity interface{}) {
+	mock.ExpectQuery(lockEventQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"title", "capacity", "finished"}).AddRow("Концерт", capacity, false))
+}
+
+func expectRegistered(mock sqlmock.Sqlmock, count int) {
+	mock.ExpectQuery(countRegisteredQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+func TestRegisterWaitlisted(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+
+	mock.ExpectBegin()
+	expectLockEvent(mock, 2)
+	expectRegistered(mock, 2)
+	mock.ExpectQuery(getRegistrationQuery).WithArgs(1, 5).WillReturnRows(sqlmock.NewRows([]string{"id", "status"}))
+	expectRegistered(mock, 2)
+	mock.ExpectQuery(createRegistrationQuery).WithArgs(1, 5, models.RegistrationWaitlisted).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	expectRegistered(mock, 2)
+	mock.ExpectQuery(waitlistPositionQuery).WithArgs(1, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectCommit()
+
+	out, err := repositoryTest.Register(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.NoError(t, err)
+	require.Equal(t, models.RegistrationWaitlisted, out.Status)
+	require.Equal(t, int32(1), out.Position)
+	require.Equal(t, int32(2), out.Registered)
+	require.Equal(t, int32(2), out.Capacity)
+	require.Empty(t, out.Promoted)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRegisterUnlimited(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+
+	//Повторная запись возвращает прежний статус
+	mock.ExpectBegin()
+	expectLockEvent(mock, nil)
+	mock.ExpectQuery(promoteWaitlistQuery).WithArgs(1, sql2.NullInt64{}).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "mail"}))
+	mock.ExpectQuery(getRegistrationQuery).WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(3, models.RegistrationRegistered))
+	expectRegistered(mock, 10)
+	mock.ExpectCommit()
+
+	out, err := repositoryTest.Register(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.NoError(t, err)
+	require.Equal(t, models.RegistrationRegistered, out.Status)
+	require.Equal(t, int32(0), out.Position)
+	require.Equal(t, int32(0), out.Capacity)
+
+	//Мероприятие уже закончилось
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockEventQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"title", "capacity", "finished"}).AddRow("Концерт", nil, true))
+	mock.ExpectRollback()
+	_, err = repositoryTest.Register(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.Equal(t, error2.ErrEventFinished, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockEventQuery).WithArgs(1).WillReturnError(sql2.ErrNoRows)
+	mock.ExpectRollback()
+	_, err = repositoryTest.Register(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.Equal(t, error2.ErrEventNotFound, err)
+
+	_, err = repositoryTest.Register(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "a"})
+	require.Equal(t, error2.ErrAtoi, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelRegistrationPromotes(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+
+	mock.ExpectBegin()
+	expectLockEvent(mock, 2)
+	mock.ExpectExec(deleteRegistrationQuery).WithArgs(1, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteGoingQuery).WithArgs(1, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectRegistered(mock, 1)
+	mock.ExpectQuery(promoteWaitlistQuery).WithArgs(1, sql2.NullInt64{Int64: 1, Valid: true}).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "mail"}).AddRow(8, "next@mail.ru"))
+	expectRegistered(mock, 2)
+	mock.ExpectCommit()
+
+	out, err := repositoryTest.CancelRegistration(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.NoError(t, err)
+	require.Equal(t, "", out.Status)
+	require.Equal(t, int32(2), out.Registered)
+	require.Equal(t, "Концерт", out.EventTitle)
+	require.Equal(t, []*eventGrpc.Promotion{{UserId: "8", Mail: "next@mail.ru"}}, out.Promoted)
+
+	mock.ExpectBegin()
+	expectLockEvent(mock, 2)
+	mock.ExpectExec(deleteRegistrationQuery).WithArgs(1, 5).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	_, err = repositoryTest.CancelRegistration(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.Equal(t, error2.ErrNotRegistered, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRegistration(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+
+	mock.ExpectQuery(getEventCapacityQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"title", "capacity", "finished"}).AddRow("Концерт", 2, false))
+	mock.ExpectQuery(getRegistrationQuery).WithArgs(1, 5).WillReturnRows(sqlmock.NewRows([]string{"id", "status"}))
+	expectRegistered(mock, 2)
+
+	out, err := repositoryTest.GetRegistration(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.NoError(t, err)
+	require.Equal(t, "", out.Status)
+	require.Equal(t, int32(2), out.Registered)
+	require.Equal(t, int32(2), out.Capacity)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,12 +4,24 @@ import (
 	proto "backend/microservice/event/proto"
 	log "backend/pkg/logger"
 	"backend/pkg/models"
+	"backend/pkg/utils"
 	error2 "backend/service/event/error"
 	"context"
 	sql2 "database/sql"
+	"fmt"
 	sql "github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"strconv"
+	"time"
+)
+
+//Наибольшее число точек на карте за один запрос
+const MapLimit = 500
+
+//Длина ряда статистики по умолчанию и наибольшая, в днях
+const (
+	StatsDefaultDays = 30
+	StatsMaxDays     = 366
 )
 
 type Repository struct {
@@ -22,30 +34,62 @@ func NewRepository(database *sql.DB) *Repository {
 	}
 }
 
+//Столбцы перечислены явно: поисковый tsvector (search) в выдаче не нужен
+const eventColumns = `id, title, description, text, city, category, viewed, img_url, starts_at, ends_at, timezone, geo, lat, lon, capacity, address, tag, author_id, hidden, rating_sum, rating_count`
+
 const (
 	logMessage       = "microservice:event:repository:"
 	checkAuthorQuery = `select author_id from "event" where id = $1`
-	listQuery        = `select * from "event"`
-	getEventQuery    = `select * from "event" where id = $1`
+	listQuery        = `select ` + eventColumns + ` from "event" where hidden = false`
+	//Запрос пользователя разбирается websearch_to_tsquery: синтаксис как у поисковиков,
+	//ошибок разбора не бывает. snippet - фрагменты описания и текста с выделенными совпадениями
+	searchQuery = `select ` + eventColumns + `, ts_rank(search, query) as rank,
+		ts_headline('russian', description || ' ' || text, query, 'MaxFragments=2, MaxWords=20, MinWords=5') as snippet
+		from "event", lateral (select websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) as query) as q
+		where hidden = false and search @@ query`
+	getEventQuery = `select ` + eventColumns + ` from "event" where id = $1 and hidden = false`
+	//viewed считает сервер (RecordView), клиент его не задаёт
 	createEventQuery = `insert into "event" 
-		(title, description, text, city, category, viewed, img_url, date, geo, address, tag, author_id) 
-		values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11::varchar[], $12) 
+		(title, description, text, city, category, img_url, starts_at, ends_at, timezone, geo, lat, lon, capacity, address, tag, author_id) 
+		values($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15::varchar[], $16) 
 		returning id`
 	updateEventQuery = `update "event" set
 		title = $1, description = $2, text = $3, city = $4, category = $5, 
-		viewed = $6, img_url = $7, date = $8, geo = $9, address = $10, tag = $11 
-		where event.id = $12`
+		img_url = $6, starts_at = $7, ends_at = $8, timezone = $9, geo = $10, lat = $11, lon = $12, capacity = $13, address = $14, tag = $15 
+		where event.id = $16`
 	updateEventQueryWithoutImgUrl = `update "event" set
 		title = $1, description = $2, text = $3, city = $4, category = $5, 
-		viewed = $6, date = $7, geo = $8, address = $9, tag = $10 
-		where event.id = $11`
+		starts_at = $6, ends_at = $7, timezone = $8, geo = $9, lat = $10, lon = $11, capacity = $12, address = $13, tag = $14 
+		where event.id = $15`
 	deleteEventQuery = `delete from "event" where id = $1`
-	visitedQuery     = `select e.* from "event" as e join visitor as v on v.event_id = e.id where v.user_id = $1`
-	createdQuery     = `select * from "event" where author_id = $1`
+	visitedQuery     = `select ` + eventColumns + ` from "event" where id in (select event_id from visitor where user_id = $1) and hidden = false`
+	createdQuery     = `select ` + eventColumns + ` from "event" where author_id = $1 and hidden = false`
+	authorQuery      = `select ` + eventColumns + ` from "event" where author_id = $1`
+	mapQuery         = `select ` + eventColumns + ` from "event" where hidden = false and lat between $1 and $2`
 	visitQuery       = `insert into "visitor" (event_id, user_id) values ($1, $2)`
 	unvisitQuery     = `delete from "visitor" where event_id = $1 and user_id = $2`
 	isVisitedQuery   = `select count(*) from "visitor" where event_id = $1 and user_id = $2`
 	getCitiesQuery   = `select distinct city from event`
+	//Просмотр засчитывается один раз в сутки (UTC) на пользователя или анонимного посетителя,
+	//повтор отбрасывают уникальные индексы. Просмотры автора не считаются
+	recordViewQuery = `with v as (
+		insert into "view" (event_id, user_id, visitor_id)
+		select id, $2, $3 from "event" where id = $1 and hidden = false and author_id is distinct from $2
+		on conflict do nothing
+		returning event_id)
+		update "event" set viewed = viewed + 1 where id in (select event_id from v)`
+	//Дни без просмотров и добавлений в избранное тоже попадают в ряд - с нулями
+	eventStatsQuery = `select to_char(g.day, 'YYYY-MM-DD') as date,
+		(select count(*) from "view" where event_id = $1 and date = g.day::date) as views,
+		(select count(*) from "visitor" where event_id = $1 and date = g.day::date) as favourites
+		from generate_series($2::date, $3::date, interval '1 day') as g(day) order by g.day`
+
+	//Радиус поиска проверяется по индексу (earth_box), затем отсекаются углы куба
+	radiusFilter = ` and earth_box(ll_to_earth(%[1]s, %[2]s), %[3]s) @> ll_to_earth(lat, lon)` +
+		` and earth_distance(ll_to_earth(%[1]s, %[2]s), ll_to_earth(lat, lon)) <= %[3]s`
+
+	forceDeleteEventQuery = `delete from "event" where id = $1`
+	setEventHiddenQuery   = `update "event" set hidden = $1 where id = $2`
 )
 
 func (s *Repository) checkAuthor(eventId int, userId int) error {
@@ -79,10 +123,14 @@ func (s *Repository) CreateEvent(ctx context.Context, in *proto.Event) (*proto.E
 		newEvent.Text,
 		newEvent.City,
 		newEvent.Category,
-		newEvent.Viewed,
 		newEvent.ImgUrl,
-		newEvent.Date,
+		newEvent.StartsAt,
+		newEvent.EndsAt,
+		newEvent.Timezone,
 		newEvent.Geo,
+		newEvent.Lat,
+		newEvent.Lon,
+		newEvent.Capacity,
 		newEvent.Address,
 		newEvent.Tag,
 		newEvent.AuthorID)
@@ -129,10 +177,14 @@ func (s *Repository) UpdateEvent(ctx context.Context, in *proto.UpdateEventReque
 			postgresEvent.Text,
 			postgresEvent.City,
 			postgresEvent.Category,
-			postgresEvent.Viewed,
 			postgresEvent.ImgUrl,
-			postgresEvent.Date,
+			postgresEvent.StartsAt,
+			postgresEvent.EndsAt,
+			postgresEvent.Timezone,
 			postgresEvent.Geo,
+			postgresEvent.Lat,
+			postgresEvent.Lon,
+			postgresEvent.Capacity,
 			postgresEvent.Address,
 			postgresEvent.Tag,
 			postgresEvent.ID)
@@ -147,9 +199,13 @@ func (s *Repository) UpdateEvent(ctx context.Context, in *proto.UpdateEventReque
 			postgresEvent.Text,
 			postgresEvent.City,
 			postgresEvent.Category,
-			postgresEvent.Viewed,
-			postgresEvent.Date,
+			postgresEvent.StartsAt,
+			postgresEvent.EndsAt,
+			postgresEvent.Timezone,
 			postgresEvent.Geo,
+			postgresEvent.Lat,
+			postgresEvent.Lon,
+			postgresEvent.Capacity,
 			postgresEvent.Address,
 			postgresEvent.Tag,
 			postgresEvent.ID)
@@ -216,15 +272,20 @@ func (s *Repository) GetEvents(ctx context.Context, in *proto.GetEventsRequest)
 	city := in.City
 	date := in.Date
 	tags := in.Tags
+	cursor, err := utils.DecodeCursor(in.Cursor)
+	if err != nil {
+		return &proto.Events{}, err
+	}
+	limit := utils.PageLimit(int(in.Limit))
 	postgresTags := make(pq.StringArray, len(tags))
 	for i := range tags {
 		postgresTags[i] = tags[i]
 	}
-	query := listQuery + " "
+	var query string
 	if title != "" {
-		query += `where lower(title) ~ lower($1) and `
+		query = searchQuery + ` and `
 	} else {
-		query += `where $1 = $1 and `
+		query = listQuery + ` and $1 = $1 and `
 	}
 	if category != "" {
 		query += `lower(category) = lower($2) and `
@@ -236,8 +297,14 @@ func (s *Repository) GetEvents(ctx context.Context, in *proto.GetEventsRequest)
 	} else {
 		query += `$3 = $3 and `
 	}
+	//date - день начала в часовом поясе мероприятия
 	if date != "" {
-		query += `lower(date) = lower($4) and `
+		day, err := parseLegacyDate(date, time.UTC)
+		if err != nil {
+			return &proto.Events{}, error2.ErrInvalidTimeFilter
+		}
+		date = day.Format(dateLayout)
+		query += `(starts_at at time zone timezone)::date = $4::date and `
 	} else {
 		query += `$4 = $4 and `
 	}
@@ -246,97 +313,228 @@ func (s *Repository) GetEvents(ctx context.Context, in *proto.GetEventsRequest)
 	} else {
 		query += `$5 = $5`
 	}
-	query += ` order by viewed DESC`
-	rows, err := s.db.Queryx(query, title, category, city, date, postgresTags)
+	args := []interface{}{title, category, city, date, postgresTags}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+	filter, err := timeFilter(in.From, in.To, in.When, arg)
 	if err != nil {
-		log.Error(message, "err = ", err)
 		return &proto.Events{}, err
 	}
-	defer rows.Close()
-	var resultEvents []*models.Event
-	for rows.Next() {
-		var e Event
-		err := rows.StructScan(&e)
-		if err != nil {
-			log.Error(message, "err = ", err)
-			return &proto.Events{}, error2.ErrPostgres
+	query += filter
+	filter, err = nearFilter(in.Near, in.RadiusKm, arg)
+	if err != nil {
+		return &proto.Events{}, err
+	}
+	query += filter
+	//Поиск отсортирован по релевантности, лента - от новых к старым по id.
+	//По viewed ленту не листают: он растёт с каждым просмотром, и между страницами
+	//мероприятия перескакивали бы через курсор
+	order := ` order by id DESC`
+	var cursorKey func(e *Event) string
+	if title != "" {
+		order = ` order by rank DESC, id DESC`
+		cursorKey = func(e *Event) string {
+			return strconv.FormatFloat(e.Rank, 'g', -1, 32)
+		}
+	}
+	if cursor != nil {
+		if title != "" {
+			//id различает мероприятия с равной релевантностью
+			key, err := strconv.ParseFloat(cursor.Key, 64)
+			if err != nil {
+				return &proto.Events{}, utils.ErrInvalidCursor
+			}
+			query += ` and (ts_rank(search, query), id) < (` + arg(key) + `, ` + arg(cursor.ID) + `)`
+		} else {
+			query += ` and id < ` + arg(cursor.ID)
 		}
-		modelEvent := toModelEvent(&e)
-		resultEvents = append(resultEvents, modelEvent)
 	}
-	outEvents := make([]*proto.Event, len(resultEvents))
-	for i, event := range resultEvents {
-		outEvents[i] = toProtoEvent(event)
+	query += order + ` limit ` + arg(limit+1)
+	events, err := s.queryEvents(query, args...)
+	if err != nil {
+		log.Error(message, "err = ", err)
+		return &proto.Events{}, err
 	}
-	out := &proto.Events{Events: outEvents}
+	out := makeEventsPage(events, limit, cursorKey)
 	log.Debug(message + "ended")
 	return out, nil
 }
 
-func (s *Repository) GetVisitedEvents(ctx context.Context, in *proto.UserId) (*proto.Events, error) {
-	message := logMessage + "GetVisitedEvents:"
+//Мероприятие попадает в интервал [from, to), если идёт в нём хотя бы частично.
+//upcoming - ещё не закончившиеся мероприятия, past - закончившиеся
+func timeFilter(fromValue string, toValue string, when string, arg func(value interface{}) string) (string, error) {
+	var filter string
+	var from, to time.Time
+	var err error
+	if fromValue != "" {
+		from, err = time.Parse(time.RFC3339, fromValue)
+		if err != nil {
+			return "", error2.ErrInvalidTimeFilter
+		}
+		filter += ` and ends_at >= ` + arg(from)
+	}
+	if toValue != "" {
+		to, err = time.Parse(time.RFC3339, toValue)
+		if err != nil || !to.After(from) {
+			return "", error2.ErrInvalidTimeFilter
+		}
+		filter += ` and starts_at < ` + arg(to)
+	}
+	switch when {
+	case "":
+	case models.EventsUpcoming:
+		filter += ` and ends_at > now()`
+	case models.EventsPast:
+		filter += ` and ends_at <= now()`
+	default:
+		return "", error2.ErrInvalidTimeFilter
+	}
+	return filter, nil
+}
+
+//Мероприятия не дальше radiusKm от точки near; без точки фильтр не применяется
+func nearFilter(near *proto.Location, radiusKm float64, arg func(value interface{}) string) (string, error) {
+	if near == nil {
+		if radiusKm != 0 {
+			return "", error2.ErrInvalidGeoFilter
+		}
+		return "", nil
+	}
+	if !validLocation(near.Lat, near.Lon) || !(radiusKm > 0) {
+		return "", error2.ErrInvalidGeoFilter
+	}
+	return fmt.Sprintf(radiusFilter, arg(near.Lat), arg(near.Lon), arg(radiusKm*1000)), nil
+}
+
+//Мероприятия с координатами внутри прямоугольника карты, самые просматриваемые.
+//Постраничной выдачи нет: фронтенд приближает карту, если точек больше MapLimit
+func (s *Repository) GetMapEvents(ctx context.Context, in *proto.MapRequest) (*proto.Events, error) {
+	message := logMessage + "GetMapEvents:"
 	log.Debug(message + "started")
-	userId := in.ID
-	userIdInt, err := strconv.Atoi(userId)
+	box := in.Box
+	if box == nil || !validLocation(box.MinLat, box.MinLon) || !validLocation(box.MaxLat, box.MaxLon) ||
+		box.MinLat > box.MaxLat {
+		return &proto.Events{}, error2.ErrInvalidGeoFilter
+	}
+	query := mapQuery
+	args := []interface{}{box.MinLat, box.MaxLat}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+	//Прямоугольник через 180-й меридиан - две полосы долгот
+	if box.MinLon <= box.MaxLon {
+		query += ` and lon between ` + arg(box.MinLon) + ` and ` + arg(box.MaxLon)
+	} else {
+		query += ` and (lon >= ` + arg(box.MinLon) + ` or lon <= ` + arg(box.MaxLon) + `)`
+	}
+	if in.Category != "" {
+		query += ` and lower(category) = lower(` + arg(in.Category) + `)`
+	}
+	filter, err := timeFilter(in.From, in.To, in.When, arg)
 	if err != nil {
-		return &proto.Events{}, error2.ErrAtoi
+		return &proto.Events{}, err
 	}
-	query := visitedQuery
-	rows, err := s.db.Queryx(query, userIdInt)
+	query += filter + ` order by viewed DESC, id DESC limit ` + arg(MapLimit)
+	events, err := s.queryEvents(query, args...)
 	if err != nil {
+		log.Error(message, "err = ", err)
 		return &proto.Events{}, error2.ErrPostgres
 	}
-	defer rows.Close()
-	var resultEvents []*models.Event
-	for rows.Next() {
-		var e Event
-		err := rows.StructScan(&e)
-		if err != nil {
-			return &proto.Events{}, error2.ErrPostgres
-		}
-		modelEvent := toModelEvent(&e)
-		resultEvents = append(resultEvents, modelEvent)
+	log.Debug(message + "ended")
+	return makeEventsPage(events, len(events), nil), nil
+}
+
+func (s *Repository) GetVisitedEvents(ctx context.Context, in *proto.UserEventsRequest) (*proto.Events, error) {
+	return s.getUserEventsPage(logMessage+"GetVisitedEvents:", visitedQuery, in)
+}
+
+func (s *Repository) GetCreatedEvents(ctx context.Context, in *proto.UserEventsRequest) (*proto.Events, error) {
+	return s.getUserEventsPage(logMessage+"GetCreatedEvents:", createdQuery, in)
+}
+
+//В отличие от GetCreatedEvents отдаёт и скрытые модератором мероприятия -
+//нужен для выгрузки данных самому автору, поэтому без постраничной выдачи
+func (s *Repository) GetAuthorEvents(ctx context.Context, in *proto.UserId) (*proto.Events, error) {
+	message := logMessage + "GetAuthorEvents:"
+	log.Debug(message + "started")
+	userIdInt, err := strconv.Atoi(in.ID)
+	if err != nil {
+		return &proto.Events{}, error2.ErrAtoi
 	}
-	outEvents := make([]*proto.Event, len(resultEvents))
-	for i, event := range resultEvents {
-		outEvents[i] = toProtoEvent(event)
+	events, err := s.queryEvents(authorQuery, userIdInt)
+	if err != nil {
+		return &proto.Events{}, error2.ErrPostgres
 	}
-	out := &proto.Events{Events: outEvents}
 	log.Debug(message + "ended")
-	return out, nil
+	return makeEventsPage(events, len(events), nil), nil
 }
 
-func (s *Repository) GetCreatedEvents(ctx context.Context, in *proto.UserId) (*proto.Events, error) {
-	message := logMessage + "GetCreatedEvents:"
+//Списки пользователя листаются по id мероприятия
+func (s *Repository) getUserEventsPage(message string, query string, in *proto.UserEventsRequest) (*proto.Events, error) {
 	log.Debug(message + "started")
-	userId := in.ID
-	userIdInt, err := strconv.Atoi(userId)
+	userIdInt, err := strconv.Atoi(in.UserId)
 	if err != nil {
 		return &proto.Events{}, error2.ErrAtoi
 	}
-	query := createdQuery
-	rows, err := s.db.Queryx(query, userIdInt)
+	cursor, err := utils.DecodeCursor(in.Cursor)
+	if err != nil {
+		return &proto.Events{}, err
+	}
+	limit := utils.PageLimit(int(in.Limit))
+	args := []interface{}{userIdInt}
+	if cursor != nil {
+		args = append(args, cursor.ID)
+		query += ` and id < $` + strconv.Itoa(len(args))
+	}
+	args = append(args, limit+1)
+	query += ` order by id DESC limit $` + strconv.Itoa(len(args))
+	events, err := s.queryEvents(query, args...)
 	if err != nil {
 		return &proto.Events{}, error2.ErrPostgres
 	}
+	log.Debug(message + "ended")
+	return makeEventsPage(events, limit, nil), nil
+}
+
+func (s *Repository) queryEvents(query string, args ...interface{}) ([]*Event, error) {
+	rows, err := s.db.Queryx(query, args...)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
-	var resultEvents []*models.Event
+	var result []*Event
 	for rows.Next() {
 		var e Event
 		err := rows.StructScan(&e)
 		if err != nil {
-			return &proto.Events{}, error2.ErrPostgres
+			return nil, error2.ErrPostgres
 		}
-		modelEvent := toModelEvent(&e)
-		resultEvents = append(resultEvents, modelEvent)
+		result = append(result, &e)
 	}
-	outEvents := make([]*proto.Event, len(resultEvents))
-	for i, event := range resultEvents {
-		outEvents[i] = toProtoEvent(event)
+	return result, nil
+}
+
+//Запрос выбирает на одну запись больше limit: если она пришла, следующая страница есть,
+//и курсор указывает на последнюю запись текущей. key - значение ключа сортировки кроме id
+func makeEventsPage(events []*Event, limit int, key func(e *Event) string) *proto.Events {
+	out := &proto.Events{}
+	if len(events) > limit {
+		events = events[:limit]
+		last := events[limit-1]
+		cursor := &utils.Cursor{ID: last.ID}
+		if key != nil {
+			cursor.Key = key(last)
+		}
+		out.NextCursor = utils.EncodeCursor(cursor)
 	}
-	out := &proto.Events{Events: outEvents}
-	log.Debug(message + "ended")
-	return out, nil
+	out.Events = make([]*proto.Event, len(events))
+	for i, e := range events {
+		out.Events[i] = toProtoEvent(toModelEvent(e))
+	}
+	return out
 }
 
 func (s *Repository) Visit(ctx context.Context, in *proto.VisitRequest) (*proto.Empty, error) {
@@ -440,3 +638,143 @@ func (s *Repository) GetCities(ctx context.Context, in *proto.Empty) (*proto.Get
 		Cities: resultCities,
 	}, nil
 }
+
+func (s *Repository) RecordView(ctx context.Context, in *proto.ViewRequest) (*proto.Empty, error) {
+	message := logMessage + "RecordView:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(in.EventId)
+	if err != nil {
+		return &proto.Empty{}, error2.ErrAtoi
+	}
+	var userId sql2.NullInt64
+	var visitorId sql2.NullString
+	if in.UserId != "" {
+		userIdInt, err := strconv.Atoi(in.UserId)
+		if err != nil {
+			return &proto.Empty{}, error2.ErrAtoi
+		}
+		userId = sql2.NullInt64{Int64: int64(userIdInt), Valid: true}
+	} else if in.VisitorId != "" {
+		visitorId = sql2.NullString{String: in.VisitorId, Valid: true}
+	} else {
+		return &proto.Empty{}, error2.ErrEmptyData
+	}
+	_, err = s.db.Exec(recordViewQuery, eventIdInt, userId, visitorId)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &proto.Empty{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return &proto.Empty{}, nil
+}
+
+//Ряд по дням для автора мероприятия. Без границ - последние StatsDefaultDays дней
+func (s *Repository) GetEventStats(ctx context.Context, in *proto.EventStatsRequest) (*proto.EventStats, error) {
+	message := logMessage + "GetEventStats:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(in.EventId)
+	if err != nil {
+		return &proto.EventStats{}, error2.ErrAtoi
+	}
+	userIdInt, err := strconv.Atoi(in.UserId)
+	if err != nil {
+		return &proto.EventStats{}, error2.ErrAtoi
+	}
+	from, to, err := statsRange(in.From, in.To, time.Now())
+	if err != nil {
+		return &proto.EventStats{}, err
+	}
+	err = s.checkAuthor(eventIdInt, userIdInt)
+	if err != nil {
+		return &proto.EventStats{}, err
+	}
+	rows, err := s.db.Queryx(eventStatsQuery, eventIdInt, from.Format(dateLayout), to.Format(dateLayout))
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return &proto.EventStats{}, error2.ErrPostgres
+	}
+	defer rows.Close()
+	out := &proto.EventStats{}
+	for rows.Next() {
+		day := &proto.DayStats{}
+		err := rows.Scan(&day.Date, &day.Views, &day.Favourites)
+		if err != nil {
+			log.Error(message+"err = ", err)
+			return &proto.EventStats{}, error2.ErrPostgres
+		}
+		out.Days = append(out.Days, day)
+	}
+	log.Debug(message + "ended")
+	return out, nil
+}
+
+//Границы включительно, в формате 2006-01-02
+func statsRange(fromValue string, toValue string, now time.Time) (time.Time, time.Time, error) {
+	to := now.UTC().Truncate(24 * time.Hour)
+	var err error
+	if toValue != "" {
+		to, err = time.Parse(dateLayout, toValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, error2.ErrInvalidStatsRange
+		}
+	}
+	from := to.AddDate(0, 0, -(StatsDefaultDays - 1))
+	if fromValue != "" {
+		from, err = time.Parse(dateLayout, fromValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, error2.ErrInvalidStatsRange
+		}
+	}
+	if to.Before(from) || to.Sub(from) >= StatsMaxDays*24*time.Hour {
+		return time.Time{}, time.Time{}, error2.ErrInvalidStatsRange
+	}
+	return from, to, nil
+}
+
+//Удаление любого мероприятия модератором, без проверки автора
+func (s *Repository) ForceDeleteEvent(ctx context.Context, in *proto.EventId) (*proto.Empty, error) {
+	message := logMessage + "ForceDeleteEvent:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(in.ID)
+	if err != nil {
+		return &proto.Empty{}, error2.ErrAtoi
+	}
+	err = s.execOnEvent(message, forceDeleteEventQuery, eventIdInt)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+	log.Debug(message + "ended")
+	return &proto.Empty{}, nil
+}
+
+//Скрытое мероприятие не попадает в выдачу и не открывается по id
+func (s *Repository) SetEventHidden(ctx context.Context, in *proto.SetEventHiddenRequest) (*proto.Empty, error) {
+	message := logMessage + "SetEventHidden:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(in.EventId)
+	if err != nil {
+		return &proto.Empty{}, error2.ErrAtoi
+	}
+	err = s.execOnEvent(message, setEventHiddenQuery, in.Hidden, eventIdInt)
+	if err != nil {
+		return &proto.Empty{}, err
+	}
+	log.Debug(message + "ended")
+	return &proto.Empty{}, nil
+}
+
+func (s *Repository) execOnEvent(message string, query string, args ...interface{}) error {
+	res, err := s.db.Exec(query, args...)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	if affected == 0 {
+		return error2.ErrEventNotFound
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package eventRepository
 import (
 	eventGrpc "backend/microservice/event/proto"
 	"backend/pkg/models"
+	"backend/pkg/utils"
 	error2 "backend/service/event/error"
 	error3 "backend/service/user/error"
 	"context"
@@ -13,8 +14,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"strconv"
 	"testing"
+	"time"
 )
 
+const eventStartsAt = "2021-11-13T19:00:00+03:00"
+
 var createEventTests = []struct {
 	id          int
 	event       *models.Event
@@ -27,6 +31,7 @@ var createEventTests = []struct {
 		1,
 		&models.Event{
 			AuthorId: "1",
+			StartsAt: eventStartsAt,
 		},
 		10,
 		nil,
@@ -35,7 +40,7 @@ var createEventTests = []struct {
 	},
 	{
 		2,
-		&models.Event{},
+		&models.Event{StartsAt: eventStartsAt},
 		10,
 		nil,
 		"10",
@@ -45,6 +50,7 @@ var createEventTests = []struct {
 		3,
 		&models.Event{
 			AuthorId: "test",
+			StartsAt: eventStartsAt,
 		},
 		0,
 		nil,
@@ -55,6 +61,7 @@ var createEventTests = []struct {
 		4,
 		&models.Event{
 			AuthorId: "10",
+			StartsAt: eventStartsAt,
 		},
 		0,
 		sql2.ErrNoRows,
@@ -65,12 +72,59 @@ var createEventTests = []struct {
 		5,
 		&models.Event{
 			AuthorId: "10",
+			StartsAt: eventStartsAt,
 		},
 		0,
 		sql2.ErrConnDone,
 		"",
 		error2.ErrPostgres,
 	},
+	{
+		6,
+		&models.Event{
+			AuthorId: "10",
+		},
+		0,
+		nil,
+		"",
+		error2.ErrInvalidEventTime,
+	},
+	{
+		7,
+		&models.Event{
+			AuthorId: "10",
+			StartsAt: eventStartsAt,
+			Timezone: "Mars/Olympus",
+		},
+		0,
+		nil,
+		"",
+		error2.ErrInvalidTimezone,
+	},
+	{
+		8,
+		&models.Event{
+			AuthorId: "10",
+			StartsAt: eventStartsAt,
+			Location: &models.Location{Lat: 55.7558, Lon: 37.6173},
+		},
+		10,
+		nil,
+		"10",
+		nil,
+	},
+	{
+		9,
+		&models.Event{
+			AuthorId: "10",
+			StartsAt: eventStartsAt,
+			Location: &models.Location{Lat: 95, Lon: 37.6173},
+		},
+		0,
+		nil,
+		"",
+		error2.ErrInvalidLocation,
+	},
 }
 
 func TestCreateEvent(t *testing.T) {
@@ -94,10 +148,14 @@ func TestCreateEvent(t *testing.T) {
 				newEvent.Text,
 				newEvent.City,
 				newEvent.Category,
-				newEvent.Viewed,
 				newEvent.ImgUrl,
-				newEvent.Date,
+				newEvent.StartsAt,
+				newEvent.EndsAt,
+				newEvent.Timezone,
 				newEvent.Geo,
+				newEvent.Lat,
+				newEvent.Lon,
+				newEvent.Capacity,
 				newEvent.Address,
 				newEvent.Tag,
 				newEvent.AuthorID,
@@ -124,6 +182,7 @@ var updateEventTests = []struct {
 		&models.Event{
 			ID:       "10",
 			AuthorId: "10",
+			StartsAt: eventStartsAt,
 		},
 		"10",
 		nil,
@@ -135,6 +194,7 @@ var updateEventTests = []struct {
 			ID:       "1",
 			AuthorId: "10",
 			ImgUrl:   "test",
+			StartsAt: eventStartsAt,
 		},
 		"10",
 		nil,
@@ -146,6 +206,7 @@ var updateEventTests = []struct {
 			ID:       "1",
 			AuthorId: "10",
 			ImgUrl:   "test",
+			StartsAt: eventStartsAt,
 		},
 		"10",
 		error2.ErrPostgres,
@@ -156,6 +217,7 @@ var updateEventTests = []struct {
 		&models.Event{
 			ID:       "1",
 			AuthorId: "10",
+			StartsAt: eventStartsAt,
 		},
 		"10",
 		error2.ErrPostgres,
@@ -166,6 +228,7 @@ var updateEventTests = []struct {
 		&models.Event{
 			ID:       "1",
 			AuthorId: "10",
+			StartsAt: eventStartsAt,
 		},
 		"2",
 		nil,
@@ -176,6 +239,7 @@ var updateEventTests = []struct {
 		&models.Event{
 			ID:       "test",
 			AuthorId: "10",
+			StartsAt: eventStartsAt,
 		},
 		"2",
 		nil,
@@ -186,6 +250,7 @@ var updateEventTests = []struct {
 		&models.Event{
 			ID:       "10",
 			AuthorId: "10",
+			StartsAt: eventStartsAt,
 		},
 		"test",
 		nil,
@@ -232,10 +297,14 @@ func TestUpdateEvent(t *testing.T) {
 					newEvent.Text,
 					newEvent.City,
 					newEvent.Category,
-					newEvent.Viewed,
 					newEvent.ImgUrl,
-					newEvent.Date,
+					newEvent.StartsAt,
+				newEvent.EndsAt,
+				newEvent.Timezone,
 					newEvent.Geo,
+					newEvent.Lat,
+					newEvent.Lon,
+					newEvent.Capacity,
 					newEvent.Address,
 					newEvent.Tag,
 					newEvent.ID,
@@ -248,9 +317,13 @@ func TestUpdateEvent(t *testing.T) {
 					newEvent.Text,
 					newEvent.City,
 					newEvent.Category,
-					newEvent.Viewed,
-					newEvent.Date,
+					newEvent.StartsAt,
+				newEvent.EndsAt,
+				newEvent.Timezone,
 					newEvent.Geo,
+					newEvent.Lat,
+					newEvent.Lon,
+					newEvent.Capacity,
 					newEvent.Address,
 					newEvent.Tag,
 					newEvent.ID,
@@ -410,6 +483,7 @@ var getEventsTests = []struct {
 	category     string
 	city         string
 	date         string
+	dateArg      string
 	tags         []string
 	postgresErr  error
 	outputEvents []*models.Event
@@ -420,7 +494,8 @@ var getEventsTests = []struct {
 		title:       "test",
 		category:    "test",
 		city:        "test",
-		date:        "test",
+		date:        "16.11.2021",
+		dateArg:     "2021-11-16",
 		tags:        []string{"test"},
 		postgresErr: nil,
 		outputEvents: []*models.Event{
@@ -472,11 +547,11 @@ func TestGetEvents(t *testing.T) {
 		for i := range test.tags {
 			postgresTags[i] = test.tags[i]
 		}
-		query := listQuery + " "
+		var query string
 		if test.title != "" {
-			query += `where lower(title) ~ lower($1) and `
+			query = searchQuery + ` and `
 		} else {
-			query += `where $1 = $1 and `
+			query = listQuery + ` and $1 = $1 and `
 		}
 		if test.category != "" {
 			query += `lower(category) = lower($2) and `
@@ -489,7 +564,7 @@ func TestGetEvents(t *testing.T) {
 			query += `$3 = $3 and `
 		}
 		if test.date != "" {
-			query += `lower(date) = lower($4) and `
+			query += `(starts_at at time zone timezone)::date = $4::date and `
 		} else {
 			query += `$4 = $4 and `
 		}
@@ -498,12 +573,16 @@ func TestGetEvents(t *testing.T) {
 		} else {
 			query += `$5 = $5`
 		}
-		query += " order by viewed DESC"
+		if test.title != "" {
+			query += " order by rank DESC, id DESC limit $6"
+		} else {
+			query += " order by id DESC limit $6"
+		}
 
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
 		mock.ExpectQuery(query).
-			WithArgs(test.title, test.category, test.city, test.date, postgresTags).
+			WithArgs(test.title, test.category, test.city, test.dateArg, postgresTags, utils.DefaultPageLimit+1).
 			WillReturnRows(rows).
 			WillReturnError(test.postgresErr)
 
@@ -574,13 +653,13 @@ func TestGetVisitedEvents(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
-		mock.ExpectQuery(visitedQuery).
-			WithArgs(userIdInt).
+		mock.ExpectQuery(visitedQuery+" order by id DESC limit $2").
+			WithArgs(userIdInt, utils.DefaultPageLimit+1).
 			WillReturnRows(rows).
 			WillReturnError(test.postgresErr)
 
-		in := &eventGrpc.UserId{
-			ID: test.userId,
+		in := &eventGrpc.UserEventsRequest{
+			UserId: test.userId,
 		}
 		out, actualErr := repositoryTest.GetVisitedEvents(context.Background(), in)
 		require.Equal(t, test.outputErr, actualErr)
@@ -642,13 +721,13 @@ func TestGetCreatedEvents(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
-		mock.ExpectQuery(createdQuery).
-			WithArgs(userIdInt).
+		mock.ExpectQuery(createdQuery+" order by id DESC limit $2").
+			WithArgs(userIdInt, utils.DefaultPageLimit+1).
 			WillReturnRows(rows).
 			WillReturnError(test.postgresErr)
 
-		in := &eventGrpc.UserId{
-			ID: test.userId,
+		in := &eventGrpc.UserEventsRequest{
+			UserId: test.userId,
 		}
 		out, actualErr := repositoryTest.GetCreatedEvents(context.Background(), in)
 		require.Equal(t, test.outputErr, actualErr)
@@ -660,6 +739,260 @@ func TestGetCreatedEvents(t *testing.T) {
 	}
 }
 
+func TestGetEventsPage(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	query := listQuery + " and $1 = $1 and $2 = $2 and $3 = $3 and $4 = $4 and $5 = $5"
+	mock.ExpectQuery(query+" order by id DESC limit $6").
+		WithArgs("", "", "", "", pq.StringArray{}, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "viewed"}).AddRow(5, 10).AddRow(4, 10).AddRow(3, 8))
+	in := &eventGrpc.GetEventsRequest{Limit: 2}
+	out, err := repositoryTest.GetEvents(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Events, 2)
+	require.Equal(t, utils.EncodeCursor(&utils.Cursor{ID: 4}), out.NextCursor)
+
+	//Между страницами мероприятие 3 набрало просмотров больше, чем показанные,
+	//но курсор от viewed не зависит, и оно не теряется
+	mock.ExpectQuery(query+" and id < $6 order by id DESC limit $7").
+		WithArgs("", "", "", "", pq.StringArray{}, 4, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "viewed"}).AddRow(3, 50).AddRow(2, 1))
+	in.Cursor = out.NextCursor
+	out, err = repositoryTest.GetEvents(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Events, 2)
+	require.Equal(t, "3", out.Events[0].ID)
+	require.Equal(t, int32(50), out.Events[0].Viewed)
+	require.Empty(t, out.NextCursor)
+
+	in.Cursor = "not a cursor"
+	_, err = repositoryTest.GetEvents(context.Background(), in)
+	require.Equal(t, utils.ErrInvalidCursor, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetEventsTimeFilter(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	from := time.Date(2021, 11, 20, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 2)
+	query := listQuery + " and $1 = $1 and $2 = $2 and $3 = $3 and $4 = $4 and $5 = $5" +
+		" and ends_at >= $6 and starts_at < $7 and ends_at > now() order by id DESC limit $8"
+	mock.ExpectQuery(query).
+		WithArgs("", "", "", "", pq.StringArray{}, from, to, utils.DefaultPageLimit+1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	in := &eventGrpc.GetEventsRequest{
+		From: from.Format(time.RFC3339),
+		To:   to.Format(time.RFC3339),
+		When: models.EventsUpcoming,
+	}
+	out, err := repositoryTest.GetEvents(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Events, 1)
+
+	var invalidTests = []*eventGrpc.GetEventsRequest{
+		{From: "20.11.2021"},
+		{From: to.Format(time.RFC3339), To: from.Format(time.RFC3339)},
+		{When: "tomorrow"},
+		{Date: "2021/11/20"},
+	}
+	for i, in := range invalidTests {
+		_, err = repositoryTest.GetEvents(context.Background(), in)
+		require.Equal(t, error2.ErrInvalidTimeFilter, err, i)
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetEventsNear(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	query := listQuery + " and $1 = $1 and $2 = $2 and $3 = $3 and $4 = $4 and $5 = $5" +
+		" and earth_box(ll_to_earth($6, $7), $8) @> ll_to_earth(lat, lon)" +
+		" and earth_distance(ll_to_earth($6, $7), ll_to_earth(lat, lon)) <= $8" +
+		" order by id DESC limit $9"
+	mock.ExpectQuery(query).
+		WithArgs("", "", "", "", pq.StringArray{}, 55.75, 37.62, 5000.0, utils.DefaultPageLimit+1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "lat", "lon"}).AddRow(1, 55.76, 37.61))
+	in := &eventGrpc.GetEventsRequest{
+		Near:     &eventGrpc.Location{Lat: 55.75, Lon: 37.62},
+		RadiusKm: 5,
+	}
+	out, err := repositoryTest.GetEvents(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Events, 1)
+	require.Equal(t, &models.Location{Lat: 55.76, Lon: 37.61}, fromProtoToModel(out.Events[0]).Location)
+
+	var invalidTests = []*eventGrpc.GetEventsRequest{
+		{RadiusKm: 5},
+		{Near: &eventGrpc.Location{Lat: 55.75, Lon: 37.62}},
+		{Near: &eventGrpc.Location{Lat: 55.75, Lon: 37.62}, RadiusKm: -1},
+		{Near: &eventGrpc.Location{Lat: 91, Lon: 37.62}, RadiusKm: 5},
+	}
+	for i, in := range invalidTests {
+		_, err = repositoryTest.GetEvents(context.Background(), in)
+		require.Equal(t, error2.ErrInvalidGeoFilter, err, i)
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetMapEvents(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectQuery(mapQuery + " and lon between $3 and $4 and lower(category) = lower($5)" +
+		" and ends_at > now() order by viewed DESC, id DESC limit $6").
+		WithArgs(55.0, 56.0, 37.0, 38.0, "music", MapLimit).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "lat", "lon"}).AddRow(1, 55.76, 37.61))
+	in := &eventGrpc.MapRequest{
+		Box:      &eventGrpc.BoundingBox{MinLat: 55, MinLon: 37, MaxLat: 56, MaxLon: 38},
+		Category: "music",
+		When:     models.EventsUpcoming,
+	}
+	out, err := repositoryTest.GetMapEvents(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Events, 1)
+	require.Empty(t, out.NextCursor)
+
+	//Прямоугольник через 180-й меридиан
+	mock.ExpectQuery(mapQuery + " and (lon >= $3 or lon <= $4) order by viewed DESC, id DESC limit $5").
+		WithArgs(60.0, 70.0, 170.0, -170.0, MapLimit).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	in = &eventGrpc.MapRequest{
+		Box: &eventGrpc.BoundingBox{MinLat: 60, MinLon: 170, MaxLat: 70, MaxLon: -170},
+	}
+	out, err = repositoryTest.GetMapEvents(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Events, 0)
+
+	var invalidTests = []*eventGrpc.MapRequest{
+		{},
+		{Box: &eventGrpc.BoundingBox{MinLat: 56, MinLon: 37, MaxLat: 55, MaxLon: 38}},
+		{Box: &eventGrpc.BoundingBox{MinLat: 55, MinLon: 37, MaxLat: 56, MaxLon: 190}},
+	}
+	for i, in := range invalidTests {
+		_, err = repositoryTest.GetMapEvents(context.Background(), in)
+		require.Equal(t, error2.ErrInvalidGeoFilter, err, i)
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventTime(t *testing.T) {
+	//Старая дата без времени - весь день в часовом поясе мероприятия
+	e, err := toPostgresEvent(&models.Event{Date: "16.11.2021", Timezone: "Asia/Yekaterinburg"})
+	require.NoError(t, err)
+	require.Equal(t, "2021-11-15T19:00:00Z", e.StartsAt.UTC().Format(time.RFC3339))
+	require.Equal(t, 24*time.Hour, e.EndsAt.Sub(e.StartsAt))
+	require.Equal(t, "Asia/Yekaterinburg", e.Timezone)
+
+	e, err = toPostgresEvent(&models.Event{StartsAt: "2021-11-13T16:00:00Z", EndsAt: "2021-11-13T18:00:00Z"})
+	require.NoError(t, err)
+	require.Equal(t, DefaultTimezone, e.Timezone)
+	modelEvent := toModelEvent(e)
+	require.Equal(t, "2021-11-13T19:00:00+03:00", modelEvent.StartsAt)
+	require.Equal(t, "2021-11-13T21:00:00+03:00", modelEvent.EndsAt)
+	require.Equal(t, "2021-11-13", modelEvent.Date)
+
+	_, err = toPostgresEvent(&models.Event{StartsAt: "2021-11-13T18:00:00Z", EndsAt: "2021-11-13T16:00:00Z"})
+	require.Equal(t, error2.ErrInvalidEventTime, err)
+	_, err = toPostgresEvent(&models.Event{Date: "13 ноября"})
+	require.Equal(t, error2.ErrInvalidEventTime, err)
+	_, err = toPostgresEvent(&models.Event{StartsAt: eventStartsAt, Timezone: "Local"})
+	require.Equal(t, error2.ErrInvalidTimezone, err)
+}
+
+func TestSearchEventsPage(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	query := searchQuery + " and $2 = $2 and $3 = $3 and $4 = $4 and $5 = $5" +
+		" and (ts_rank(search, query), id) < ($6, $7) order by rank DESC, id DESC limit $8"
+	rows := sqlmock.NewRows([]string{"id", "rank", "snippet"}).
+		AddRow(5, 0.0607927, "<b>концерт</b> в парке").
+		AddRow(3, 0.0303964, "")
+	mock.ExpectQuery(query).
+		WithArgs("концерт", "", "", "", pq.StringArray{}, 0.1, 6, 2).
+		WillReturnRows(rows)
+	in := &eventGrpc.GetEventsRequest{
+		Title:  "концерт",
+		Limit:  1,
+		Cursor: utils.EncodeCursor(&utils.Cursor{Key: "0.1", ID: 6}),
+	}
+	out, err := repositoryTest.GetEvents(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Events, 1)
+	require.Equal(t, "<b>концерт</b> в парке", out.Events[0].Snippet)
+	require.Equal(t, utils.EncodeCursor(&utils.Cursor{Key: "0.0607927", ID: 5}), out.NextCursor)
+
+	in.Cursor = utils.EncodeCursor(&utils.Cursor{Key: "a", ID: 6})
+	_, err = repositoryTest.GetEvents(context.Background(), in)
+	require.Equal(t, utils.ErrInvalidCursor, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCreatedEventsPage(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(4).AddRow(3)
+	mock.ExpectQuery(createdQuery+" and id < $2 order by id DESC limit $3").
+		WithArgs(1, 5, 3).
+		WillReturnRows(rows)
+	in := &eventGrpc.UserEventsRequest{
+		UserId: "1",
+		Limit:  2,
+		Cursor: utils.EncodeCursor(&utils.Cursor{ID: 5}),
+	}
+	out, err := repositoryTest.GetCreatedEvents(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Events, 2)
+	require.Equal(t, "", out.NextCursor)
+
+	in.Cursor = "bad"
+	_, err = repositoryTest.GetCreatedEvents(context.Background(), in)
+	require.Equal(t, utils.ErrInvalidCursor, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAuthorEvents(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	rows := sqlmock.NewRows([]string{"id", "hidden"}).AddRow(1, false).AddRow(2, true)
+	mock.ExpectQuery(authorQuery).WithArgs(1).WillReturnRows(rows)
+	out, err := repositoryTest.GetAuthorEvents(context.Background(), &eventGrpc.UserId{ID: "1"})
+	require.NoError(t, err)
+	require.Len(t, out.Events, 2)
+	require.Equal(t, "2", out.Events[1].ID)
+
+	_, err = repositoryTest.GetAuthorEvents(context.Background(), &eventGrpc.UserId{ID: "a"})
+	require.Equal(t, error3.ErrAtoi, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 var visitTests = []struct {
 	id          int
 	eventId     string
@@ -924,3 +1257,158 @@ func TestGetCities(t *testing.T) {
 		require.Equal(t, test.outputResult, actualRes)
 	}
 }
+
+var forceDeleteEventTests = []struct {
+	id          int
+	eventId     string
+	affected    int64
+	postgresErr error
+	outputErr   error
+}{
+	{
+		1,
+		"1",
+		1,
+		nil,
+		nil,
+	},
+	{
+		2,
+		"a",
+		0,
+		nil,
+		error2.ErrAtoi,
+	},
+	{
+		3,
+		"1",
+		0,
+		nil,
+		error2.ErrEventNotFound,
+	},
+	{
+		4,
+		"1",
+		0,
+		sql2.ErrConnDone,
+		error2.ErrPostgres,
+	},
+}
+
+func TestForceDeleteEvent(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	for _, test := range forceDeleteEventTests {
+		if test.outputErr != error2.ErrAtoi {
+			mock.ExpectExec(forceDeleteEventQuery).
+				WithArgs(1).
+				WillReturnResult(sqlmock.NewResult(0, test.affected)).
+				WillReturnError(test.postgresErr)
+		}
+		_, actualErr := repositoryTest.ForceDeleteEvent(context.Background(), &eventGrpc.EventId{ID: test.eventId})
+		require.Equal(t, test.outputErr, actualErr, test.id)
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetEventHidden(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectExec(setEventHiddenQuery).WithArgs(true, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	_, err = repositoryTest.SetEventHidden(context.Background(), &eventGrpc.SetEventHiddenRequest{EventId: "1", Hidden: true})
+	require.NoError(t, err)
+
+	mock.ExpectExec(setEventHiddenQuery).WithArgs(false, 2).WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = repositoryTest.SetEventHidden(context.Background(), &eventGrpc.SetEventHiddenRequest{EventId: "2"})
+	require.Equal(t, error2.ErrEventNotFound, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordView(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectExec(recordViewQuery).
+		WithArgs(1, sql2.NullInt64{Int64: 5, Valid: true}, sql2.NullString{}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	_, err = repositoryTest.RecordView(context.Background(), &eventGrpc.ViewRequest{EventId: "1", UserId: "5", VisitorId: "anon"})
+	require.NoError(t, err)
+
+	mock.ExpectExec(recordViewQuery).
+		WithArgs(1, sql2.NullInt64{}, sql2.NullString{String: "anon", Valid: true}).
+		WillReturnError(sql2.ErrConnDone)
+	_, err = repositoryTest.RecordView(context.Background(), &eventGrpc.ViewRequest{EventId: "1", VisitorId: "anon"})
+	require.Equal(t, error2.ErrPostgres, err)
+
+	_, err = repositoryTest.RecordView(context.Background(), &eventGrpc.ViewRequest{EventId: "1"})
+	require.Equal(t, error2.ErrEmptyData, err)
+	_, err = repositoryTest.RecordView(context.Background(), &eventGrpc.ViewRequest{EventId: "a", UserId: "5"})
+	require.Equal(t, error2.ErrAtoi, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetEventStats(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	mock.ExpectQuery(checkAuthorQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id"}).AddRow(5))
+	mock.ExpectQuery(eventStatsQuery).WithArgs(1, "2021-11-13", "2021-11-14").
+		WillReturnRows(sqlmock.NewRows([]string{"date", "views", "favourites"}).
+			AddRow("2021-11-13", 10, 2).
+			AddRow("2021-11-14", 0, 0))
+	in := &eventGrpc.EventStatsRequest{EventId: "1", UserId: "5", From: "2021-11-13", To: "2021-11-14"}
+	out, err := repositoryTest.GetEventStats(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Days, 2)
+	require.Equal(t, "2021-11-13", out.Days[0].Date)
+	require.Equal(t, int64(10), out.Days[0].Views)
+	require.Equal(t, int64(2), out.Days[0].Favourites)
+
+	mock.ExpectQuery(checkAuthorQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id"}).AddRow(6))
+	_, err = repositoryTest.GetEventStats(context.Background(), in)
+	require.Equal(t, error2.ErrNotAllowed, err)
+
+	in.From = "2021-11-15"
+	_, err = repositoryTest.GetEventStats(context.Background(), in)
+	require.Equal(t, error2.ErrInvalidStatsRange, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatsRange(t *testing.T) {
+	now := time.Date(2021, 11, 20, 15, 30, 0, 0, time.UTC)
+	from, to, err := statsRange("", "", now)
+	require.NoError(t, err)
+	require.Equal(t, "2021-10-22", from.Format(dateLayout))
+	require.Equal(t, "2021-11-20", to.Format(dateLayout))
+
+	from, to, err = statsRange("2021-11-01", "2021-11-01", now)
+	require.NoError(t, err)
+	require.Equal(t, from, to)
+
+	var invalidTests = [][2]string{
+		{"01.11.2021", ""},
+		{"", "2021/11/01"},
+		{"2021-11-02", "2021-11-01"},
+		{"2020-01-01", "2021-11-01"},
+	}
+	for i, test := range invalidTests {
+		_, _, err = statsRange(test[0], test[1], now)
+		require.Equal(t, error2.ErrInvalidStatsRange, err, i)
+	}
+}
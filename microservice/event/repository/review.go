@@ -0,0 +1,178 @@
+package eventRepository
+
+import (
+	proto "backend/microservice/event/proto"
+	log "backend/pkg/logger"
+	"backend/pkg/utils"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+	"math"
+	"strconv"
+	"time"
+)
+
+const (
+	minRating = 1
+	maxRating = 5
+)
+
+//Отзыв оставляет только тот, кто был на прошедшем мероприятии: записан (registered).
+//Если организатор отмечал проход по билетам (есть хоть одна отметка), нужна ещё и своя
+//отметка на входе, иначе хватает записи. rsvp не в счёт - это лишь ответ пользователя.
+//rating_sum и rating_count мероприятия меняются тем же запросом, что вставляет отзыв,
+//повторный отзыв не вставляется и счётчики не трогает. Отзывы скрытого мероприятия не видны
+const (
+	reviewColumns    = `r.id, r.event_id, r.user_id, r.rating, r.text, r.created_at`
+	reviewEventQuery = `select author_id, ends_at <= now() as finished from "event" where id = $1 and hidden = false`
+	attendedQuery    = `select exists (select 1 from "registration" r
+		where r.event_id = $1 and r.user_id = $2 and r.status = 'registered' and (r.checked_in_at is not null
+			or not exists (select 1 from "registration" c where c.event_id = $1 and c.checked_in_at is not null)))`
+	createReviewQuery = `with r as (
+			insert into "review" (event_id, user_id, rating, text) values ($1, $2, $3, $4)
+			on conflict (event_id, user_id) do nothing returning id, rating
+		)
+		update "event" set rating_sum = rating_sum + r.rating, rating_count = rating_count + 1
+		from r where "event".id = $1 returning r.id`
+	getReviewQuery  = `select ` + reviewColumns + ` from "review" r where r.id = $1`
+	getReviewsQuery = `select ` + reviewColumns + ` from "review" r
+		join "event" e on e.id = r.event_id and e.hidden = false where r.event_id = $1`
+)
+
+type Review struct {
+	ID        int       `db:"id"`
+	EventId   int       `db:"event_id"`
+	UserId    int       `db:"user_id"`
+	Rating    int32     `db:"rating"`
+	Text      string    `db:"text"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type reviewEvent struct {
+	AuthorID int  `db:"author_id"`
+	Finished bool `db:"finished"`
+}
+
+func toProtoReview(r *Review) *proto.Review {
+	return &proto.Review{
+		Id:        strconv.Itoa(r.ID),
+		EventId:   strconv.Itoa(r.EventId),
+		UserId:    strconv.Itoa(r.UserId),
+		Rating:    r.Rating,
+		Text:      r.Text,
+		CreatedAt: r.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+//Средняя оценка с точностью до сотых, без отзывов - 0
+func ratingAvg(sum int, count int) float64 {
+	if count == 0 {
+		return 0
+	}
+	return math.Round(float64(sum)/float64(count)*100) / 100
+}
+
+func validRating(rating int32) bool {
+	return rating >= minRating && rating <= maxRating
+}
+
+func (s *Repository) CreateReview(ctx context.Context, in *proto.Review) (*proto.Review, error) {
+	message := logMessage + "CreateReview:"
+	log.Debug(message + "started")
+	eventIdInt, userIdInt, err := parseVisitRequest(&proto.VisitRequest{EventId: in.EventId, UserId: in.UserId})
+	if err != nil {
+		return &proto.Review{}, err
+	}
+	if !validRating(in.Rating) {
+		return &proto.Review{}, error2.ErrInvalidRating
+	}
+	var e reviewEvent
+	err = s.db.Get(&e, reviewEventQuery, eventIdInt)
+	if err == sql2.ErrNoRows {
+		return &proto.Review{}, error2.ErrEventNotFound
+	}
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Review{}, error2.ErrPostgres
+	}
+	//Организатор не оценивает своё мероприятие
+	if e.AuthorID == userIdInt {
+		return &proto.Review{}, error2.ErrNotAllowed
+	}
+	if !e.Finished {
+		return &proto.Review{}, error2.ErrEventNotFinished
+	}
+	var attended bool
+	err = s.db.Get(&attended, attendedQuery, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Review{}, error2.ErrPostgres
+	}
+	if !attended {
+		return &proto.Review{}, error2.ErrNotAttended
+	}
+	var reviewId int
+	err = s.db.Get(&reviewId, createReviewQuery, eventIdInt, userIdInt, in.Rating, in.Text)
+	if err == sql2.ErrNoRows {
+		return &proto.Review{}, error2.ErrAlreadyReviewed
+	}
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Review{}, error2.ErrPostgres
+	}
+	var r Review
+	err = s.db.Get(&r, getReviewQuery, reviewId)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Review{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return toProtoReview(&r), nil
+}
+
+//Листается по id от новых к старым, как и комментарии
+func (s *Repository) GetReviews(ctx context.Context, in *proto.ReviewsRequest) (*proto.Reviews, error) {
+	message := logMessage + "GetReviews:"
+	log.Debug(message + "started")
+	eventIdInt, err := strconv.Atoi(in.EventId)
+	if err != nil {
+		return &proto.Reviews{}, error2.ErrAtoi
+	}
+	cursor, err := utils.DecodeCursor(in.Cursor)
+	if err != nil {
+		return &proto.Reviews{}, err
+	}
+	limit := utils.PageLimit(int(in.Limit))
+	query := getReviewsQuery
+	args := []interface{}{eventIdInt}
+	if cursor != nil {
+		args = append(args, cursor.ID)
+		query += ` and r.id < $` + strconv.Itoa(len(args))
+	}
+	args = append(args, limit+1)
+	query += ` order by r.id DESC limit $` + strconv.Itoa(len(args))
+	rows, err := s.db.Queryx(query, args...)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Reviews{}, error2.ErrPostgres
+	}
+	defer rows.Close()
+	out := &proto.Reviews{}
+	var lastId int
+	for rows.Next() {
+		var r Review
+		err = rows.StructScan(&r)
+		if err != nil {
+			log.Error(message+"err =", err)
+			return &proto.Reviews{}, error2.ErrPostgres
+		}
+		if len(out.Reviews) == limit {
+			out.NextCursor = utils.EncodeCursor(&utils.Cursor{ID: lastId})
+			break
+		}
+		lastId = r.ID
+		out.Reviews = append(out.Reviews, toProtoReview(&r))
+	}
+	log.Debug(message + "ended")
+	return out, nil
+}
@@ -0,0 +1,131 @@
+package eventRepository
+
+import (
+	eventGrpc "backend/microservice/event/proto"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var reviewRowColumns = []string{"id", "event_id", "user_id", "rating", "text", "created_at"}
+
+func expectReviewEvent(mock sqlmock.Sqlmock, authorId int, finished bool) {
+	mock.ExpectQuery(reviewEventQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id", "finished"}).AddRow(authorId, finished))
+}
+
+func expectAttended(mock sqlmock.Sqlmock, attended bool) {
+	mock.ExpectQuery(attendedQuery).WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"attended"}).AddRow(attended))
+}
+
+func TestCreateReview(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+	createdAt := time.Date(2021, 11, 13, 18, 30, 0, 0, time.UTC)
+	in := &eventGrpc.Review{EventId: "1", UserId: "5", Rating: 4, Text: "Хороший концерт"}
+
+	expectReviewEvent(mock, 2, true)
+	expectAttended(mock, true)
+	mock.ExpectQuery(createReviewQuery).WithArgs(1, 5, int32(4), "Хороший концерт").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(getReviewQuery).WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(reviewRowColumns).AddRow(3, 1, 5, 4, "Хороший концерт", createdAt))
+	out, err := repositoryTest.CreateReview(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, &eventGrpc.Review{
+		Id:        "3",
+		EventId:   "1",
+		UserId:    "5",
+		Rating:    4,
+		Text:      "Хороший концерт",
+		CreatedAt: "2021-11-13T18:30:00Z",
+	}, out)
+
+	//Мероприятие без отметок прохода: достаточно записи
+	require.Contains(t, attendedQuery, "not exists")
+	expectReviewEvent(mock, 2, true)
+	expectAttended(mock, true)
+	mock.ExpectQuery(createReviewQuery).WithArgs(1, 5, int32(4), "Хороший концерт").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(getReviewQuery).WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(reviewRowColumns).AddRow(3, 1, 5, 4, "Хороший концерт", createdAt))
+	_, err = repositoryTest.CreateReview(context.Background(), in)
+	require.NoError(t, err)
+
+	//Второй отзыв того же пользователя не вставляется
+	expectReviewEvent(mock, 2, true)
+	expectAttended(mock, true)
+	mock.ExpectQuery(createReviewQuery).WithArgs(1, 5, int32(4), "Хороший концерт").WillReturnError(sql2.ErrNoRows)
+	_, err = repositoryTest.CreateReview(context.Background(), in)
+	require.Equal(t, error2.ErrAlreadyReviewed, err)
+
+	expectReviewEvent(mock, 2, true)
+	expectAttended(mock, false)
+	_, err = repositoryTest.CreateReview(context.Background(), in)
+	require.Equal(t, error2.ErrNotAttended, err)
+
+	expectReviewEvent(mock, 2, false)
+	_, err = repositoryTest.CreateReview(context.Background(), in)
+	require.Equal(t, error2.ErrEventNotFinished, err)
+
+	//Ответ going после конца мероприятия не сохраняется и права на отзыв не даёт
+	mock.ExpectExec(setRsvpQuery).WithArgs(1, 5, models.RsvpGoing, true).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(rsvpEventQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"finished"}).AddRow(true))
+	_, err = repositoryTest.SetRsvp(context.Background(), &eventGrpc.RsvpRequest{EventId: "1", UserId: "5", Status: models.RsvpGoing})
+	require.Equal(t, error2.ErrEventFinished, err)
+	require.NotContains(t, attendedQuery, "rsvp")
+	expectReviewEvent(mock, 2, true)
+	expectAttended(mock, false)
+	_, err = repositoryTest.CreateReview(context.Background(), in)
+	require.Equal(t, error2.ErrNotAttended, err)
+
+	//Организатор своё мероприятие не оценивает
+	expectReviewEvent(mock, 5, true)
+	_, err = repositoryTest.CreateReview(context.Background(), in)
+	require.Equal(t, error2.ErrNotAllowed, err)
+
+	mock.ExpectQuery(reviewEventQuery).WithArgs(1).WillReturnError(sql2.ErrNoRows)
+	_, err = repositoryTest.CreateReview(context.Background(), in)
+	require.Equal(t, error2.ErrEventNotFound, err)
+
+	_, err = repositoryTest.CreateReview(context.Background(), &eventGrpc.Review{EventId: "1", UserId: "5", Rating: 6})
+	require.Equal(t, error2.ErrInvalidRating, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetReviews(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+	createdAt := time.Date(2021, 11, 13, 18, 30, 0, 0, time.UTC)
+
+	mock.ExpectQuery(getReviewsQuery+" and r.id < $2 order by r.id DESC limit $3").
+		WithArgs(1, 10, 3).
+		WillReturnRows(sqlmock.NewRows(reviewRowColumns).
+			AddRow(9, 1, 5, 5, "", createdAt).
+			AddRow(8, 1, 6, 3, "Скучно", createdAt).
+			AddRow(7, 1, 7, 4, "Неплохо", createdAt))
+	out, err := repositoryTest.GetReviews(context.Background(), &eventGrpc.ReviewsRequest{
+		EventId: "1",
+		Limit:   2,
+		Cursor:  utils.EncodeCursor(&utils.Cursor{ID: 10}),
+	})
+	require.NoError(t, err)
+	require.Len(t, out.Reviews, 2)
+	require.Equal(t, int32(5), out.Reviews[0].Rating)
+	require.Equal(t, "Скучно", out.Reviews[1].Text)
+	require.Equal(t, utils.EncodeCursor(&utils.Cursor{ID: 8}), out.NextCursor)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRatingAvg(t *testing.T) {
+	require.Equal(t, 0.0, ratingAvg(0, 0))
+	require.Equal(t, 4.33, ratingAvg(13, 3))
+	require.Equal(t, 5.0, ratingAvg(10, 2))
+}
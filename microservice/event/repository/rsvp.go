@@ -0,0 +1,134 @@
+package eventRepository
+
+import (
+	proto "backend/microservice/event/proto"
+	log "backend/pkg/logger"
+	"backend/pkg/models"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+)
+
+//Ответ на скрытое, несуществующее или закончившееся мероприятие не вставляется - insert ... select
+//не находит строку мероприятия. going ($4) - только при подтверждённой записи: мест в списке
+//участников не больше, чем в регистрации. for share держит запись, пока ответ не сохранён,
+//чтобы отмена записи не проскочила между проверкой и вставкой
+const (
+	setRsvpQuery = `insert into "rsvp" (event_id, user_id, status)
+		select id, $2, $3 from "event" where id = $1 and hidden = false and ends_at > now()
+		and (not $4 or exists (select 1 from "registration" where event_id = $1 and user_id = $2 and status = 'registered' for share))
+		on conflict (event_id, user_id) do update set status = excluded.status, updated_at = now()`
+	rsvpEventQuery   = `select ends_at <= now() from "event" where id = $1 and hidden = false`
+	deleteGoingQuery = `delete from "rsvp" where event_id = $1 and user_id = $2 and status = 'going'`
+	deleteRsvpQuery  = `delete from "rsvp" where event_id = $1 and user_id = $2`
+	getRsvpQuery     = `select status from "rsvp" where event_id = $1 and user_id = $2`
+	rsvpCountsQuery  = `select count(*) filter (where status = 'going') as going,
+		count(*) filter (where status = 'interested') as interested from "rsvp" where event_id = $1`
+)
+
+type rsvpCounts struct {
+	Going      int32 `db:"going"`
+	Interested int32 `db:"interested"`
+}
+
+func (s *Repository) SetRsvp(ctx context.Context, in *proto.RsvpRequest) (*proto.Rsvp, error) {
+	message := logMessage + "SetRsvp:"
+	log.Debug(message + "started")
+	eventIdInt, userIdInt, err := parseVisitRequest(&proto.VisitRequest{EventId: in.EventId, UserId: in.UserId})
+	if err != nil {
+		return &proto.Rsvp{}, err
+	}
+	if !models.IsValidRsvpStatus(in.Status) {
+		return &proto.Rsvp{}, error2.ErrInvalidRsvpStatus
+	}
+	res, err := s.db.Exec(setRsvpQuery, eventIdInt, userIdInt, in.Status, in.Status == models.RsvpGoing)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Rsvp{}, error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return &proto.Rsvp{}, error2.ErrPostgres
+	}
+	if affected == 0 {
+		return &proto.Rsvp{}, s.rsvpRejected(eventIdInt)
+	}
+	out, err := s.rsvpState(eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Rsvp{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return out, nil
+}
+
+func (s *Repository) DeleteRsvp(ctx context.Context, in *proto.VisitRequest) (*proto.Rsvp, error) {
+	message := logMessage + "DeleteRsvp:"
+	log.Debug(message + "started")
+	eventIdInt, userIdInt, err := parseVisitRequest(in)
+	if err != nil {
+		return &proto.Rsvp{}, err
+	}
+	_, err = s.db.Exec(deleteRsvpQuery, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Rsvp{}, error2.ErrPostgres
+	}
+	out, err := s.rsvpState(eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Rsvp{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return out, nil
+}
+
+func (s *Repository) GetRsvp(ctx context.Context, in *proto.VisitRequest) (*proto.Rsvp, error) {
+	message := logMessage + "GetRsvp:"
+	log.Debug(message + "started")
+	eventIdInt, userIdInt, err := parseVisitRequest(in)
+	if err != nil {
+		return &proto.Rsvp{}, err
+	}
+	out, err := s.rsvpState(eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Rsvp{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return out, nil
+}
+
+//Почему ответ не сохранён: мероприятия нет, оно закончилось или going без записи
+func (s *Repository) rsvpRejected(eventId int) error {
+	var finished bool
+	err := s.db.Get(&finished, rsvpEventQuery, eventId)
+	if err == sql2.ErrNoRows {
+		return error2.ErrEventNotFound
+	}
+	if err != nil {
+		log.Error(logMessage+"rsvpRejected:err =", err)
+		return error2.ErrPostgres
+	}
+	if finished {
+		return error2.ErrEventFinished
+	}
+	return error2.ErrNotRegistered
+}
+
+//Ответ пользователя и число ответивших going и interested
+func (s *Repository) rsvpState(eventId int, userId int) (*proto.Rsvp, error) {
+	out := &proto.Rsvp{}
+	err := s.db.Get(&out.Status, getRsvpQuery, eventId, userId)
+	if err != nil && err != sql2.ErrNoRows {
+		return nil, err
+	}
+	var counts rsvpCounts
+	err = s.db.Get(&counts, rsvpCountsQuery, eventId)
+	if err != nil {
+		return nil, err
+	}
+	out.Going = counts.Going
+	out.Interested = counts.Interested
+	return out, nil
+}
@@ -0,0 +1,75 @@
+package eventRepository
+
+import (
+	eventGrpc "backend/microservice/event/proto"
+	"backend/pkg/models"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func expectRsvpState(mock sqlmock.Sqlmock, status interface{}, going int, interested int) {
+	rows := sqlmock.NewRows([]string{"status"})
+	if status != nil {
+		rows.AddRow(status)
+	}
+	mock.ExpectQuery(getRsvpQuery).WithArgs(1, 5).WillReturnRows(rows)
+	mock.ExpectQuery(rsvpCountsQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"going", "interested"}).AddRow(going, interested))
+}
+
+func TestSetRsvp(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+
+	going := &eventGrpc.RsvpRequest{EventId: "1", UserId: "5", Status: models.RsvpGoing}
+	mock.ExpectExec(setRsvpQuery).WithArgs(1, 5, models.RsvpGoing, true).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectRsvpState(mock, models.RsvpGoing, 3, 7)
+	out, err := repositoryTest.SetRsvp(context.Background(), going)
+	require.NoError(t, err)
+	require.Equal(t, &eventGrpc.Rsvp{Status: models.RsvpGoing, Going: 3, Interested: 7}, out)
+
+	//going без подтверждённой записи
+	mock.ExpectExec(setRsvpQuery).WithArgs(1, 5, models.RsvpGoing, true).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(rsvpEventQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"finished"}).AddRow(false))
+	_, err = repositoryTest.SetRsvp(context.Background(), going)
+	require.Equal(t, error2.ErrNotRegistered, err)
+
+	mock.ExpectExec(setRsvpQuery).WithArgs(1, 5, models.RsvpInterested, false).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(rsvpEventQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"finished"}).AddRow(true))
+	_, err = repositoryTest.SetRsvp(context.Background(), &eventGrpc.RsvpRequest{EventId: "1", UserId: "5", Status: models.RsvpInterested})
+	require.Equal(t, error2.ErrEventFinished, err)
+
+	//Мероприятие не найдено или скрыто
+	mock.ExpectExec(setRsvpQuery).WithArgs(1, 5, models.RsvpNotGoing, false).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(rsvpEventQuery).WithArgs(1).WillReturnError(sql2.ErrNoRows)
+	_, err = repositoryTest.SetRsvp(context.Background(), &eventGrpc.RsvpRequest{EventId: "1", UserId: "5", Status: models.RsvpNotGoing})
+	require.Equal(t, error2.ErrEventNotFound, err)
+
+	_, err = repositoryTest.SetRsvp(context.Background(), &eventGrpc.RsvpRequest{EventId: "1", UserId: "5", Status: "maybe"})
+	require.Equal(t, error2.ErrInvalidRsvpStatus, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteRsvp(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+
+	mock.ExpectExec(deleteRsvpQuery).WithArgs(1, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectRsvpState(mock, nil, 2, 7)
+	out, err := repositoryTest.DeleteRsvp(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.NoError(t, err)
+	require.Equal(t, &eventGrpc.Rsvp{Going: 2, Interested: 7}, out)
+
+	expectRsvpState(mock, models.RsvpInterested, 2, 7)
+	out, err = repositoryTest.GetRsvp(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.NoError(t, err)
+	require.Equal(t, models.RsvpInterested, out.Status)
+
+	_, err = repositoryTest.GetRsvp(context.Background(), &eventGrpc.VisitRequest{EventId: "x", UserId: "5"})
+	require.Equal(t, error2.ErrAtoi, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
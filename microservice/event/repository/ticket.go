@@ -0,0 +1,215 @@
+package eventRepository
+
+import (
+	proto "backend/microservice/event/proto"
+	log "backend/pkg/logger"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+)
+
+//Билет - подписанный сервисом мероприятий токен с id записи (registration), который
+//кодируется в QR-код. id записи не переиспользуется, поэтому после отмены и повторной
+//записи старый билет недействителен. Отметка прохода ставится одним update с условием
+//checked_in_at is null - повторное сканирование его не пройдёт. Билет на скрытое
+//мероприятие не выдаётся
+const (
+	ticketSecretEnv = "TICKETSECRET"
+	getTicketQuery  = `select r.id, r.checked_in_at, e.title from "registration" r join "event" e on e.id = r.event_id
+		where r.event_id = $1 and r.user_id = $2 and r.status = 'registered' and e.hidden = false`
+	checkInQuery = `update "registration" set checked_in_at = now()
+		where id = $1 and event_id = $2 and user_id = $3 and status = 'registered' and checked_in_at is null
+		returning checked_in_at`
+	getCheckInQuery = `select checked_in_at from "registration"
+		where id = $1 and event_id = $2 and user_id = $3 and status = 'registered'`
+	attendanceQuery = `select count(*) filter (where checked_in_at is not null) as attended, count(*) as registered
+		from "registration" where event_id = $1 and status = 'registered'`
+)
+
+type ticketClaims struct {
+	EventId int `json:"eventId"`
+	UserId  int `json:"userId"`
+	jwt.StandardClaims
+}
+
+type ticketRow struct {
+	ID          int           `db:"id"`
+	CheckedInAt sql2.NullTime `db:"checked_in_at"`
+	Title       string        `db:"title"`
+}
+
+type attendanceRow struct {
+	Attended   int32 `db:"attended"`
+	Registered int32 `db:"registered"`
+}
+
+func ticketSecret() ([]byte, error) {
+	secret := os.Getenv(ticketSecretEnv)
+	if secret == "" {
+		return nil, error2.ErrNoTicketSecret
+	}
+	return []byte(secret), nil
+}
+
+func signTicket(registrationId int, eventId int, userId int) (string, error) {
+	secret, err := ticketSecret()
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &ticketClaims{
+		EventId: eventId,
+		UserId:  userId,
+		StandardClaims: jwt.StandardClaims{
+			ID: strconv.Itoa(registrationId),
+		},
+	})
+	return token.SignedString(secret)
+}
+
+func parseTicket(code string) (*ticketClaims, int, error) {
+	secret, err := ticketSecret()
+	if err != nil {
+		return nil, 0, err
+	}
+	claims := &ticketClaims{}
+	_, err = jwt.ParseWithClaims(code, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrHashUnavailable
+		}
+		return secret, nil
+	})
+	if err != nil {
+		return nil, 0, error2.ErrInvalidTicket
+	}
+	registrationId, err := strconv.Atoi(claims.ID)
+	if err != nil {
+		return nil, 0, error2.ErrInvalidTicket
+	}
+	return claims, registrationId, nil
+}
+
+func formatCheckIn(t sql2.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.UTC().Format(time.RFC3339)
+}
+
+//Билет есть только у участника, в листе ожидания его нет
+func (s *Repository) GetTicket(ctx context.Context, in *proto.VisitRequest) (*proto.Ticket, error) {
+	message := logMessage + "GetTicket:"
+	log.Debug(message + "started")
+	eventIdInt, userIdInt, err := parseVisitRequest(in)
+	if err != nil {
+		return &proto.Ticket{}, err
+	}
+	var row ticketRow
+	err = s.db.Get(&row, getTicketQuery, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		if err == sql2.ErrNoRows {
+			return &proto.Ticket{}, error2.ErrNotRegistered
+		}
+		return &proto.Ticket{}, error2.ErrPostgres
+	}
+	code, err := signTicket(row.ID, eventIdInt, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Ticket{}, error2.ErrNoTicketSecret
+	}
+	log.Debug(message + "ended")
+	return &proto.Ticket{
+		Code:        code,
+		EventId:     in.EventId,
+		UserId:      in.UserId,
+		EventTitle:  row.Title,
+		CheckedInAt: formatCheckIn(row.CheckedInAt),
+	}, nil
+}
+
+//UserId - организатор, отмечать проход может только автор мероприятия
+func (s *Repository) CheckIn(ctx context.Context, in *proto.CheckInRequest) (*proto.CheckInResult, error) {
+	message := logMessage + "CheckIn:"
+	log.Debug(message + "started")
+	eventIdInt, organizerIdInt, err := parseVisitRequest(&proto.VisitRequest{EventId: in.EventId, UserId: in.UserId})
+	if err != nil {
+		return &proto.CheckInResult{}, err
+	}
+	err = s.checkAuthor(eventIdInt, organizerIdInt)
+	if err != nil {
+		return &proto.CheckInResult{}, err
+	}
+	claims, registrationId, err := parseTicket(in.Code)
+	if err != nil {
+		return &proto.CheckInResult{}, err
+	}
+	//Билет на другое мероприятие
+	if claims.EventId != eventIdInt {
+		return &proto.CheckInResult{}, error2.ErrInvalidTicket
+	}
+	var checkedInAt sql2.NullTime
+	err = s.db.Get(&checkedInAt, checkInQuery, registrationId, eventIdInt, claims.UserId)
+	if err == sql2.ErrNoRows {
+		//Отличаем повторное сканирование от отменённой записи
+		err = s.db.Get(&checkedInAt, getCheckInQuery, registrationId, eventIdInt, claims.UserId)
+		if err == sql2.ErrNoRows {
+			return &proto.CheckInResult{}, error2.ErrInvalidTicket
+		}
+		if err == nil {
+			return &proto.CheckInResult{}, error2.ErrAlreadyCheckedIn
+		}
+	}
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.CheckInResult{}, error2.ErrPostgres
+	}
+	attendance, err := s.attendance(eventIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.CheckInResult{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return &proto.CheckInResult{
+		UserId:      strconv.Itoa(claims.UserId),
+		CheckedInAt: formatCheckIn(checkedInAt),
+		Attendance:  attendance,
+	}, nil
+}
+
+//UserId - организатор
+func (s *Repository) GetAttendance(ctx context.Context, in *proto.VisitRequest) (*proto.Attendance, error) {
+	message := logMessage + "GetAttendance:"
+	log.Debug(message + "started")
+	eventIdInt, organizerIdInt, err := parseVisitRequest(in)
+	if err != nil {
+		return &proto.Attendance{}, err
+	}
+	err = s.checkAuthor(eventIdInt, organizerIdInt)
+	if err != nil {
+		return &proto.Attendance{}, err
+	}
+	out, err := s.attendance(eventIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.Attendance{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return out, nil
+}
+
+func (s *Repository) attendance(eventId int) (*proto.Attendance, error) {
+	var row attendanceRow
+	err := s.db.Get(&row, attendanceQuery, eventId)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.Attendance{
+		Attended:   row.Attended,
+		Registered: row.Registered,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package eventRepository
+
+import (
+	eventGrpc "backend/microservice/event/proto"
+	error2 "backend/service/event/error"
+	"context"
+	sql2 "database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func expectAuthor(mock sqlmock.Sqlmock, authorId int) {
+	mock.ExpectQuery(checkAuthorQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"author_id"}).AddRow(authorId))
+}
+
+func TestGetTicket(t *testing.T) {
+	t.Setenv(ticketSecretEnv, "ticket-secret")
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+
+	mock.ExpectQuery(getTicketQuery).WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "checked_in_at", "title"}).AddRow(7, nil, "Концерт"))
+	out, err := repositoryTest.GetTicket(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.NoError(t, err)
+	require.Equal(t, "Концерт", out.EventTitle)
+	require.Equal(t, "", out.CheckedInAt)
+	claims, registrationId, err := parseTicket(out.Code)
+	require.NoError(t, err)
+	require.Equal(t, 7, registrationId)
+	require.Equal(t, 1, claims.EventId)
+	require.Equal(t, 5, claims.UserId)
+
+	//В листе ожидания, не записан или мероприятие скрыто
+	require.Contains(t, getTicketQuery, "e.hidden = false")
+	mock.ExpectQuery(getTicketQuery).WithArgs(1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "checked_in_at", "title"}))
+	_, err = repositoryTest.GetTicket(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "5"})
+	require.Equal(t, error2.ErrNotRegistered, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestParseTicket(t *testing.T) {
+	t.Setenv(ticketSecretEnv, "ticket-secret")
+	code, err := signTicket(7, 1, 5)
+	require.NoError(t, err)
+
+	t.Setenv(ticketSecretEnv, "other-secret")
+	_, _, err = parseTicket(code)
+	require.Equal(t, error2.ErrInvalidTicket, err)
+
+	t.Setenv(ticketSecretEnv, "")
+	_, err = signTicket(7, 1, 5)
+	require.Equal(t, error2.ErrNoTicketSecret, err)
+}
+
+func TestCheckIn(t *testing.T) {
+	t.Setenv(ticketSecretEnv, "ticket-secret")
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+	code, err := signTicket(7, 1, 5)
+	require.NoError(t, err)
+	in := &eventGrpc.CheckInRequest{EventId: "1", UserId: "9", Code: code}
+	checkedInAt := time.Date(2021, 11, 13, 18, 30, 0, 0, time.UTC)
+
+	expectAuthor(mock, 9)
+	mock.ExpectQuery(checkInQuery).WithArgs(7, 1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"checked_in_at"}).AddRow(checkedInAt))
+	mock.ExpectQuery(attendanceQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"attended", "registered"}).AddRow(1, 2))
+	out, err := repositoryTest.CheckIn(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, &eventGrpc.CheckInResult{
+		UserId:      "5",
+		CheckedInAt: "2021-11-13T18:30:00Z",
+		Attendance:  &eventGrpc.Attendance{Attended: 1, Registered: 2},
+	}, out)
+
+	//Повторное сканирование
+	expectAuthor(mock, 9)
+	mock.ExpectQuery(checkInQuery).WithArgs(7, 1, 5).WillReturnError(sql2.ErrNoRows)
+	mock.ExpectQuery(getCheckInQuery).WithArgs(7, 1, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"checked_in_at"}).AddRow(checkedInAt))
+	_, err = repositoryTest.CheckIn(context.Background(), in)
+	require.Equal(t, error2.ErrAlreadyCheckedIn, err)
+
+	//Запись отменена
+	expectAuthor(mock, 9)
+	mock.ExpectQuery(checkInQuery).WithArgs(7, 1, 5).WillReturnError(sql2.ErrNoRows)
+	mock.ExpectQuery(getCheckInQuery).WithArgs(7, 1, 5).WillReturnError(sql2.ErrNoRows)
+	_, err = repositoryTest.CheckIn(context.Background(), in)
+	require.Equal(t, error2.ErrInvalidTicket, err)
+
+	//Билет на другое мероприятие
+	otherCode, err := signTicket(8, 2, 5)
+	require.NoError(t, err)
+	expectAuthor(mock, 9)
+	_, err = repositoryTest.CheckIn(context.Background(), &eventGrpc.CheckInRequest{EventId: "1", UserId: "9", Code: otherCode})
+	require.Equal(t, error2.ErrInvalidTicket, err)
+
+	//Не организатор
+	expectAuthor(mock, 9)
+	_, err = repositoryTest.CheckIn(context.Background(), &eventGrpc.CheckInRequest{EventId: "1", UserId: "5", Code: code})
+	require.Equal(t, error2.ErrNotAllowed, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAttendance(t *testing.T) {
+	repositoryTest, mock, closeDb := newRegistrationTest(t)
+	defer closeDb()
+
+	expectAuthor(mock, 9)
+	mock.ExpectQuery(attendanceQuery).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"attended", "registered"}).AddRow(3, 10))
+	out, err := repositoryTest.GetAttendance(context.Background(), &eventGrpc.VisitRequest{EventId: "1", UserId: "9"})
+	require.NoError(t, err)
+	require.Equal(t, &eventGrpc.Attendance{Attended: 3, Registered: 10}, out)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -131,13 +131,15 @@ type User struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID       string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Surname  string `protobuf:"bytes,3,opt,name=Surname,proto3" json:"Surname,omitempty"`
-	Mail     string `protobuf:"bytes,4,opt,name=Mail,proto3" json:"Mail,omitempty"`
-	Password string `protobuf:"bytes,5,opt,name=Password,proto3" json:"Password,omitempty"`
-	About    string `protobuf:"bytes,6,opt,name=About,proto3" json:"About,omitempty"`
-	ImgUrl   string `protobuf:"bytes,7,opt,name=ImgUrl,proto3" json:"ImgUrl,omitempty"`
+	ID          string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Surname     string  `protobuf:"bytes,3,opt,name=Surname,proto3" json:"Surname,omitempty"`
+	Mail        string  `protobuf:"bytes,4,opt,name=Mail,proto3" json:"Mail,omitempty"`
+	Password    string  `protobuf:"bytes,5,opt,name=Password,proto3" json:"Password,omitempty"`
+	About       string  `protobuf:"bytes,6,opt,name=About,proto3" json:"About,omitempty"`
+	ImgUrl      string  `protobuf:"bytes,7,opt,name=ImgUrl,proto3" json:"ImgUrl,omitempty"`
+	Reputation  float64 `protobuf:"fixed64,8,opt,name=Reputation,proto3" json:"Reputation,omitempty"`
+	ReviewCount int32   `protobuf:"varint,9,opt,name=ReviewCount,proto3" json:"ReviewCount,omitempty"`
 }
 
 func (x *User) Reset() {
@@ -221,12 +223,27 @@ func (x *User) GetImgUrl() string {
 	return ""
 }
 
+func (x *User) GetReputation() float64 {
+	if x != nil {
+		return x.Reputation
+	}
+	return 0
+}
+
+func (x *User) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
 type Users struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Users      []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursor string  `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 }
 
 func (x *Users) Reset() {
@@ -268,17 +285,25 @@ func (x *Users) GetUsers() []*User {
 	return nil
 }
 
-type SubscribeRequest struct {
+func (x *Users) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscribedId string `protobuf:"bytes,1,opt,name=SubscribedId,proto3" json:"SubscribedId,omitempty"`
-	SubscriberId string `protobuf:"bytes,2,opt,name=SubscriberId,proto3" json:"SubscriberId,omitempty"`
+	ID     string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Limit  int32  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
 }
 
-func (x *SubscribeRequest) Reset() {
-	*x = SubscribeRequest{}
+func (x *UsersRequest) Reset() {
+	*x = UsersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,13 +311,13 @@ func (x *SubscribeRequest) Reset() {
 	}
 }
 
-func (x *SubscribeRequest) String() string {
+func (x *UsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeRequest) ProtoMessage() {}
+func (*UsersRequest) ProtoMessage() {}
 
-func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *UsersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -304,21 +329,99 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UsersRequest.ProtoReflect.Descriptor instead.
+func (*UsersRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SubscribeRequest) GetSubscribedId() string {
+func (x *UsersRequest) GetID() string {
 	if x != nil {
-		return x.SubscribedId
+		return x.ID
 	}
 	return ""
 }
 
-func (x *SubscribeRequest) GetSubscriberId() string {
+func (x *UsersRequest) GetLimit() int32 {
 	if x != nil {
-		return x.SubscriberId
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *UsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type AttendeesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=EventId,proto3" json:"EventId,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	Limit   int32  `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Cursor  string `protobuf:"bytes,4,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+}
+
+func (x *AttendeesRequest) Reset() {
+	*x = AttendeesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttendeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendeesRequest) ProtoMessage() {}
+
+func (x *AttendeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendeesRequest.ProtoReflect.Descriptor instead.
+func (*AttendeesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AttendeesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AttendeesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AttendeesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AttendeesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
 	return ""
 }
@@ -334,7 +437,7 @@ type EventId struct {
 func (x *EventId) Reset() {
 	*x = EventId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[5]
+		mi := &file_user_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -347,7 +450,7 @@ func (x *EventId) String() string {
 func (*EventId) ProtoMessage() {}
 
 func (x *EventId) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[5]
+	mi := &file_user_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +463,7 @@ func (x *EventId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventId.ProtoReflect.Descriptor instead.
 func (*EventId) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{5}
+	return file_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EventId) GetID() string {
@@ -370,6 +473,61 @@ func (x *EventId) GetID() string {
 	return ""
 }
 
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscribedId string `protobuf:"bytes,1,opt,name=SubscribedId,proto3" json:"SubscribedId,omitempty"`
+	SubscriberId string `protobuf:"bytes,2,opt,name=SubscriberId,proto3" json:"SubscriberId,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SubscribeRequest) GetSubscribedId() string {
+	if x != nil {
+		return x.SubscribedId
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetSubscriberId() string {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return ""
+}
+
 type IsSubscribedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -381,7 +539,7 @@ type IsSubscribedRequest struct {
 func (x *IsSubscribedRequest) Reset() {
 	*x = IsSubscribedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[6]
+		mi := &file_user_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -394,7 +552,7 @@ func (x *IsSubscribedRequest) String() string {
 func (*IsSubscribedRequest) ProtoMessage() {}
 
 func (x *IsSubscribedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[6]
+	mi := &file_user_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +565,7 @@ func (x *IsSubscribedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsSubscribedRequest.ProtoReflect.Descriptor instead.
 func (*IsSubscribedRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{6}
+	return file_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *IsSubscribedRequest) GetResult() bool {
@@ -417,6 +575,187 @@ func (x *IsSubscribedRequest) GetResult() bool {
 	return false
 }
 
+type EmailChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	Mail   string `protobuf:"bytes,2,opt,name=Mail,proto3" json:"Mail,omitempty"`
+}
+
+func (x *EmailChangeRequest) Reset() {
+	*x = EmailChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailChangeRequest) ProtoMessage() {}
+
+func (x *EmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*EmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EmailChangeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmailChangeRequest) GetMail() string {
+	if x != nil {
+		return x.Mail
+	}
+	return ""
+}
+
+type EmailChangeToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *EmailChangeToken) Reset() {
+	*x = EmailChangeToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmailChangeToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailChangeToken) ProtoMessage() {}
+
+func (x *EmailChangeToken) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailChangeToken.ProtoReflect.Descriptor instead.
+func (*EmailChangeToken) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EmailChangeToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type EmailChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	OldMail     string `protobuf:"bytes,2,opt,name=OldMail,proto3" json:"OldMail,omitempty"`
+	NewMail     string `protobuf:"bytes,3,opt,name=NewMail,proto3" json:"NewMail,omitempty"`
+	Token       string `protobuf:"bytes,4,opt,name=Token,proto3" json:"Token,omitempty"`
+	CancelToken string `protobuf:"bytes,5,opt,name=CancelToken,proto3" json:"CancelToken,omitempty"`
+}
+
+func (x *EmailChange) Reset() {
+	*x = EmailChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmailChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailChange) ProtoMessage() {}
+
+func (x *EmailChange) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailChange.ProtoReflect.Descriptor instead.
+func (*EmailChange) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EmailChange) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmailChange) GetOldMail() string {
+	if x != nil {
+		return x.OldMail
+	}
+	return ""
+}
+
+func (x *EmailChange) GetNewMail() string {
+	if x != nil {
+		return x.NewMail
+	}
+	return ""
+}
+
+func (x *EmailChange) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *EmailChange) GetCancelToken() string {
+	if x != nil {
+		return x.CancelToken
+	}
+	return ""
+}
+
 type Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -426,7 +765,7 @@ type Empty struct {
 func (x *Empty) Reset() {
 	*x = Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[7]
+		mi := &file_user_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -439,7 +778,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[7]
+	mi := &file_user_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +791,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{7}
+	return file_user_proto_rawDescGZIP(), []int{12}
 }
 
 var File_user_proto protoreflect.FileDescriptor
@@ -465,7 +804,7 @@ var file_user_proto_rawDesc = []byte{
 	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
 	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a,
 	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x04, 0x55, 0x73,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x04, 0x55, 0x73,
 	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x72, 0x6e, 0x61, 0x6d,
@@ -475,57 +814,107 @@ var file_user_proto_rawDesc = []byte{
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
 	0x12, 0x14, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x22, 0x2d,
-	0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70,
-	0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x5a, 0x0a,
-	0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x64, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x07, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x13, 0x49, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xab, 0x04, 0x0a,
-	0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x10, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x0e, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x00, 0x12, 0x33,
-	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x35, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x0f, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x33,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x11, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x3c, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1a,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a,
-	0x0c, 0x49, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x1a, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x47, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x2f, 0x75,
-	0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x6d, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x4d, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x4c, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x72, 0x0a,
+	0x10, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x19, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x5a, 0x0a, 0x10,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x64, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x13, 0x49, 0x73, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4d, 0x61, 0x69, 0x6c, 0x22, 0x28, 0x0a, 0x10, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4f,
+	0x6c, 0x64, 0x4d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x6c,
+	0x64, 0x4d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x69, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x32, 0xa3, 0x06, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x31, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x10,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x1a, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x22, 0x00, 0x12, 0x33, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x73, 0x12, 0x1a,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0b, 0x55, 0x6e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x49, 0x73, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x49,
+	0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x15, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x47,
+	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -540,39 +929,50 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_user_proto_goTypes = []interface{}{
 	(*UserId)(nil),                    // 0: userGrpc.UserId
 	(*UpdateUserPasswordRequest)(nil), // 1: userGrpc.UpdateUserPasswordRequest
 	(*User)(nil),                      // 2: userGrpc.User
 	(*Users)(nil),                     // 3: userGrpc.Users
-	(*SubscribeRequest)(nil),          // 4: userGrpc.SubscribeRequest
-	(*EventId)(nil),                   // 5: userGrpc.EventId
-	(*IsSubscribedRequest)(nil),       // 6: userGrpc.IsSubscribedRequest
-	(*Empty)(nil),                     // 7: userGrpc.Empty
+	(*UsersRequest)(nil),              // 4: userGrpc.UsersRequest
+	(*AttendeesRequest)(nil),          // 5: userGrpc.AttendeesRequest
+	(*EventId)(nil),                   // 6: userGrpc.EventId
+	(*SubscribeRequest)(nil),          // 7: userGrpc.SubscribeRequest
+	(*IsSubscribedRequest)(nil),       // 8: userGrpc.IsSubscribedRequest
+	(*EmailChangeRequest)(nil),        // 9: userGrpc.EmailChangeRequest
+	(*EmailChangeToken)(nil),          // 10: userGrpc.EmailChangeToken
+	(*EmailChange)(nil),               // 11: userGrpc.EmailChange
+	(*Empty)(nil),                     // 12: userGrpc.Empty
 }
 var file_user_proto_depIdxs = []int32{
 	2,  // 0: userGrpc.Users.users:type_name -> userGrpc.User
 	0,  // 1: userGrpc.Repository.GetUserById:input_type -> userGrpc.UserId
 	2,  // 2: userGrpc.Repository.UpdateUserInfo:input_type -> userGrpc.User
 	1,  // 3: userGrpc.Repository.UpdateUserPassword:input_type -> userGrpc.UpdateUserPasswordRequest
-	0,  // 4: userGrpc.Repository.GetSubscribers:input_type -> userGrpc.UserId
-	0,  // 5: userGrpc.Repository.GetSubscribes:input_type -> userGrpc.UserId
-	5,  // 6: userGrpc.Repository.GetVisitors:input_type -> userGrpc.EventId
-	4,  // 7: userGrpc.Repository.Subscribe:input_type -> userGrpc.SubscribeRequest
-	4,  // 8: userGrpc.Repository.Unsubscribe:input_type -> userGrpc.SubscribeRequest
-	4,  // 9: userGrpc.Repository.IsSubscribed:input_type -> userGrpc.SubscribeRequest
-	2,  // 10: userGrpc.Repository.GetUserById:output_type -> userGrpc.User
-	7,  // 11: userGrpc.Repository.UpdateUserInfo:output_type -> userGrpc.Empty
-	7,  // 12: userGrpc.Repository.UpdateUserPassword:output_type -> userGrpc.Empty
-	3,  // 13: userGrpc.Repository.GetSubscribers:output_type -> userGrpc.Users
-	3,  // 14: userGrpc.Repository.GetSubscribes:output_type -> userGrpc.Users
-	3,  // 15: userGrpc.Repository.GetVisitors:output_type -> userGrpc.Users
-	7,  // 16: userGrpc.Repository.Subscribe:output_type -> userGrpc.Empty
-	7,  // 17: userGrpc.Repository.Unsubscribe:output_type -> userGrpc.Empty
-	6,  // 18: userGrpc.Repository.IsSubscribed:output_type -> userGrpc.IsSubscribedRequest
-	10, // [10:19] is the sub-list for method output_type
-	1,  // [1:10] is the sub-list for method input_type
+	4,  // 4: userGrpc.Repository.GetSubscribers:input_type -> userGrpc.UsersRequest
+	4,  // 5: userGrpc.Repository.GetSubscribes:input_type -> userGrpc.UsersRequest
+	5,  // 6: userGrpc.Repository.GetAttendees:input_type -> userGrpc.AttendeesRequest
+	7,  // 7: userGrpc.Repository.Subscribe:input_type -> userGrpc.SubscribeRequest
+	7,  // 8: userGrpc.Repository.Unsubscribe:input_type -> userGrpc.SubscribeRequest
+	7,  // 9: userGrpc.Repository.IsSubscribed:input_type -> userGrpc.SubscribeRequest
+	9,  // 10: userGrpc.Repository.RequestEmailChange:input_type -> userGrpc.EmailChangeRequest
+	10, // 11: userGrpc.Repository.ConfirmEmailChange:input_type -> userGrpc.EmailChangeToken
+	10, // 12: userGrpc.Repository.CancelEmailChange:input_type -> userGrpc.EmailChangeToken
+	2,  // 13: userGrpc.Repository.GetUserById:output_type -> userGrpc.User
+	12, // 14: userGrpc.Repository.UpdateUserInfo:output_type -> userGrpc.Empty
+	12, // 15: userGrpc.Repository.UpdateUserPassword:output_type -> userGrpc.Empty
+	3,  // 16: userGrpc.Repository.GetSubscribers:output_type -> userGrpc.Users
+	3,  // 17: userGrpc.Repository.GetSubscribes:output_type -> userGrpc.Users
+	3,  // 18: userGrpc.Repository.GetAttendees:output_type -> userGrpc.Users
+	12, // 19: userGrpc.Repository.Subscribe:output_type -> userGrpc.Empty
+	12, // 20: userGrpc.Repository.Unsubscribe:output_type -> userGrpc.Empty
+	8,  // 21: userGrpc.Repository.IsSubscribed:output_type -> userGrpc.IsSubscribedRequest
+	11, // 22: userGrpc.Repository.RequestEmailChange:output_type -> userGrpc.EmailChange
+	11, // 23: userGrpc.Repository.ConfirmEmailChange:output_type -> userGrpc.EmailChange
+	11, // 24: userGrpc.Repository.CancelEmailChange:output_type -> userGrpc.EmailChange
+	13, // [13:25] is the sub-list for method output_type
+	1,  // [1:13] is the sub-list for method input_type
 	1,  // [1:1] is the sub-list for extension type_name
 	1,  // [1:1] is the sub-list for extension extendee
 	0,  // [0:1] is the sub-list for field type_name
@@ -633,7 +1033,7 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
+			switch v := v.(*UsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -645,7 +1045,7 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventId); i {
+			switch v := v.(*AttendeesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -657,7 +1057,7 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsSubscribedRequest); i {
+			switch v := v.(*EventId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -669,6 +1069,66 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsSubscribedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailChangeToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -687,7 +1147,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_user_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -716,12 +1176,15 @@ type RepositoryClient interface {
 	GetUserById(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*User, error)
 	UpdateUserInfo(ctx context.Context, in *User, opts ...grpc.CallOption) (*Empty, error)
 	UpdateUserPassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*Empty, error)
-	GetSubscribers(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Users, error)
-	GetSubscribes(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Users, error)
-	GetVisitors(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Users, error)
+	GetSubscribers(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*Users, error)
+	GetSubscribes(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*Users, error)
+	GetAttendees(ctx context.Context, in *AttendeesRequest, opts ...grpc.CallOption) (*Users, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*Empty, error)
 	Unsubscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*Empty, error)
 	IsSubscribed(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*IsSubscribedRequest, error)
+	RequestEmailChange(ctx context.Context, in *EmailChangeRequest, opts ...grpc.CallOption) (*EmailChange, error)
+	ConfirmEmailChange(ctx context.Context, in *EmailChangeToken, opts ...grpc.CallOption) (*EmailChange, error)
+	CancelEmailChange(ctx context.Context, in *EmailChangeToken, opts ...grpc.CallOption) (*EmailChange, error)
 }
 
 type repositoryClient struct {
@@ -759,7 +1222,7 @@ func (c *repositoryClient) UpdateUserPassword(ctx context.Context, in *UpdateUse
 	return out, nil
 }
 
-func (c *repositoryClient) GetSubscribers(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Users, error) {
+func (c *repositoryClient) GetSubscribers(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*Users, error) {
 	out := new(Users)
 	err := c.cc.Invoke(ctx, "/userGrpc.Repository/GetSubscribers", in, out, opts...)
 	if err != nil {
@@ -768,7 +1231,7 @@ func (c *repositoryClient) GetSubscribers(ctx context.Context, in *UserId, opts
 	return out, nil
 }
 
-func (c *repositoryClient) GetSubscribes(ctx context.Context, in *UserId, opts ...grpc.CallOption) (*Users, error) {
+func (c *repositoryClient) GetSubscribes(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*Users, error) {
 	out := new(Users)
 	err := c.cc.Invoke(ctx, "/userGrpc.Repository/GetSubscribes", in, out, opts...)
 	if err != nil {
@@ -777,9 +1240,9 @@ func (c *repositoryClient) GetSubscribes(ctx context.Context, in *UserId, opts .
 	return out, nil
 }
 
-func (c *repositoryClient) GetVisitors(ctx context.Context, in *EventId, opts ...grpc.CallOption) (*Users, error) {
+func (c *repositoryClient) GetAttendees(ctx context.Context, in *AttendeesRequest, opts ...grpc.CallOption) (*Users, error) {
 	out := new(Users)
-	err := c.cc.Invoke(ctx, "/userGrpc.Repository/GetVisitors", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/userGrpc.Repository/GetAttendees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -813,17 +1276,47 @@ func (c *repositoryClient) IsSubscribed(ctx context.Context, in *SubscribeReques
 	return out, nil
 }
 
+func (c *repositoryClient) RequestEmailChange(ctx context.Context, in *EmailChangeRequest, opts ...grpc.CallOption) (*EmailChange, error) {
+	out := new(EmailChange)
+	err := c.cc.Invoke(ctx, "/userGrpc.Repository/RequestEmailChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ConfirmEmailChange(ctx context.Context, in *EmailChangeToken, opts ...grpc.CallOption) (*EmailChange, error) {
+	out := new(EmailChange)
+	err := c.cc.Invoke(ctx, "/userGrpc.Repository/ConfirmEmailChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) CancelEmailChange(ctx context.Context, in *EmailChangeToken, opts ...grpc.CallOption) (*EmailChange, error) {
+	out := new(EmailChange)
+	err := c.cc.Invoke(ctx, "/userGrpc.Repository/CancelEmailChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepositoryServer is the server API for Repository service.
 type RepositoryServer interface {
 	GetUserById(context.Context, *UserId) (*User, error)
 	UpdateUserInfo(context.Context, *User) (*Empty, error)
 	UpdateUserPassword(context.Context, *UpdateUserPasswordRequest) (*Empty, error)
-	GetSubscribers(context.Context, *UserId) (*Users, error)
-	GetSubscribes(context.Context, *UserId) (*Users, error)
-	GetVisitors(context.Context, *EventId) (*Users, error)
+	GetSubscribers(context.Context, *UsersRequest) (*Users, error)
+	GetSubscribes(context.Context, *UsersRequest) (*Users, error)
+	GetAttendees(context.Context, *AttendeesRequest) (*Users, error)
 	Subscribe(context.Context, *SubscribeRequest) (*Empty, error)
 	Unsubscribe(context.Context, *SubscribeRequest) (*Empty, error)
 	IsSubscribed(context.Context, *SubscribeRequest) (*IsSubscribedRequest, error)
+	RequestEmailChange(context.Context, *EmailChangeRequest) (*EmailChange, error)
+	ConfirmEmailChange(context.Context, *EmailChangeToken) (*EmailChange, error)
+	CancelEmailChange(context.Context, *EmailChangeToken) (*EmailChange, error)
 }
 
 // UnimplementedRepositoryServer can be embedded to have forward compatible implementations.
@@ -839,14 +1332,14 @@ func (*UnimplementedRepositoryServer) UpdateUserInfo(context.Context, *User) (*E
 func (*UnimplementedRepositoryServer) UpdateUserPassword(context.Context, *UpdateUserPasswordRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
 }
-func (*UnimplementedRepositoryServer) GetSubscribers(context.Context, *UserId) (*Users, error) {
+func (*UnimplementedRepositoryServer) GetSubscribers(context.Context, *UsersRequest) (*Users, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubscribers not implemented")
 }
-func (*UnimplementedRepositoryServer) GetSubscribes(context.Context, *UserId) (*Users, error) {
+func (*UnimplementedRepositoryServer) GetSubscribes(context.Context, *UsersRequest) (*Users, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubscribes not implemented")
 }
-func (*UnimplementedRepositoryServer) GetVisitors(context.Context, *EventId) (*Users, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVisitors not implemented")
+func (*UnimplementedRepositoryServer) GetAttendees(context.Context, *AttendeesRequest) (*Users, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendees not implemented")
 }
 func (*UnimplementedRepositoryServer) Subscribe(context.Context, *SubscribeRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
@@ -857,6 +1350,15 @@ func (*UnimplementedRepositoryServer) Unsubscribe(context.Context, *SubscribeReq
 func (*UnimplementedRepositoryServer) IsSubscribed(context.Context, *SubscribeRequest) (*IsSubscribedRequest, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsSubscribed not implemented")
 }
+func (*UnimplementedRepositoryServer) RequestEmailChange(context.Context, *EmailChangeRequest) (*EmailChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailChange not implemented")
+}
+func (*UnimplementedRepositoryServer) ConfirmEmailChange(context.Context, *EmailChangeToken) (*EmailChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailChange not implemented")
+}
+func (*UnimplementedRepositoryServer) CancelEmailChange(context.Context, *EmailChangeToken) (*EmailChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEmailChange not implemented")
+}
 
 func RegisterRepositoryServer(s *grpc.Server, srv RepositoryServer) {
 	s.RegisterService(&_Repository_serviceDesc, srv)
@@ -917,7 +1419,7 @@ func _Repository_UpdateUserPassword_Handler(srv interface{}, ctx context.Context
 }
 
 func _Repository_GetSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserId)
+	in := new(UsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -929,13 +1431,13 @@ func _Repository_GetSubscribers_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: "/userGrpc.Repository/GetSubscribers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetSubscribers(ctx, req.(*UserId))
+		return srv.(RepositoryServer).GetSubscribers(ctx, req.(*UsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Repository_GetSubscribes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserId)
+	in := new(UsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -947,25 +1449,25 @@ func _Repository_GetSubscribes_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: "/userGrpc.Repository/GetSubscribes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetSubscribes(ctx, req.(*UserId))
+		return srv.(RepositoryServer).GetSubscribes(ctx, req.(*UsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_GetVisitors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventId)
+func _Repository_GetAttendees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttendeesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).GetVisitors(ctx, in)
+		return srv.(RepositoryServer).GetAttendees(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/userGrpc.Repository/GetVisitors",
+		FullMethod: "/userGrpc.Repository/GetAttendees",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).GetVisitors(ctx, req.(*EventId))
+		return srv.(RepositoryServer).GetAttendees(ctx, req.(*AttendeesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1024,6 +1526,60 @@ func _Repository_IsSubscribed_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Repository_RequestEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).RequestEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/userGrpc.Repository/RequestEmailChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).RequestEmailChange(ctx, req.(*EmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailChangeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/userGrpc.Repository/ConfirmEmailChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).ConfirmEmailChange(ctx, req.(*EmailChangeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_CancelEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailChangeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).CancelEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/userGrpc.Repository/CancelEmailChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).CancelEmailChange(ctx, req.(*EmailChangeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Repository_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "userGrpc.Repository",
 	HandlerType: (*RepositoryServer)(nil),
@@ -1049,8 +1605,8 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Repository_GetSubscribes_Handler,
 		},
 		{
-			MethodName: "GetVisitors",
-			Handler:    _Repository_GetVisitors_Handler,
+			MethodName: "GetAttendees",
+			Handler:    _Repository_GetAttendees_Handler,
 		},
 		{
 			MethodName: "Subscribe",
@@ -1064,6 +1620,18 @@ var _Repository_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsSubscribed",
 			Handler:    _Repository_IsSubscribed_Handler,
 		},
+		{
+			MethodName: "RequestEmailChange",
+			Handler:    _Repository_RequestEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _Repository_ConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "CancelEmailChange",
+			Handler:    _Repository_CancelEmailChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
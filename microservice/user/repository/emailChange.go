@@ -0,0 +1,271 @@
+package repository
+
+import (
+	proto "backend/microservice/user/proto"
+	log "backend/pkg/logger"
+	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/user/error"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	sql2 "database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	sql "github.com/jmoiron/sqlx"
+	"strconv"
+	"strings"
+)
+
+//Подтвердить смену можно в течение суток, отменить (в том числе откатить
+//уже подтверждённую) - в течение недели
+const (
+	emailChangeTokenLength = 32
+
+	getMailQuery                  = `select mail from "user" where id = $1`
+	mailExistsQuery               = `select count(*) from "user" where mail = $1`
+	deletePendingEmailChangeQuery = `delete from "email_change" where user_id = $1 and confirmed_at is null`
+	createEmailChangeQuery        = `insert into "email_change" (user_id, old_mail, new_mail, token_hash, cancel_token_hash, expires_at) values ($1, $2, $3, $4, $5, now() + interval '1 day')`
+	getEmailChangeQuery           = `select id, user_id, old_mail, new_mail from "email_change" where token_hash = $1 and confirmed_at is null and expires_at > now() for update`
+	getCancelEmailChangeQuery     = `select id, user_id, old_mail, new_mail, confirmed_at is not null as confirmed from "email_change" where cancel_token_hash = $1 and created_at > now() - interval '7 days' for update`
+	updateMailQuery               = `update "user" set mail = $1, email_verified = true where id = $2 and mail = $3`
+	confirmEmailChangeQuery       = `update "email_change" set confirmed_at = now() where id = $1`
+	deleteEmailChangeQuery        = `delete from "email_change" where id = $1`
+)
+
+type EmailChange struct {
+	ID        int    `db:"id"`
+	UserId    int    `db:"user_id"`
+	OldMail   string `db:"old_mail"`
+	NewMail   string `db:"new_mail"`
+	Confirmed bool   `db:"confirmed"`
+}
+
+func newEmailChangeToken() (string, string, error) {
+	b := make([]byte, emailChangeTokenLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashEmailChangeToken(token), nil
+}
+
+func hashEmailChangeToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func isUniqueViolation(err error) bool {
+	return strings.Contains(err.Error(), "duplicate key value violates")
+}
+
+//Почта меняется только после перехода по ссылке с новой почты, поэтому здесь
+//лишь создаётся заявка. Прежняя неподтверждённая заявка заменяется новой
+func (s *Repository) RequestEmailChange(ctx context.Context, in *proto.EmailChangeRequest) (*proto.EmailChange, error) {
+	message := logMessage + "RequestEmailChange:"
+	log.Debug(message + "started")
+	if in.UserId == "" || in.Mail == "" {
+		return &proto.EmailChange{}, error2.ErrEmptyData
+	}
+	userIdInt, err := strconv.Atoi(in.UserId)
+	if err != nil {
+		return &proto.EmailChange{}, error2.ErrAtoi
+	}
+	token, tokenHash, err := newEmailChangeToken()
+	if err != nil {
+		return &proto.EmailChange{}, err
+	}
+	cancelToken, cancelTokenHash, err := newEmailChangeToken()
+	if err != nil {
+		return &proto.EmailChange{}, err
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	var oldMail string
+	err = tx.Get(&oldMail, getMailQuery, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		if err == sql2.ErrNoRows {
+			return &proto.EmailChange{}, error2.ErrUserNotFound
+		}
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	if oldMail == in.Mail {
+		return &proto.EmailChange{}, error2.ErrSameMail
+	}
+	var count int
+	err = tx.Get(&count, mailExistsQuery, in.Mail)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	if count > 0 {
+		return &proto.EmailChange{}, error2.ErrMailExists
+	}
+	_, err = tx.Exec(deletePendingEmailChangeQuery, userIdInt)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	_, err = tx.Exec(createEmailChangeQuery, userIdInt, oldMail, in.Mail, tokenHash, cancelTokenHash)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return &proto.EmailChange{
+		UserId:      in.UserId,
+		OldMail:     oldMail,
+		NewMail:     in.Mail,
+		Token:       token,
+		CancelToken: cancelToken,
+	}, nil
+}
+
+//Почту могли занять, пока заявка ждала подтверждения - тогда unique-ограничение
+//на mail отклонит смену и вернётся ErrMailExists
+func (s *Repository) ConfirmEmailChange(ctx context.Context, in *proto.EmailChangeToken) (*proto.EmailChange, error) {
+	message := logMessage + "ConfirmEmailChange:"
+	log.Debug(message + "started")
+	if in.Token == "" {
+		return &proto.EmailChange{}, error2.ErrEmptyData
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	change := EmailChange{}
+	err = tx.Get(&change, getEmailChangeQuery, hashEmailChangeToken(in.Token))
+	if err != nil {
+		log.Error(message+"err =", err)
+		if err == sql2.ErrNoRows {
+			return &proto.EmailChange{}, error2.ErrInvalidToken
+		}
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	err = updateMail(tx, change.UserId, change.OldMail, change.NewMail)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, err
+	}
+	_, err = tx.Exec(confirmEmailChangeQuery, change.ID)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	err = recordEmailChangeEvent(ctx, tx, &change, models.AuthEventEmailChange)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return toProtoEmailChange(&change), nil
+}
+
+//Неподтверждённая заявка просто удаляется, подтверждённая - откатывается на старую почту
+func (s *Repository) CancelEmailChange(ctx context.Context, in *proto.EmailChangeToken) (*proto.EmailChange, error) {
+	message := logMessage + "CancelEmailChange:"
+	log.Debug(message + "started")
+	if in.Token == "" {
+		return &proto.EmailChange{}, error2.ErrEmptyData
+	}
+	tx, err := s.db.Beginx()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	defer tx.Rollback()
+	change := EmailChange{}
+	err = tx.Get(&change, getCancelEmailChangeQuery, hashEmailChangeToken(in.Token))
+	if err != nil {
+		log.Error(message+"err =", err)
+		if err == sql2.ErrNoRows {
+			return &proto.EmailChange{}, error2.ErrInvalidToken
+		}
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	if change.Confirmed {
+		err = updateMail(tx, change.UserId, change.NewMail, change.OldMail)
+		if err != nil {
+			log.Error(message+"err =", err)
+			return &proto.EmailChange{}, err
+		}
+	}
+	_, err = tx.Exec(deleteEmailChangeQuery, change.ID)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	//Отмену неподтверждённой заявки не пишем - почта не менялась
+	if change.Confirmed {
+		err = recordEmailChangeEvent(ctx, tx, &change, models.AuthEventEmailChangeCancel)
+		if err != nil {
+			log.Error(message+"err =", err)
+			return &proto.EmailChange{}, error2.ErrPostgres
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		log.Error(message+"err =", err)
+		return &proto.EmailChange{}, error2.ErrPostgres
+	}
+	log.Debug(message + "ended")
+	return toProtoEmailChange(&change), nil
+}
+
+//Меняет почту с from на to. Если почта уже не from (сменилась другой заявкой) - токен
+//считаем недействительным
+func updateMail(tx *sql.Tx, userId int, from string, to string) error {
+	res, err := tx.Exec(updateMailQuery, to, userId, from)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return error2.ErrMailExists
+		}
+		return error2.ErrPostgres
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return error2.ErrPostgres
+	}
+	if affected == 0 {
+		return error2.ErrInvalidToken
+	}
+	return nil
+}
+
+//Смена почты пишется в журнал безопасности в той же транзакции, что и сама смена
+func recordEmailChangeEvent(ctx context.Context, tx *sql.Tx, change *EmailChange, eventType string) error {
+	ip, userAgent := utils.ClientFromContext(ctx)
+	return utils.RecordAuthEvent(tx, &models.AuthEvent{
+		UserId:    strconv.Itoa(change.UserId),
+		Type:      eventType,
+		Mail:      change.NewMail,
+		IP:        ip,
+		UserAgent: userAgent,
+	})
+}
+
+func toProtoEmailChange(change *EmailChange) *proto.EmailChange {
+	return &proto.EmailChange{
+		UserId:  strconv.Itoa(change.UserId),
+		OldMail: change.OldMail,
+		NewMail: change.NewMail,
+	}
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	userGrpc "backend/microservice/user/proto"
+	"backend/pkg/models"
+	error3 "backend/service/user/error"
+	"context"
+	sql2 "database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRequestEmailChange(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	var tokenHash, cancelTokenHash string
+	mock.ExpectBegin()
+	mock.ExpectQuery(getMailQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"mail"}).AddRow("old@mail.ru"))
+	mock.ExpectQuery(mailExistsQuery).WithArgs("new@mail.ru").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec(deletePendingEmailChangeQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(createEmailChangeQuery).
+		WithArgs(1, "old@mail.ru", "new@mail.ru", hashArg{&tokenHash}, hashArg{&cancelTokenHash}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	in := &userGrpc.EmailChangeRequest{UserId: "1", Mail: "new@mail.ru"}
+	out, err := repositoryTest.RequestEmailChange(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, "old@mail.ru", out.OldMail)
+	//В базе только хэши токенов
+	require.Equal(t, hashEmailChangeToken(out.Token), tokenHash)
+	require.Equal(t, hashEmailChangeToken(out.CancelToken), cancelTokenHash)
+	require.NotEqual(t, out.Token, out.CancelToken)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(getMailQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"mail"}).AddRow("old@mail.ru"))
+	mock.ExpectQuery(mailExistsQuery).WithArgs("new@mail.ru").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+	_, err = repositoryTest.RequestEmailChange(context.Background(), in)
+	require.Equal(t, error3.ErrMailExists, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(getMailQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"mail"}).AddRow("new@mail.ru"))
+	mock.ExpectRollback()
+	_, err = repositoryTest.RequestEmailChange(context.Background(), in)
+	require.Equal(t, error3.ErrSameMail, err)
+
+	_, err = repositoryTest.RequestEmailChange(context.Background(), &userGrpc.EmailChangeRequest{UserId: "a", Mail: "new@mail.ru"})
+	require.Equal(t, error3.ErrAtoi, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+//hashArg запоминает переданный в запрос хэш токена
+type hashArg struct {
+	value *string
+}
+
+func (a hashArg) Match(v driver.Value) bool {
+	hash, ok := v.(string)
+	*a.value = hash
+	return ok && len(hash) == 64
+}
+
+var emailChangeColumns = []string{"id", "user_id", "old_mail", "new_mail"}
+
+var confirmEmailChangeTests = []struct {
+	id        int
+	getErr    error
+	updateErr error
+	updated   int64
+	outputErr error
+}{
+	{1, nil, nil, 1, nil},
+	{2, sql2.ErrNoRows, nil, 0, error3.ErrInvalidToken},
+	{3, nil, errors.New(`pq: duplicate key value violates unique constraint "user_mail_key"`), 0, error3.ErrMailExists},
+	{4, nil, nil, 0, error3.ErrInvalidToken},
+}
+
+func TestConfirmEmailChange(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	for _, test := range confirmEmailChangeTests {
+		mock.ExpectBegin()
+		get := mock.ExpectQuery(getEmailChangeQuery).WithArgs(hashEmailChangeToken("token"))
+		if test.getErr != nil {
+			get.WillReturnError(test.getErr)
+		} else {
+			get.WillReturnRows(sqlmock.NewRows(emailChangeColumns).AddRow(5, 1, "old@mail.ru", "new@mail.ru"))
+			update := mock.ExpectExec(updateMailQuery).WithArgs("new@mail.ru", 1, "old@mail.ru")
+			if test.updateErr != nil {
+				update.WillReturnError(test.updateErr)
+			} else {
+				update.WillReturnResult(sqlmock.NewResult(0, test.updated))
+			}
+		}
+		if test.outputErr == nil {
+			mock.ExpectExec(confirmEmailChangeQuery).WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(insertAuthEventQuery).
+				WithArgs(sql2.NullInt64{Int64: 1, Valid: true}, models.AuthEventEmailChange, "new@mail.ru", "", "").
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		} else {
+			mock.ExpectRollback()
+		}
+		out, err := repositoryTest.ConfirmEmailChange(context.Background(), &userGrpc.EmailChangeToken{Token: "token"})
+		require.Equal(t, test.outputErr, err, test.id)
+		if test.outputErr == nil {
+			require.Equal(t, "1", out.UserId, test.id)
+			require.Equal(t, "new@mail.ru", out.NewMail, test.id)
+		}
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelEmailChange(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+	columns := append(emailChangeColumns, "confirmed")
+	in := &userGrpc.EmailChangeToken{Token: "cancel"}
+
+	//Заявка ещё не подтверждена - почта не меняется
+	mock.ExpectBegin()
+	mock.ExpectQuery(getCancelEmailChangeQuery).WithArgs(hashEmailChangeToken("cancel")).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(5, 1, "old@mail.ru", "new@mail.ru", false))
+	mock.ExpectExec(deleteEmailChangeQuery).WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	_, err = repositoryTest.CancelEmailChange(context.Background(), in)
+	require.NoError(t, err)
+
+	//Уже подтверждена - возвращаем старую почту
+	mock.ExpectBegin()
+	mock.ExpectQuery(getCancelEmailChangeQuery).WithArgs(hashEmailChangeToken("cancel")).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(5, 1, "old@mail.ru", "new@mail.ru", true))
+	mock.ExpectExec(updateMailQuery).WithArgs("old@mail.ru", 1, "new@mail.ru").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteEmailChangeQuery).WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insertAuthEventQuery).
+		WithArgs(sql2.NullInt64{Int64: 1, Valid: true}, models.AuthEventEmailChangeCancel, "new@mail.ru", "", "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	out, err := repositoryTest.CancelEmailChange(context.Background(), in)
+	require.NoError(t, err)
+	require.Equal(t, "old@mail.ru", out.OldMail)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(getCancelEmailChangeQuery).WithArgs(hashEmailChangeToken("cancel")).WillReturnError(sql2.ErrNoRows)
+	mock.ExpectRollback()
+	_, err = repositoryTest.CancelEmailChange(context.Background(), in)
+	require.Equal(t, error3.ErrInvalidToken, err)
+
+	_, err = repositoryTest.CancelEmailChange(context.Background(), &userGrpc.EmailChangeToken{})
+	require.Equal(t, error3.ErrEmptyData, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -26,17 +26,17 @@ func (m *RepositoryClientMock) UpdateUserPassword(ctx context.Context, in *userG
 	return args.Get(0).(*userGrpc.Empty), args.Error(1)
 }
 
-func (m *RepositoryClientMock) GetSubscribers(ctx context.Context, in *userGrpc.UserId, opts ...grpc.CallOption) (*userGrpc.Users, error) {
+func (m *RepositoryClientMock) GetSubscribers(ctx context.Context, in *userGrpc.UsersRequest, opts ...grpc.CallOption) (*userGrpc.Users, error) {
 	args := m.Called(ctx, in)
 	return args.Get(0).(*userGrpc.Users), args.Error(1)
 }
 
-func (m *RepositoryClientMock) GetSubscribes(ctx context.Context, in *userGrpc.UserId, opts ...grpc.CallOption) (*userGrpc.Users, error) {
+func (m *RepositoryClientMock) GetSubscribes(ctx context.Context, in *userGrpc.UsersRequest, opts ...grpc.CallOption) (*userGrpc.Users, error) {
 	args := m.Called(ctx, in)
 	return args.Get(0).(*userGrpc.Users), args.Error(1)
 }
 
-func (m *RepositoryClientMock) GetVisitors(ctx context.Context, in *userGrpc.EventId, opts ...grpc.CallOption) (*userGrpc.Users, error) {
+func (m *RepositoryClientMock) GetAttendees(ctx context.Context, in *userGrpc.AttendeesRequest, opts ...grpc.CallOption) (*userGrpc.Users, error) {
 	args := m.Called(ctx, in)
 	return args.Get(0).(*userGrpc.Users), args.Error(1)
 }
@@ -55,3 +55,18 @@ func (m *RepositoryClientMock) IsSubscribed(ctx context.Context, in *userGrpc.Su
 	args := m.Called(ctx, in)
 	return args.Get(0).(*userGrpc.IsSubscribedRequest), args.Error(1)
 }
+
+func (m *RepositoryClientMock) RequestEmailChange(ctx context.Context, in *userGrpc.EmailChangeRequest, opts ...grpc.CallOption) (*userGrpc.EmailChange, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*userGrpc.EmailChange), args.Error(1)
+}
+
+func (m *RepositoryClientMock) ConfirmEmailChange(ctx context.Context, in *userGrpc.EmailChangeToken, opts ...grpc.CallOption) (*userGrpc.EmailChange, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*userGrpc.EmailChange), args.Error(1)
+}
+
+func (m *RepositoryClientMock) CancelEmailChange(ctx context.Context, in *userGrpc.EmailChangeToken, opts ...grpc.CallOption) (*userGrpc.EmailChange, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*userGrpc.EmailChange), args.Error(1)
+}
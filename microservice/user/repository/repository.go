@@ -4,6 +4,7 @@ import (
 	proto "backend/microservice/user/proto"
 	log "backend/pkg/logger"
 	"backend/pkg/models"
+	"backend/pkg/utils"
 	error2 "backend/service/user/error"
 	"context"
 	sql2 "database/sql"
@@ -13,7 +14,10 @@ import (
 
 const (
 	logMessage                       = "microservice:user:repository:"
-	getUserByIdQuery                 = `select * from "user" where id = $1`
+	//Репутация организатора - средняя оценка по всем отзывам на его открытые мероприятия
+	getUserByIdQuery = `select u.*, r.rating_sum, r.rating_count from "user" as u,
+		lateral (select coalesce(sum(rating_sum), 0) as rating_sum, coalesce(sum(rating_count), 0) as rating_count
+		from "event" where author_id = u.id and hidden = false) as r where u.id = $1`
 	updateUserInfoQueryWithoutImgUrl = `update "user" set name = $1, surname = $2, about = $3 where id = $4`
 	updateUserInfoQuery              = `update "user" set name = $1, surname = $2, about = $3, img_url = $4 where id = $5`
 	updateUserPasswordQuery          = `update "user" set password = $1 where id = $2`
@@ -21,7 +25,7 @@ const (
 	updateUserImgUrlQuery = `update "user" set img_url = $1 where id = $2`
 	getSubscribersQuery   = `select u.* from "user" as u join subscribe s on s.subscriber_id = u.id where s.subscribed_id = $1`
 	getSubscribesQuery    = `select u.* from "user" as u join subscribe s on s.subscribed_id = u.id where s.subscriber_id = $1`
-	getVisitorsQuery      = `select u.* from "user" as u join visitor v on u.id = v.user_id where v.event_id = $1`
+	getAttendeesQuery     = `select u.* from "user" as u join rsvp r on u.id = r.user_id where r.event_id = $1 and r.status = $2`
 	subscribeQuery        = `insert into "subscribe" (subscribed_id, subscriber_id) values ($1, $2)`
 	unsubscribeQuery      = `delete from subscribe where subscribed_id = $1 and subscriber_id = $2`
 	isSubscribedQuery     = `select count(*) from subscribe where subscribed_id = $1 and subscriber_id = $2`
@@ -56,6 +60,7 @@ func (s *Repository) GetUserById(ctx context.Context, in *proto.UserId) (*proto.
 	return out, nil
 }
 
+//Почта здесь не меняется - только через RequestEmailChange и ConfirmEmailChange
 func (s *Repository) UpdateUserInfo(ctx context.Context, in *proto.User) (*proto.Empty, error) {
 	message := logMessage + "UpdateUserInfo:"
 	log.Debug(message + "started")
@@ -103,105 +108,81 @@ func (s *Repository) UpdateUserPassword(ctx context.Context, in *proto.UpdateUse
 	if err != nil {
 		return &proto.Empty{}, error2.ErrPostgres
 	}
+	ip, userAgent := utils.ClientFromContext(ctx)
+	err = utils.RecordAuthEvent(s.db, &models.AuthEvent{
+		UserId:    userId,
+		Type:      models.AuthEventPasswordChange,
+		IP:        ip,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		//Пароль уже сменён, запись в журнал не должна это отменять
+		log.Error(message+"err =", err)
+	}
 	log.Debug(message + "ended")
 	return &proto.Empty{}, nil
 }
 
-func (s *Repository) GetSubscribers(ctx context.Context, in *proto.UserId) (*proto.Users, error) {
-	message := logMessage + "GetSubscribers:"
-	log.Debug(message + "started")
-	userId := in.ID
-	userIdInt, err := strconv.Atoi(userId)
-	if err != nil {
-		return &proto.Users{}, error2.ErrAtoi
-	}
-	query := getSubscribersQuery
-	rows, err := s.db.Queryx(query, userIdInt)
-	if err != nil {
-		return &proto.Users{}, error2.ErrPostgres
-	}
-	defer rows.Close()
-	var resultUsers []*models.User
-	for rows.Next() {
-		var u User
-		err := rows.StructScan(&u)
-		if err != nil {
-			return &proto.Users{}, error2.ErrPostgres
-		}
-		modelUser := toModelUser(&u)
-		resultUsers = append(resultUsers, modelUser)
-	}
-	outUsers := make([]*proto.User, len(resultUsers))
-	for i, event := range resultUsers {
-		outUsers[i] = toProtoUser(event)
-	}
-	out := &proto.Users{Users: outUsers}
-	log.Debug(message + "ended")
-	return out, nil
+func (s *Repository) GetSubscribers(ctx context.Context, in *proto.UsersRequest) (*proto.Users, error) {
+	return s.getUsersPage(logMessage+"GetSubscribers:", getSubscribersQuery, in.ID, in.Limit, in.Cursor)
 }
 
-func (s *Repository) GetSubscribes(ctx context.Context, in *proto.UserId) (*proto.Users, error) {
-	message := logMessage + "GetSubscribes:"
+func (s *Repository) GetSubscribes(ctx context.Context, in *proto.UsersRequest) (*proto.Users, error) {
+	return s.getUsersPage(logMessage+"GetSubscribes:", getSubscribesQuery, in.ID, in.Limit, in.Cursor)
+}
+
+//Участники мероприятия по ответу RSVP, избранное (visitor) сюда не попадает
+func (s *Repository) GetAttendees(ctx context.Context, in *proto.AttendeesRequest) (*proto.Users, error) {
+	return s.getUsersPage(logMessage+"GetAttendees:", getAttendeesQuery, in.EventId, in.Limit, in.Cursor, in.Status)
+}
+
+//Списки листаются по id пользователя: запрашивается на одну запись больше limit,
+//и если она пришла, курсор указывает на последнюю запись страницы. filters - параметры
+//запроса после id ($2, $3, ...)
+func (s *Repository) getUsersPage(message string, query string, id string, limit int32, cursor string, filters ...interface{}) (*proto.Users, error) {
 	log.Debug(message + "started")
-	userId := in.ID
-	userIdInt, err := strconv.Atoi(userId)
+	idInt, err := strconv.Atoi(id)
 	if err != nil {
 		return &proto.Users{}, error2.ErrAtoi
 	}
-	query := getSubscribesQuery
-	rows, err := s.db.Queryx(query, userIdInt)
+	pageCursor, err := utils.DecodeCursor(cursor)
 	if err != nil {
-		return &proto.Users{}, error2.ErrPostgres
-	}
-	defer rows.Close()
-	var resultUsers []*models.User
-	for rows.Next() {
-		var u User
-		err := rows.StructScan(&u)
-		if err != nil {
-			return &proto.Users{}, error2.ErrPostgres
-		}
-		modelUser := toModelUser(&u)
-		resultUsers = append(resultUsers, modelUser)
+		return &proto.Users{}, err
 	}
-	outUsers := make([]*proto.User, len(resultUsers))
-	for i, event := range resultUsers {
-		outUsers[i] = toProtoUser(event)
+	pageLimit := utils.PageLimit(int(limit))
+	args := append([]interface{}{idInt}, filters...)
+	if pageCursor != nil {
+		args = append(args, pageCursor.ID)
+		query += ` and u.id < $` + strconv.Itoa(len(args))
 	}
-	out := &proto.Users{Users: outUsers}
-	log.Debug(message + "ended")
-	return out, nil
-}
-
-func (s *Repository) GetVisitors(ctx context.Context, in *proto.EventId) (*proto.Users, error) {
-	message := logMessage + "GetVisitors:"
-	log.Debug(message + "started")
-	eventId := in.ID
-	eventIdInt, err := strconv.Atoi(eventId)
-	if err != nil {
-		return &proto.Users{}, error2.ErrAtoi
-	}
-	query := getVisitorsQuery
-	rows, err := s.db.Queryx(query, eventIdInt)
+	args = append(args, pageLimit+1)
+	query += ` order by u.id DESC limit $` + strconv.Itoa(len(args))
+	rows, err := s.db.Queryx(query, args...)
 	if err != nil {
 		return &proto.Users{}, error2.ErrPostgres
 	}
 	defer rows.Close()
 	var resultUsers []*models.User
+	var lastId int
+	out := &proto.Users{}
 	for rows.Next() {
 		var u User
 		err := rows.StructScan(&u)
 		if err != nil {
 			return &proto.Users{}, error2.ErrPostgres
 		}
+		if len(resultUsers) == pageLimit {
+			out.NextCursor = utils.EncodeCursor(&utils.Cursor{ID: lastId})
+			break
+		}
+		lastId = u.ID
 		modelUser := toModelUser(&u)
 		resultUsers = append(resultUsers, modelUser)
 	}
-	outUsers := make([]*proto.User, len(resultUsers))
-	for i, event := range resultUsers {
-		outUsers[i] = toProtoUser(event)
+	out.Users = make([]*proto.User, len(resultUsers))
+	for i, user := range resultUsers {
+		out.Users[i] = toProtoUser(user)
 	}
-	out := &proto.Users{Users: outUsers}
 	log.Debug(message + "ended")
 	return out, nil
 }
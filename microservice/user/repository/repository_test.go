@@ -3,6 +3,7 @@ package repository
 import (
 	userGrpc "backend/microservice/user/proto"
 	"backend/pkg/models"
+	"backend/pkg/utils"
 	error3 "backend/service/user/error"
 	"context"
 	sql2 "database/sql"
@@ -13,15 +14,20 @@ import (
 	"testing"
 )
 
+//Запись в журнал безопасности (utils.RecordAuthEvent)
+const insertAuthEventQuery = `insert into "auth_event" (user_id, type, mail, ip, user_agent) values ($1, $2, $3, $4, $5)`
+
 func fromProtoToModel(u *userGrpc.User) *models.User {
 	return &models.User{
-		ID:       u.ID,
-		Name:     u.Name,
-		Surname:  u.Surname,
-		Mail:     u.Mail,
-		Password: u.Password,
-		About:    u.About,
-		ImgUrl:   u.ImgUrl,
+		ID:          u.ID,
+		Name:        u.Name,
+		Surname:     u.Surname,
+		Mail:        u.Mail,
+		Password:    u.Password,
+		About:       u.About,
+		ImgUrl:      u.ImgUrl,
+		Reputation:  u.Reputation,
+		ReviewCount: int(u.ReviewCount),
 	}
 }
 
@@ -87,6 +93,28 @@ func TestGetUserById(t *testing.T) {
 	}
 }
 
+func TestGetUserReputation(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	repositoryTest := NewRepository(sqlx.NewDb(db, "sqlmock"))
+
+	mock.ExpectQuery(getUserByIdQuery).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "rating_sum", "rating_count"}).AddRow(1, "testName", 13, 3))
+	out, err := repositoryTest.GetUserById(context.Background(), &userGrpc.UserId{ID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, 4.33, out.Reputation)
+	require.Equal(t, int32(3), out.ReviewCount)
+
+	//Без отзывов репутации нет
+	mock.ExpectQuery(getUserByIdQuery).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "rating_sum", "rating_count"}).AddRow(1, "testName", 0, 0))
+	out, err = repositoryTest.GetUserById(context.Background(), &userGrpc.UserId{ID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, 0.0, out.Reputation)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 var updateUserInfoTests = []struct {
 	id          int
 	userId      string
@@ -229,6 +257,11 @@ func TestUpdateUserPassword(t *testing.T) {
 			WithArgs(test.password, userIdInt).
 			WillReturnRows(sqlmock.NewRows([]string{})).
 			WillReturnError(test.postgresErr)
+		if test.outputErr == nil {
+			mock.ExpectExec(insertAuthEventQuery).
+				WithArgs(sql2.NullInt64{Int64: int64(userIdInt), Valid: true}, models.AuthEventPasswordChange, "", "", "").
+				WillReturnResult(sqlmock.NewResult(1, 1))
+		}
 
 		in := &userGrpc.UpdateUserPasswordRequest{
 			ID:       test.userId,
@@ -286,12 +319,12 @@ func TestGetSubscribers(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
-		mock.ExpectQuery(getSubscribersQuery).
-			WithArgs(userIdInt).
+		mock.ExpectQuery(getSubscribersQuery+" order by u.id DESC limit $2").
+			WithArgs(userIdInt, utils.DefaultPageLimit+1).
 			WillReturnRows(rows).
 			WillReturnError(test.postgresErr)
 
-		in := &userGrpc.UserId{
+		in := &userGrpc.UsersRequest{
 			ID: test.userId,
 		}
 		out, actualErr := repositoryTest.GetSubscribers(context.Background(), in)
@@ -351,12 +384,12 @@ func TestGetSubscribes(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
-		mock.ExpectQuery(getSubscribesQuery).
-			WithArgs(userIdInt).
+		mock.ExpectQuery(getSubscribesQuery+" order by u.id DESC limit $2").
+			WithArgs(userIdInt, utils.DefaultPageLimit+1).
 			WillReturnRows(rows).
 			WillReturnError(test.postgresErr)
 
-		in := &userGrpc.UserId{
+		in := &userGrpc.UsersRequest{
 			ID: test.userId,
 		}
 		out, actualErr := repositoryTest.GetSubscribes(context.Background(), in)
@@ -369,7 +402,7 @@ func TestGetSubscribes(t *testing.T) {
 	}
 }
 
-var getVisitorsTests = []struct {
+var getAttendeesTests = []struct {
 	id          int
 	eventId     string
 	postgresErr error
@@ -401,14 +434,14 @@ var getVisitorsTests = []struct {
 	},
 }
 
-func TestGetVisitors(t *testing.T) {
+func TestGetAttendees(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err, logMessage, err)
 	defer db.Close()
 	sqlxDB := sqlx.NewDb(db, "sqlmock")
 	repositoryTest := NewRepository(sqlxDB)
 
-	for _, test := range getVisitorsTests {
+	for _, test := range getAttendeesTests {
 		eventIdInt, err := strconv.Atoi(test.eventId)
 		if err != nil {
 			eventIdInt = 0
@@ -416,15 +449,16 @@ func TestGetVisitors(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
-		mock.ExpectQuery(getVisitorsQuery).
-			WithArgs(eventIdInt).
+		mock.ExpectQuery(getAttendeesQuery+" order by u.id DESC limit $3").
+			WithArgs(eventIdInt, models.RsvpGoing, utils.DefaultPageLimit+1).
 			WillReturnRows(rows).
 			WillReturnError(test.postgresErr)
 
-		in := &userGrpc.EventId{
-			ID: test.eventId,
+		in := &userGrpc.AttendeesRequest{
+			EventId: test.eventId,
+			Status:  models.RsvpGoing,
 		}
-		out, actualErr := repositoryTest.GetVisitors(context.Background(), in)
+		out, actualErr := repositoryTest.GetAttendees(context.Background(), in)
 		require.Equal(t, test.outputErr, actualErr)
 		actualRes := make([]*models.User, len(out.Users))
 		for i, u := range out.Users {
@@ -434,6 +468,33 @@ func TestGetVisitors(t *testing.T) {
 	}
 }
 
+func TestGetSubscribersPage(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, logMessage, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+	repositoryTest := NewRepository(sqlxDB)
+
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(9).AddRow(7).AddRow(4)
+	mock.ExpectQuery(getSubscribersQuery+" and u.id < $2 order by u.id DESC limit $3").
+		WithArgs(1, 10, 3).
+		WillReturnRows(rows)
+	in := &userGrpc.UsersRequest{
+		ID:     "1",
+		Limit:  2,
+		Cursor: utils.EncodeCursor(&utils.Cursor{ID: 10}),
+	}
+	out, err := repositoryTest.GetSubscribers(context.Background(), in)
+	require.NoError(t, err)
+	require.Len(t, out.Users, 2)
+	require.Equal(t, utils.EncodeCursor(&utils.Cursor{ID: 7}), out.NextCursor)
+
+	in.Cursor = "bad"
+	_, err = repositoryTest.GetSubscribers(context.Background(), in)
+	require.Equal(t, utils.ErrInvalidCursor, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 var subscribeTests = []struct {
 	id           int
 	subscribedId string
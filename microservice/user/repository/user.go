@@ -4,17 +4,26 @@ import (
 	proto "backend/microservice/user/proto"
 	"backend/pkg/models"
 	error2 "backend/service/user/error"
+	"math"
 	"strconv"
 )
 
 type User struct {
-	ID       int    `db:"id"`
-	Name     string `db:"name"`
-	Surname  string `db:"surname"`
-	Mail     string `db:"mail"`
-	Password string `db:"password"`
-	About    string `db:"about"`
-	ImgUrl   string `db:"img_url"`
+	ID            int    `db:"id"`
+	Name          string `db:"name"`
+	Surname       string `db:"surname"`
+	Mail          string `db:"mail"`
+	Password      string `db:"password"`
+	About         string `db:"about"`
+	ImgUrl        string `db:"img_url"`
+	EmailVerified bool   `db:"email_verified"`
+	TotpSecret    string `db:"totp_secret"`
+	TotpEnabled   bool   `db:"totp_enabled"`
+	Role          string `db:"role"`
+	Banned        bool   `db:"banned"`
+	//Только в профиле: сумма и число оценок мероприятий пользователя
+	RatingSum   int `db:"rating_sum"`
+	RatingCount int `db:"rating_count"`
 }
 
 func toPostgresUser(u *models.User) (*User, error) {
@@ -41,24 +50,36 @@ func toPostgresUser(u *models.User) (*User, error) {
 
 func toModelUser(u *User) *models.User {
 	return &models.User{
-		ID:       strconv.Itoa(u.ID),
-		Name:     u.Name,
-		Surname:  u.Surname,
-		Mail:     u.Mail,
-		Password: u.Password,
-		About:    u.About,
-		ImgUrl:   u.ImgUrl,
+		ID:          strconv.Itoa(u.ID),
+		Name:        u.Name,
+		Surname:     u.Surname,
+		Mail:        u.Mail,
+		Password:    u.Password,
+		About:       u.About,
+		ImgUrl:      u.ImgUrl,
+		Reputation:  reputation(u.RatingSum, u.RatingCount),
+		ReviewCount: u.RatingCount,
 	}
 }
 
+//Средняя оценка с точностью до сотых, без отзывов - 0
+func reputation(sum int, count int) float64 {
+	if count == 0 {
+		return 0
+	}
+	return math.Round(float64(sum)/float64(count)*100) / 100
+}
+
 func toProtoUser(u *models.User) *proto.User {
 	return &proto.User{
-		ID:       u.ID,
-		Name:     u.Name,
-		Surname:  u.Surname,
-		Mail:     u.Mail,
-		Password: u.Password,
-		About:    u.About,
-		ImgUrl:   u.ImgUrl,
+		ID:          u.ID,
+		Name:        u.Name,
+		Surname:     u.Surname,
+		Mail:        u.Mail,
+		Password:    u.Password,
+		About:       u.About,
+		ImgUrl:      u.ImgUrl,
+		Reputation:  u.Reputation,
+		ReviewCount: int32(u.ReviewCount),
 	}
 }
@@ -2,11 +2,14 @@ package middleware
 
 import (
 	log "backend/pkg/logger"
+	"backend/pkg/models"
 	"backend/pkg/response"
 	"backend/pkg/utils"
 	"backend/service/auth"
+	error2 "backend/service/auth/error"
 	"context"
 	"github.com/gorilla/mux"
+	uuid "github.com/satori/go.uuid"
 	"github.com/spf13/viper"
 	"net/http"
 	"time"
@@ -14,6 +17,12 @@ import (
 
 const logMessage = "middleware:"
 
+//Анонимный посетитель узнаётся по cookie год
+const (
+	visitorCookie       = "visitor_id"
+	visitorCookieMaxAge = 365 * 24 * 60 * 60
+)
+
 type Middlewares struct {
 	authService auth.UseCase
 }
@@ -48,7 +57,7 @@ func (m *Middlewares) CORS(next http.Handler) http.Handler {
 		w.Header().Set("Content-Type", "application/json")
 		w.Header().Set("Access-Control-Allow-Methods", "GET,POST,DELETE,PUT,OPTIONS,HEAD")
 		w.Header().Set("Access-Control-Expose-Headers",
-			"Accept,Accept-Encoding,X-CSRF-Token,Authorization")
+			"Accept,Accept-Encoding,X-CSRF-Token,Authorization,Retry-After")
 		if r.Method == http.MethodOptions {
 			return
 		}
@@ -76,25 +85,167 @@ func (m *Middlewares) GetVars(next http.Handler) http.Handler {
 	})
 }
 
+//Auth пускает по cookie session_id или по API-токену в "Authorization: Bearer".
+//Токену без явно заданного права доступны только читающие запросы
 func (m *Middlewares) Auth(next http.Handler) http.Handler {
+	return m.authenticate(next, "")
+}
+
+//AuthScope - Auth, в котором API-токену для доступа нужно право scope
+func (m *Middlewares) AuthScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return m.authenticate(next, scope)
+	}
+}
+
+func (m *Middlewares) authenticate(next http.Handler, scope string) http.Handler {
 	message := logMessage + "Auth:"
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := utils.GetBearerToken(r); token != "" {
+			userCtx, scopes, err := m.checkApiToken(r, token)
+			if err == nil && !hasScope(scopes, requiredScope(r, scope)) {
+				err = error2.ErrScopeNotGranted
+			}
+			if !utils.CheckIfNoError(&w, err, message, http.StatusForbidden) {
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(userCtx))
+			return
+		}
 		cookie, err := r.Cookie("session_id")
 		if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 			return
 		}
-		userId, err := m.authService.CheckSession(cookie.Value)
+		userId, role, err := m.authService.CheckSession(cookie.Value)
 		if !utils.CheckIfNoError(&w, err, message, http.StatusNotFound) {
 			return
 		}
 		userCtx := context.WithValue(r.Context(), "userId", userId)
+		userCtx = context.WithValue(userCtx, "role", role)
 		next.ServeHTTP(w, r.WithContext(userCtx))
 	})
 }
 
+//Viewer - необязательная авторизация для публичных страниц: вошедшему пользователю
+//кладёт userId, остальным - visitorId из cookie visitor_id, выдавая его при первом визите
+func (m *Middlewares) Viewer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var userId string
+		var err error
+		if token := utils.GetBearerToken(r); token != "" {
+			userId, _, _, err = m.authService.CheckApiToken(token)
+		} else if cookie, cookieErr := r.Cookie("session_id"); cookieErr == nil {
+			userId, _, err = m.authService.CheckSession(cookie.Value)
+		}
+		if err == nil && userId != "" {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), "userId", userId)))
+			return
+		}
+		var visitorId string
+		if cookie, err := r.Cookie(visitorCookie); err == nil {
+			if id, err := uuid.FromString(cookie.Value); err == nil {
+				visitorId = id.String()
+			}
+		}
+		if visitorId == "" {
+			visitorId = uuid.NewV4().String()
+			http.SetCookie(w, &http.Cookie{
+				Name:     visitorCookie,
+				Value:    visitorId,
+				HttpOnly: true,
+				Secure:   true,
+				MaxAge:   visitorCookieMaxAge,
+				SameSite: http.SameSiteNoneMode,
+				Path:     "/",
+			})
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), "visitorId", visitorId)))
+	})
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+//Без явно заданного права читающим запросам нужно read, а остальные токену закрыты
+func requiredScope(r *http.Request, scope string) string {
+	if scope != "" {
+		return scope
+	}
+	if isSafeMethod(r.Method) {
+		return models.ScopeRead
+	}
+	return ""
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if scope != "" && s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Middlewares) checkApiToken(r *http.Request, token string) (context.Context, []string, error) {
+	userId, role, scopes, err := m.authService.CheckApiToken(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	userCtx := context.WithValue(r.Context(), "userId", userId)
+	userCtx = context.WithValue(userCtx, "role", role)
+	return userCtx, scopes, nil
+}
+
+func (m *Middlewares) Verified(next http.Handler) http.Handler {
+	message := logMessage + "Verified:"
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userId, _ := r.Context().Value("userId").(string)
+		verified, err := m.authService.IsEmailVerified(userId)
+		if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
+			return
+		}
+		if !verified {
+			err = error2.ErrEmailNotVerified
+		}
+		if !utils.CheckIfNoError(&w, err, message, http.StatusForbidden) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+//RequireRole пропускает запрос, только если у пользователя есть права роли role.
+//Должен стоять после Auth, который кладёт роль в контекст
+func (m *Middlewares) RequireRole(role string) func(http.Handler) http.Handler {
+	message := logMessage + "RequireRole:"
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userRole, _ := r.Context().Value("role").(string)
+			var err error
+			if !models.HasRole(userRole, role) {
+				err = error2.ErrForbidden
+			}
+			if !utils.CheckIfNoError(&w, err, message, http.StatusForbidden) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 func (m *Middlewares) CSRF(next http.Handler) http.Handler {
 	message := logMessage + "CSRF:"
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		//Authorization браузер сам не подставляет, поэтому запросам с API-токеном CSRF не грозит
+		if token := utils.GetBearerToken(r); token != "" {
+			userCtx, _, err := m.checkApiToken(r, token)
+			if !utils.CheckIfNoError(&w, err, message, http.StatusNotFound) {
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(userCtx))
+			return
+		}
 		gottenToken := (*r).Header.Get("X-CSRF-Token")
 		userId, err := m.authService.CheckToken(gottenToken)
 		if !utils.CheckIfNoError(&w, err, message, http.StatusNotFound) {
@@ -3,6 +3,7 @@ package middleware
 import (
 	"backend/service/auth/usecase"
 	"bytes"
+	"context"
 	"errors"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/require"
@@ -76,7 +77,7 @@ func TestUtils(t *testing.T) {
 		}
 		req.AddCookie(cookie)
 
-		useCaseMock.On("CheckSession", cookie.Value).Return("", test.err)
+		useCaseMock.On("CheckSession", cookie.Value).Return("", "", test.err)
 		useCaseMock.On("CheckToken", req.Header.Get("X-CSRF-Token")).Return("", test.err)
 
 		r.ServeHTTP(w, req)
@@ -86,3 +87,251 @@ func TestUtils(t *testing.T) {
 		}
 	}
 }
+
+func TestViewer(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	middlewares := NewMiddlewares(useCaseMock)
+	useCaseMock.On("CheckSession", "valid").Return("7", "user", nil)
+	useCaseMock.On("CheckSession", "expired").Return("", "", errors.New("test error"))
+
+	var userId, visitorId string
+	handler := middlewares.Viewer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userId, _ = r.Context().Value("userId").(string)
+		visitorId, _ = r.Context().Value("visitorId").(string)
+	}))
+
+	req, err := http.NewRequest("GET", "/test", nil)
+	require.NoError(t, err)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "valid"})
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, "7", userId)
+	require.Empty(t, visitorId)
+
+	//Сессия истекла - посетитель становится анонимным и получает cookie
+	req, err = http.NewRequest("GET", "/test", nil)
+	require.NoError(t, err)
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "expired"})
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Empty(t, userId)
+	require.NotEmpty(t, visitorId)
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, visitorCookie, cookies[0].Name)
+	require.Equal(t, visitorId, cookies[0].Value)
+
+	//Повторный визит - тот же visitorId, новая cookie не выдаётся
+	issued := visitorId
+	req, err = http.NewRequest("GET", "/test", nil)
+	require.NoError(t, err)
+	req.AddCookie(cookies[0])
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, issued, visitorId)
+	require.Empty(t, w.Result().Cookies())
+}
+
+var verifiedTests = []struct {
+	id       int
+	verified bool
+	err      error
+	called   bool
+}{
+	{
+		1,
+		true,
+		nil,
+		true,
+	},
+	{
+		2,
+		false,
+		nil,
+		false,
+	},
+	{
+		3,
+		false,
+		errors.New("test error"),
+		false,
+	},
+}
+
+func TestVerified(t *testing.T) {
+	for _, test := range verifiedTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		middlewares := NewMiddlewares(useCaseMock)
+		useCaseMock.On("IsEmailVerified", "1").Return(test.verified, test.err)
+
+		called := false
+		handler := middlewares.Verified(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+
+		req, err := http.NewRequest("POST", "/test", bytes.NewBuffer(nil))
+		require.NoError(t, err)
+		req = req.WithContext(context.WithValue(req.Context(), "userId", "1"))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		require.Equal(t, test.called, called, test.id)
+	}
+}
+
+var requireRoleTests = []struct {
+	id       int
+	role     string
+	required string
+	called   bool
+}{
+	{
+		1,
+		"admin",
+		"moderator",
+		true,
+	},
+	{
+		2,
+		"moderator",
+		"moderator",
+		true,
+	},
+	{
+		3,
+		"user",
+		"moderator",
+		false,
+	},
+	{
+		4,
+		"moderator",
+		"admin",
+		false,
+	},
+	{
+		5,
+		"",
+		"user",
+		false,
+	},
+}
+
+func TestRequireRole(t *testing.T) {
+	for _, test := range requireRoleTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		middlewares := NewMiddlewares(useCaseMock)
+
+		called := false
+		handler := middlewares.RequireRole(test.required)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+
+		req, err := http.NewRequest("DELETE", "/test", bytes.NewBuffer(nil))
+		require.NoError(t, err)
+		req = req.WithContext(context.WithValue(req.Context(), "role", test.role))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		require.Equal(t, test.called, called, test.id)
+	}
+}
+
+var apiTokenTests = []struct {
+	id     int
+	method string
+	scope  string
+	scopes []string
+	err    error
+	called bool
+}{
+	{
+		1,
+		"GET",
+		"",
+		[]string{"read"},
+		nil,
+		true,
+	},
+	{
+		2,
+		"POST",
+		"",
+		[]string{"read", "events:write"},
+		nil,
+		false,
+	},
+	{
+		3,
+		"POST",
+		"events:write",
+		[]string{"events:write"},
+		nil,
+		true,
+	},
+	{
+		4,
+		"POST",
+		"events:write",
+		[]string{"read"},
+		nil,
+		false,
+	},
+	{
+		5,
+		"GET",
+		"",
+		[]string{"read"},
+		errors.New("test error"),
+		false,
+	},
+}
+
+func TestAuthApiToken(t *testing.T) {
+	for _, test := range apiTokenTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		middlewares := NewMiddlewares(useCaseMock)
+		useCaseMock.On("CheckApiToken", "token").Return("1", "user", test.scopes, test.err)
+
+		called := false
+		var next http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			require.Equal(t, "1", r.Context().Value("userId"))
+		})
+		var handler http.Handler
+		if test.scope == "" {
+			handler = middlewares.Auth(next)
+		} else {
+			handler = middlewares.AuthScope(test.scope)(next)
+		}
+
+		req, err := http.NewRequest(test.method, "/test", bytes.NewBuffer(nil))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer token")
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		require.Equal(t, test.called, called, test.id)
+		useCaseMock.AssertNotCalled(t, "CheckSession", "token")
+	}
+}
+
+func TestCSRFApiToken(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	middlewares := NewMiddlewares(useCaseMock)
+	useCaseMock.On("CheckApiToken", "token").Return("1", "user", []string{"events:write"}, nil)
+
+	called := false
+	handler := middlewares.CSRF(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req, err := http.NewRequest("POST", "/test", bytes.NewBuffer(nil))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer token")
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.True(t, called)
+	useCaseMock.AssertNotCalled(t, "CheckToken", "")
+}
@@ -0,0 +1,15 @@
+package models
+
+//ParentId - комментарий, на который это ответ, пустой - верхний уровень.
+//Удалённый комментарий приходит с Deleted и пустым Text. Replies - число ответов
+type Comment struct {
+	ID        string
+	EventId   string
+	UserId    string
+	ParentId  string
+	Text      string
+	CreatedAt string
+	EditedAt  string
+	Deleted   bool
+	Replies   int
+}
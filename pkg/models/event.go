@@ -11,7 +11,61 @@ type Event struct {
 	ImgUrl      string
 	Tag         []string
 	Date        string
+	StartsAt    string
+	EndsAt      string
+	Timezone    string
 	Geo         string
+	Location    *Location
+	Capacity    int
 	Address     string
 	AuthorId    string
+	Snippet     string
+	//Средняя оценка по отзывам, 0 - отзывов нет
+	RatingAvg   float64
+	RatingCount int
+}
+
+//Координаты в градусах WGS 84
+type Location struct {
+	Lat float64
+	Lon float64
+}
+
+//Прямоугольник карты; MinLon > MaxLon - прямоугольник пересекает 180-й меридиан
+type BoundingBox struct {
+	MinLat float64
+	MinLon float64
+	MaxLat float64
+	MaxLon float64
+}
+
+//Режимы выборки мероприятий по времени
+const (
+	EventsUpcoming = "upcoming"
+	EventsPast     = "past"
+)
+
+type EventFilter struct {
+	Query    string
+	Category string
+	City     string
+	Date     string
+	Tags     []string
+	From     string
+	To       string
+	When     string
+	Near     *Location
+	RadiusKm float64
+}
+
+//Статистика мероприятия для автора: ряд по дням
+type EventStats struct {
+	EventId string
+	Days    []*DayStats
+}
+
+type DayStats struct {
+	Date       string
+	Views      int64
+	Favourites int64
 }
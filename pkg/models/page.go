@@ -0,0 +1,8 @@
+package models
+
+//Параметры постраничной выдачи. Cursor - непрозрачная строка из nextCursor
+//предыдущей страницы, пустая - первая страница; Limit 0 - размер по умолчанию
+type Page struct {
+	Limit  int
+	Cursor string
+}
@@ -0,0 +1,24 @@
+package models
+
+//Статусы записи на мероприятие. Пустой статус - пользователь не записан
+const (
+	RegistrationRegistered = "registered"
+	RegistrationWaitlisted = "waitlisted"
+)
+
+//Запись пользователя на мероприятие. Position - место в листе ожидания, с 1;
+//Capacity 0 - мест без ограничения. Promoted - кого перевели из листа ожидания
+//в участники этим же запросом, им отправляется письмо
+type Registration struct {
+	Status     string
+	Position   int
+	Registered int
+	Capacity   int
+	EventTitle string
+	Promoted   []*Promotion
+}
+
+type Promotion struct {
+	UserId string
+	Mail   string
+}
@@ -1,17 +1,102 @@
 package models
 
 type UserResponseBody struct {
-	ID       string `json:"id,omitempty"`
-	Name     string `json:"name,omitempty" valid:"type(string),length(0|50)" san:"xss"`
-	Surname  string `json:"surname,omitempty" valid:"type(string),length(0|50)" san:"xss"`
-	About    string `json:"description,omitempty" valid:"type(string),length(0|150)" san:"xss"`
-	ImgUrl   string `json:"imgUrl,omitempty" valid:"type(string)" san:"xss"`
-	Mail     string `json:"email,omitempty" valid:"email,length(0|150)" san:"xss"`
-	Password string `json:"password,omitempty" valid:"type(string),length(0|150)" san:"xss"`
+	ID          string  `json:"id,omitempty"`
+	Name        string  `json:"name,omitempty" valid:"type(string),length(0|50)" san:"xss"`
+	Surname     string  `json:"surname,omitempty" valid:"type(string),length(0|50)" san:"xss"`
+	About       string  `json:"description,omitempty" valid:"type(string),length(0|150)" san:"xss"`
+	ImgUrl      string  `json:"imgUrl,omitempty" valid:"type(string)" san:"xss"`
+	Mail        string  `json:"email,omitempty" valid:"email,length(0|150)" san:"xss"`
+	Password    string  `json:"password,omitempty" valid:"type(string),length(0|150)" san:"xss"`
+	RememberMe  bool    `json:"rememberMe,omitempty"`
+	Reputation  float64 `json:"reputation,omitempty"`
+	ReviewCount int     `json:"reviewCount,omitempty"`
+}
+
+type PasswordResetResponseBody struct {
+	Token    string `json:"token" valid:"type(string),length(0|100)" san:"xss"`
+	Password string `json:"password" valid:"type(string),length(0|150)" san:"xss"`
+}
+
+type TwoFactorResponseBody struct {
+	Token      string `json:"token,omitempty" valid:"type(string),length(0|100)" san:"xss"`
+	Code       string `json:"code" valid:"type(string),length(0|20)" san:"xss"`
+	RememberMe bool   `json:"rememberMe,omitempty"`
+}
+
+type MagicLinkResponseBody struct {
+	Token      string `json:"token" valid:"type(string),length(0|100)" san:"xss"`
+	RememberMe bool   `json:"rememberMe,omitempty"`
+}
+
+type EmailChangeResponseBody struct {
+	Mail  string `json:"email" valid:"email,length(0|150)" san:"xss"`
+	Token string `json:"token" valid:"type(string),length(0|100)" san:"xss"`
+}
+
+type RoleResponseBody struct {
+	Role string `json:"role" valid:"type(string),length(1|20)" san:"xss"`
+}
+
+type TwoFactorChallengeResponseBody struct {
+	TwoFactorRequired bool   `json:"twoFactorRequired"`
+	ChallengeToken    string `json:"challengeToken"`
+}
+
+type TotpEnrollmentResponseBody struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type RecoveryCodesResponseBody struct {
+	Codes []string `json:"recoveryCodes"`
 }
 
 type UserListResponseBody struct {
-	Users []UserResponseBody `json:"users"`
+	Users      []UserResponseBody `json:"users"`
+	NextCursor string             `json:"nextCursor,omitempty"`
+}
+
+type SessionResponseBody struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	LastSeen  string `json:"lastSeen"`
+	UserAgent string `json:"userAgent"`
+	IP        string `json:"ip"`
+	Current   bool   `json:"current"`
+}
+
+type SessionListResponseBody struct {
+	Sessions []SessionResponseBody `json:"sessions"`
+}
+
+type AuthEventResponseBody struct {
+	ID        string `json:"id"`
+	UserId    string `json:"userId,omitempty"`
+	Type      string `json:"type"`
+	Mail      string `json:"mail,omitempty"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"userAgent"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type AuthEventListResponseBody struct {
+	Events []AuthEventResponseBody `json:"events"`
+}
+
+type ApiTokenResponseBody struct {
+	ID         string   `json:"id,omitempty"`
+	Name       string   `json:"name" valid:"type(string),length(1|50)" san:"xss"`
+	Scopes     []string `json:"scopes" san:"xss"`
+	ExpiresIn  int      `json:"expiresIn,omitempty"`
+	CreatedAt  string   `json:"createdAt,omitempty"`
+	ExpiresAt  string   `json:"expiresAt,omitempty"`
+	LastUsedAt string   `json:"lastUsedAt,omitempty"`
+	Token      string   `json:"token,omitempty"`
+}
+
+type ApiTokenListResponseBody struct {
+	Tokens []ApiTokenResponseBody `json:"tokens"`
 }
 
 type EventIDResponseBody struct {
@@ -29,19 +114,127 @@ type EventResponseBody struct {
 	ImgUrl      string   `json:"imgUrl" valid:"type(string),length(0|255)" san:"xss"`
 	Tag         []string `json:"tag" san:"xss"`
 	Date        string   `json:"date" valid:"type(string),length(0|10)" san:"xss"`
+	StartsAt    string   `json:"startsAt" valid:"type(string),length(0|35)" san:"xss"`
+	EndsAt      string   `json:"endsAt" valid:"type(string),length(0|35)" san:"xss"`
+	Timezone    string   `json:"timezone" valid:"type(string),length(0|64)" san:"xss"`
 	Geo         string   `json:"geo" valid:"type(string),length(0|255)"`
-	Address     string   `json:"address" valid:"type(string)","length(0|255)" san:"xss"`
+	Lat         *float64 `json:"lat,omitempty"`
+	Lon         *float64 `json:"lon,omitempty"`
+	Capacity    int      `json:"capacity" valid:"range(0|100000)"`
+	Address     string   `json:"address" valid:"type(string),length(0|255)" san:"xss"`
 	AuthorID    string   `json:"authorid" san:"xss"`
+	Snippet     string   `json:"snippet,omitempty"`
+	Rating      float64  `json:"rating"`
+	RatingCount int      `json:"ratingCount"`
+}
+
+type RegistrationResponseBody struct {
+	Status     string `json:"status"`
+	Position   int    `json:"position,omitempty"`
+	Registered int    `json:"registered"`
+	Capacity   int    `json:"capacity"`
+}
+
+type EventStatsResponseBody struct {
+	EventID string                 `json:"eventId"`
+	Days    []DayStatsResponseBody `json:"days"`
+}
+
+type DayStatsResponseBody struct {
+	Date       string `json:"date"`
+	Views      int64  `json:"views"`
+	Favourites int64  `json:"favourites"`
 }
 
 type EventListResponseBody struct {
-	Events []EventResponseBody `json:"events"`
+	Events     []EventResponseBody `json:"events"`
+	NextCursor string              `json:"nextCursor,omitempty"`
+}
+
+//GeoJSON (RFC 7946): координаты точки - [долгота, широта]
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Geometry   GeoJSONPoint           `json:"geometry"`
+	Properties EventMapPropertiesBody `json:"properties"`
+}
+
+type GeoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+type EventMapPropertiesBody struct {
+	Title    string `json:"title"`
+	Category string `json:"category"`
+	ImgUrl   string `json:"imgUrl"`
+	StartsAt string `json:"startsAt"`
+	EndsAt   string `json:"endsAt"`
+	Address  string `json:"address"`
 }
 
 type SubscribedResponseBody struct {
 	Result bool `json:"result"`
 }
 
+type RsvpResponseBody struct {
+	Status     string `json:"status" valid:"type(string),length(1|16)" san:"xss"`
+	Going      int    `json:"going"`
+	Interested int    `json:"interested"`
+}
+
+type TicketCodeResponseBody struct {
+	Code string `json:"code" valid:"type(string),length(1|1024)"`
+}
+
+type AttendanceResponseBody struct {
+	Attended   int `json:"attended"`
+	Registered int `json:"registered"`
+}
+
+type CheckInResponseBody struct {
+	UserId      string `json:"userId"`
+	CheckedInAt string `json:"checkedInAt"`
+	Attended    int    `json:"attended"`
+	Registered  int    `json:"registered"`
+}
+
+type CommentResponseBody struct {
+	ID        string `json:"id,omitempty"`
+	EventId   string `json:"eventId,omitempty"`
+	UserId    string `json:"userId,omitempty"`
+	ParentId  string `json:"parentId,omitempty" valid:"numeric"`
+	Text      string `json:"text" valid:"type(string),length(1|2000)" san:"xss"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	EditedAt  string `json:"editedAt,omitempty"`
+	Deleted   bool   `json:"deleted,omitempty"`
+	Replies   int    `json:"replies"`
+}
+
+type CommentListResponseBody struct {
+	Comments   []CommentResponseBody `json:"comments"`
+	NextCursor string                `json:"nextCursor,omitempty"`
+}
+
+type ReviewResponseBody struct {
+	ID        string `json:"id,omitempty"`
+	EventId   string `json:"eventId,omitempty"`
+	UserId    string `json:"userId,omitempty"`
+	Rating    int    `json:"rating" valid:"range(1|5)"`
+	Text      string `json:"text" valid:"type(string),length(0|2000)" san:"xss"`
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+type ReviewListResponseBody struct {
+	Reviews    []ReviewResponseBody `json:"reviews"`
+	NextCursor string               `json:"nextCursor,omitempty"`
+}
+
 type FavouriteResponseBody struct {
 	Result bool `json:"result"`
 }
@@ -49,3 +242,11 @@ type FavouriteResponseBody struct {
 type CitiesResponseBody struct {
 	Cities []string `json:"cities"`
 }
+
+type UserExportResponseBody struct {
+	Profile       UserResponseBody    `json:"profile"`
+	CreatedEvents []EventResponseBody `json:"createdEvents"`
+	Favourites    []EventResponseBody `json:"favourites"`
+	Subscriptions []UserResponseBody  `json:"subscriptions"`
+	Images        []string            `json:"images"`
+}
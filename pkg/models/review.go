@@ -0,0 +1,11 @@
+package models
+
+//Оценка Rating от 1 до 5, текст отзыва необязателен
+type Review struct {
+	ID        string
+	EventId   string
+	UserId    string
+	Rating    int
+	Text      string
+	CreatedAt string
+}
@@ -0,0 +1,21 @@
+package models
+
+//Ответ пользователя о том, пойдёт ли он на мероприятие. Хранится отдельно от избранного:
+//добавить в избранное - только закладка, она не делает пользователя участником
+const (
+	RsvpGoing      = "going"
+	RsvpInterested = "interested"
+	RsvpNotGoing   = "not_going"
+)
+
+func IsValidRsvpStatus(status string) bool {
+	return status == RsvpGoing || status == RsvpInterested || status == RsvpNotGoing
+}
+
+//Status - ответ пользователя, пустой - не отвечал. Going и Interested - сколько
+//всего пользователей ответили так на это мероприятие
+type Rsvp struct {
+	Status     string
+	Going      int
+	Interested int
+}
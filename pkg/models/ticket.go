@@ -0,0 +1,23 @@
+package models
+
+//Code - подписанный токен, который кодируется в QR-код билета.
+//CheckedInAt - время прохода в RFC3339, пустое - участник ещё не пришёл
+type Ticket struct {
+	Code        string
+	EventId     string
+	UserId      string
+	EventTitle  string
+	CheckedInAt string
+}
+
+//Сколько участников уже прошло (Attended) из всех записанных (Registered)
+type Attendance struct {
+	Attended   int
+	Registered int
+}
+
+type CheckIn struct {
+	UserId      string
+	CheckedInAt string
+	Attendance  Attendance
+}
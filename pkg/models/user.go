@@ -1,11 +1,123 @@
 package models
 
 type User struct {
-	ID       string
-	Name     string
-	Surname  string
-	Mail     string
-	Password string
-	About    string
-	ImgUrl   string
+	ID            string
+	Name          string
+	Surname       string
+	Mail          string
+	Password      string
+	About         string
+	ImgUrl        string
+	EmailVerified bool
+	TotpEnabled   bool
+	RememberMe    bool
+	Role          string
+	Banned        bool
+	//Репутация организатора: средняя оценка отзывов на его мероприятия и их число
+	Reputation  float64
+	ReviewCount int
+}
+
+//Роли по возрастанию прав: модератор может всё, что пользователь, админ - всё, что модератор
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
+var roleRanks = map[string]int{
+	RoleUser:      1,
+	RoleModerator: 2,
+	RoleAdmin:     3,
+}
+
+func IsValidRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+//HasRole - есть ли у роли role права роли required
+func HasRole(role string, required string) bool {
+	return IsValidRole(required) && roleRanks[role] >= roleRanks[required]
+}
+
+//Права персональных API-токенов
+const (
+	ScopeRead        = "read"
+	ScopeEventsWrite = "events:write"
+)
+
+func IsValidScope(scope string) bool {
+	return scope == ScopeRead || scope == ScopeEventsWrite
+}
+
+type ApiToken struct {
+	ID         string
+	Name       string
+	Scopes     []string
+	CreatedAt  string
+	ExpiresAt  string
+	LastUsedAt string
+	Token      string
+}
+
+//UserExport - данные пользователя для выгрузки: профиль, созданные мероприятия
+//(вместе со скрытыми), избранное и подписки
+type UserExport struct {
+	User          *User
+	CreatedEvents []*Event
+	Favourites    []*Event
+	Subscriptions []*User
+}
+
+type EmailChange struct {
+	UserId      string
+	OldMail     string
+	NewMail     string
+	Token       string
+	CancelToken string
+}
+
+type Session struct {
+	ID        string
+	CreatedAt string
+	LastSeen  string
+	UserAgent string
+	IP        string
+	Current   bool
+}
+
+//Типы событий журнала безопасности
+const (
+	AuthEventSignUp            = "sign_up"
+	AuthEventLogin             = "login"
+	AuthEventLoginFailed       = "login_failed"
+	AuthEventLogout            = "logout"
+	AuthEventPasswordChange    = "password_change"
+	AuthEventSessionRevoke     = "session_revoke"
+	AuthEventEmailChange       = "email_change"
+	AuthEventEmailChangeCancel = "email_change_cancel"
+)
+
+//AuthEvent - запись журнала безопасности. UserId пустой, если неудачный вход был
+//на несуществующую почту
+type AuthEvent struct {
+	ID        string
+	UserId    string
+	Type      string
+	Mail      string
+	IP        string
+	UserAgent string
+	CreatedAt string
+}
+
+//Фильтр журнала безопасности для администраторов; From и To - время в RFC 3339
+type AuthEventQuery struct {
+	UserId string
+	Type   string
+	Mail   string
+	IP     string
+	From   string
+	To     string
+	Limit  int
 }
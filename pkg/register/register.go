@@ -2,6 +2,7 @@ package register
 
 import (
 	"backend/middleware"
+	"backend/pkg/models"
 	authHttp "backend/service/auth/delivery/http"
 	eventHttp "backend/service/event/delivery/http"
 	userHttp "backend/service/user/delivery/http"
@@ -12,11 +13,39 @@ import (
 func AuthHTTPEndpoints(r *mux.Router, delivery *authHttp.Delivery, middlewares *middleware.Middlewares) {
 	r.HandleFunc("/signup", delivery.SignUp)
 	r.HandleFunc("/login", delivery.SignIn)
+	r.HandleFunc("/login/2fa", delivery.LoginTwoFactor).Methods("POST")
+	r.HandleFunc("/password/reset", delivery.RequestPasswordReset).Methods("POST")
+	r.HandleFunc("/password/reset/confirm", delivery.ConfirmPasswordReset).Methods("POST")
+	r.HandleFunc("/magic-link", delivery.RequestMagicLink).Methods("POST")
+	r.HandleFunc("/magic-link/confirm", delivery.ConfirmMagicLink).Methods("POST")
+	r.HandleFunc("/verify", delivery.VerifyEmail).Methods("GET")
+	r.Handle("/oidc/{provider}", middlewares.GetVars(http.HandlerFunc(delivery.OidcLogin))).Methods("GET")
+	r.Handle("/oidc/{provider}/callback", middlewares.GetVars(http.HandlerFunc(delivery.OidcCallback))).Methods("GET")
+	resendVerificationHandlerFunc := http.HandlerFunc(delivery.ResendVerification)
+	r.Handle("/verify/resend", middlewares.Auth(resendVerificationHandlerFunc)).Methods("POST")
 	logoutHandlerFunc := http.HandlerFunc(delivery.Logout)
 	r.Handle("/logout", middlewares.Auth(logoutHandlerFunc))
+	getSessionsHandlerFunc := http.HandlerFunc(delivery.GetSessions)
+	r.Handle("/sessions", middlewares.Auth(getSessionsHandlerFunc)).Methods("GET")
+	revokeAllOtherSessionsHandlerFunc := http.HandlerFunc(delivery.RevokeAllOtherSessions)
+	r.Handle("/sessions", middlewares.Auth(revokeAllOtherSessionsHandlerFunc)).Methods("DELETE")
+	revokeSessionHandlerFunc := middlewares.GetVars(http.HandlerFunc(delivery.RevokeSession))
+	r.Handle("/sessions/{id}", middlewares.Auth(revokeSessionHandlerFunc)).Methods("DELETE")
+	enrollTotpHandlerFunc := http.HandlerFunc(delivery.EnrollTotp)
+	r.Handle("/2fa/enroll", middlewares.Auth(enrollTotpHandlerFunc)).Methods("POST")
+	confirmTotpHandlerFunc := http.HandlerFunc(delivery.ConfirmTotp)
+	r.Handle("/2fa/confirm", middlewares.Auth(confirmTotpHandlerFunc)).Methods("POST")
+	disableTotpHandlerFunc := http.HandlerFunc(delivery.DisableTotp)
+	r.Handle("/2fa/disable", middlewares.Auth(disableTotpHandlerFunc)).Methods("POST")
+	getApiTokensHandlerFunc := http.HandlerFunc(delivery.GetApiTokens)
+	r.Handle("/tokens", middlewares.Auth(getApiTokensHandlerFunc)).Methods("GET")
+	createApiTokenHandlerFunc := http.HandlerFunc(delivery.CreateApiToken)
+	r.Handle("/tokens", middlewares.Auth(createApiTokenHandlerFunc)).Methods("POST")
+	revokeApiTokenHandlerFunc := middlewares.GetVars(http.HandlerFunc(delivery.RevokeApiToken))
+	r.Handle("/tokens/{id:[0-9]+}", middlewares.Auth(revokeApiTokenHandlerFunc)).Methods("DELETE")
 }
 
-func UserHTTPEndpoints(r *mux.Router, uDelivery *userHttp.Delivery, eDelivery *eventHttp.Delivery, mws *middleware.Middlewares) {
+func UserHTTPEndpoints(r *mux.Router, uDelivery *userHttp.Delivery, eDelivery *eventHttp.Delivery, aDelivery *authHttp.Delivery, mws *middleware.Middlewares) {
 	r.HandleFunc("/{id:[0-9]+}", uDelivery.GetUserById).Methods("GET")
 	r.HandleFunc("/{id:[0-9]+}/events/favourite", eDelivery.GetVisitedEvents).Methods("GET")
 	r.HandleFunc("/{id:[0-9]+}/events/created", eDelivery.GetCreatedEvents).Methods("GET")
@@ -31,9 +60,24 @@ func UserHTTPEndpoints(r *mux.Router, uDelivery *userHttp.Delivery, eDelivery *e
 
 	updateUserPasswordHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.UpdateUserPassword)))
 	r.Handle("/password", updateUserPasswordHandlerFunc).Methods("POST")
+
+	requestEmailChangeHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.RequestEmailChange)))
+	r.Handle("/email", requestEmailChangeHandlerFunc).Methods("POST")
+	//Ссылку из письма могут открыть в другом браузере, поэтому без сессии - хватает токена
+	r.HandleFunc("/email/confirm", uDelivery.ConfirmEmailChange).Methods("POST")
+	r.HandleFunc("/email/cancel", uDelivery.CancelEmailChange).Methods("POST")
+
+	exportUserDataHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.ExportUserData)))
+	r.Handle("/export", exportUserDataHandlerFunc).Methods("GET")
+
+	deleteAccountHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(aDelivery.DeleteAccount)))
+	r.Handle("/delete", deleteAccountHandlerFunc).Methods("POST")
+
+	securityLogHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(aDelivery.GetSecurityLog)))
+	r.Handle("/security-log", securityLogHandlerFunc).Methods("GET")
 	//
 
-	subscribeHandleFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.Subscribe)))
+	subscribeHandleFunc := mws.Auth(mws.Verified(mws.GetVars(http.HandlerFunc(uDelivery.Subscribe))))
 	r.Handle("/{id:[0-9]}/subscription", subscribeHandleFunc).Methods("POST")
 
 	unsubscribeHandleFunc := mws.Auth(mws.GetVars(http.HandlerFunc(uDelivery.Unsubscribe)))
@@ -44,24 +88,102 @@ func UserHTTPEndpoints(r *mux.Router, uDelivery *userHttp.Delivery, eDelivery *e
 	//
 }
 
-func EventHTTPEndpoints(r *mux.Router, delivery *eventHttp.Delivery, mws *middleware.Middlewares) {
+func EventHTTPEndpoints(r *mux.Router, delivery *eventHttp.Delivery, uDelivery *userHttp.Delivery, mws *middleware.Middlewares) {
 	//TODO: Попросить фронт заменить "query" на "title", ибо понятно, почему.
 	r.HandleFunc("", delivery.GetEvents).Methods("GET")
 	r.HandleFunc("/cities", delivery.GetCities).Methods("GET")
-	r.HandleFunc("/{id:[0-9]+}", delivery.GetEventById).Methods("GET")
-	updateEventHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.UpdateEvent)))
+	r.HandleFunc("/map", delivery.GetMapEvents).Methods("GET")
+	r.Handle("/{id:[0-9]+}", mws.Viewer(http.HandlerFunc(delivery.GetEventById))).Methods("GET")
+	getEventStatsHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.GetEventStats)))
+	r.Handle("/{id:[0-9]+}/stats", getEventStatsHandlerFunc).Methods("GET")
+	eventsWrite := mws.AuthScope(models.ScopeEventsWrite)
+	updateEventHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.UpdateEvent)))
 	r.Handle("/{id:[0-9]+}", updateEventHandlerFunc).Methods("POST")
-	deleteEventHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.DeleteEvent)))
+	deleteEventHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.DeleteEvent)))
 	r.Handle("/{id:[0-9]+}", deleteEventHandlerFunc).Methods("DELETE")
-	createEventHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.CreateEvent)))
+	createEventHandlerFunc := eventsWrite(mws.Verified(mws.GetVars(http.HandlerFunc(delivery.CreateEvent))))
 	r.Handle("", createEventHandlerFunc).Methods("POST")
 	//
-	visitHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.Visit)))
+	visitHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.Visit)))
 	r.Handle("/{id:[0-9]+}/favourite", visitHandlerFunc).Methods("POST")
 
-	unvisitHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.Unvisit)))
+	unvisitHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.Unvisit)))
 	r.Handle("/{id:[0-9]+}/favourite", unvisitHandlerFunc).Methods("DELETE")
 
 	isVisitedHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.IsVisited)))
 	r.Handle("/{id:[0-9]+}/favourite", isVisitedHandlerFunc).Methods("GET")
+	//
+	registerHandlerFunc := eventsWrite(mws.Verified(mws.GetVars(http.HandlerFunc(delivery.Register))))
+	r.Handle("/{id:[0-9]+}/registration", registerHandlerFunc).Methods("POST")
+
+	cancelRegistrationHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.CancelRegistration)))
+	r.Handle("/{id:[0-9]+}/registration", cancelRegistrationHandlerFunc).Methods("DELETE")
+
+	getRegistrationHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.GetRegistration)))
+	r.Handle("/{id:[0-9]+}/registration", getRegistrationHandlerFunc).Methods("GET")
+
+	getTicketHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.GetTicket)))
+	r.Handle("/{id:[0-9]+}/ticket", getTicketHandlerFunc).Methods("GET")
+
+	checkInHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.CheckIn)))
+	r.Handle("/{id:[0-9]+}/checkin", checkInHandlerFunc).Methods("POST")
+
+	getAttendanceHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.GetAttendance)))
+	r.Handle("/{id:[0-9]+}/attendance", getAttendanceHandlerFunc).Methods("GET")
+	//
+	getCommentsHandlerFunc := mws.GetVars(http.HandlerFunc(delivery.GetComments))
+	r.Handle("/{id:[0-9]+}/comments", getCommentsHandlerFunc).Methods("GET")
+
+	createCommentHandlerFunc := eventsWrite(mws.Verified(mws.GetVars(http.HandlerFunc(delivery.CreateComment))))
+	r.Handle("/{id:[0-9]+}/comments", createCommentHandlerFunc).Methods("POST")
+
+	updateCommentHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.UpdateComment)))
+	r.Handle("/{id:[0-9]+}/comments/{commentId:[0-9]+}", updateCommentHandlerFunc).Methods("POST")
+
+	deleteCommentHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.DeleteComment)))
+	r.Handle("/{id:[0-9]+}/comments/{commentId:[0-9]+}", deleteCommentHandlerFunc).Methods("DELETE")
+	//
+	getReviewsHandlerFunc := mws.GetVars(http.HandlerFunc(delivery.GetReviews))
+	r.Handle("/{id:[0-9]+}/reviews", getReviewsHandlerFunc).Methods("GET")
+
+	createReviewHandlerFunc := eventsWrite(mws.Verified(mws.GetVars(http.HandlerFunc(delivery.CreateReview))))
+	r.Handle("/{id:[0-9]+}/reviews", createReviewHandlerFunc).Methods("POST")
+	//Избранное (favourite) - закладка, участие отмечается отдельно через rsvp
+	setRsvpHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.SetRsvp)))
+	r.Handle("/{id:[0-9]+}/rsvp", setRsvpHandlerFunc).Methods("POST")
+
+	deleteRsvpHandlerFunc := eventsWrite(mws.GetVars(http.HandlerFunc(delivery.DeleteRsvp)))
+	r.Handle("/{id:[0-9]+}/rsvp", deleteRsvpHandlerFunc).Methods("DELETE")
+
+	getRsvpHandlerFunc := mws.Auth(mws.GetVars(http.HandlerFunc(delivery.GetRsvp)))
+	r.Handle("/{id:[0-9]+}/rsvp", getRsvpHandlerFunc).Methods("GET")
+
+	getAttendeesHandlerFunc := mws.GetVars(http.HandlerFunc(uDelivery.GetAttendees))
+	r.Handle("/{id:[0-9]+}/attendees", getAttendeesHandlerFunc).Methods("GET")
+}
+
+func AdminHTTPEndpoints(r *mux.Router, aDelivery *authHttp.Delivery, eDelivery *eventHttp.Delivery, mws *middleware.Middlewares) {
+	moderator := mws.RequireRole(models.RoleModerator)
+	admin := mws.RequireRole(models.RoleAdmin)
+
+	forceDeleteEventHandlerFunc := mws.Auth(moderator(mws.GetVars(http.HandlerFunc(eDelivery.ForceDeleteEvent))))
+	r.Handle("/events/{id:[0-9]+}", forceDeleteEventHandlerFunc).Methods("DELETE")
+
+	hideEventHandlerFunc := mws.Auth(moderator(mws.GetVars(http.HandlerFunc(eDelivery.HideEvent))))
+	r.Handle("/events/{id:[0-9]+}/hidden", hideEventHandlerFunc).Methods("POST")
+
+	unhideEventHandlerFunc := mws.Auth(moderator(mws.GetVars(http.HandlerFunc(eDelivery.UnhideEvent))))
+	r.Handle("/events/{id:[0-9]+}/hidden", unhideEventHandlerFunc).Methods("DELETE")
+	//
+	banUserHandlerFunc := mws.Auth(moderator(mws.GetVars(http.HandlerFunc(aDelivery.BanUser))))
+	r.Handle("/users/{id:[0-9]+}/ban", banUserHandlerFunc).Methods("POST")
+
+	unbanUserHandlerFunc := mws.Auth(moderator(mws.GetVars(http.HandlerFunc(aDelivery.UnbanUser))))
+	r.Handle("/users/{id:[0-9]+}/ban", unbanUserHandlerFunc).Methods("DELETE")
+
+	setRoleHandlerFunc := mws.Auth(admin(mws.GetVars(http.HandlerFunc(aDelivery.SetRole))))
+	r.Handle("/users/{id:[0-9]+}/role", setRoleHandlerFunc).Methods("POST")
+
+	queryAuthEventsHandlerFunc := mws.Auth(admin(mws.GetVars(http.HandlerFunc(aDelivery.QueryAuthEvents))))
+	r.Handle("/auth-events", queryAuthEventsHandlerFunc).Methods("GET")
 }
@@ -8,6 +8,7 @@ import (
 func TestRegister(t *testing.T) {
 	r := mux.NewRouter()
 	AuthHTTPEndpoints(r, nil, nil)
-	UserHTTPEndpoints(r, nil, nil, nil)
-	EventHTTPEndpoints(r, nil, nil)
+	UserHTTPEndpoints(r, nil, nil, nil, nil)
+	EventHTTPEndpoints(r, nil, nil, nil)
+	AdminHTTPEndpoints(r, nil, nil, nil)
 }
@@ -21,6 +21,13 @@ func StatusResponse(status HttpStatus) *Response {
 	}
 }
 
+func TooManyRequestsResponse(errorMessage string) *Response {
+	return &Response{
+		Status:  429,
+		Message: errorMessage,
+	}
+}
+
 func OkResponse() *Response {
 	return &Response{
 		Status:  200,
@@ -43,11 +50,77 @@ func UserResponse(user *models.User) *Response {
 	}
 }
 
-func UserListResponse(users []*models.User) *Response {
+func UserListResponse(users []*models.User, nextCursor string) *Response {
+	body := MakeUserListResponseBody(users)
+	body.NextCursor = nextCursor
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    body,
+	}
+}
+
+func TwoFactorChallengeResponse(challengeToken string) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body: models.TwoFactorChallengeResponseBody{
+			TwoFactorRequired: true,
+			ChallengeToken:    challengeToken,
+		},
+	}
+}
+
+func TotpEnrollmentResponse(secret string, uri string) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body: models.TotpEnrollmentResponseBody{
+			Secret: secret,
+			URI:    uri,
+		},
+	}
+}
+
+func RecoveryCodesResponse(codes []string) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body: models.RecoveryCodesResponseBody{
+			Codes: codes,
+		},
+	}
+}
+
+func SessionListResponse(sessions []*models.Session) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    MakeSessionListResponseBody(sessions),
+	}
+}
+
+func AuthEventListResponse(events []*models.AuthEvent) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    MakeAuthEventListResponseBody(events),
+	}
+}
+
+func ApiTokenResponse(token *models.ApiToken) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    MakeApiTokenResponseBody(token),
+	}
+}
+
+func ApiTokenListResponse(tokens []*models.ApiToken) *Response {
 	return &Response{
 		Status:  200,
 		Message: "",
-		Body:    MakeUserListResponseBody(users),
+		Body:    MakeApiTokenListResponseBody(tokens),
 	}
 }
 
@@ -69,11 +142,42 @@ func EventIdResponse(eventID string) *Response {
 	}
 }
 
-func EventListResponse(events []*models.Event) *Response {
+func EventListResponse(events []*models.Event, nextCursor string) *Response {
+	body := MakeEventListResponseBody(events)
+	body.NextCursor = nextCursor
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    body,
+	}
+}
+
+func RegistrationResponse(registration *models.Registration) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body: models.RegistrationResponseBody{
+			Status:     registration.Status,
+			Position:   registration.Position,
+			Registered: registration.Registered,
+			Capacity:   registration.Capacity,
+		},
+	}
+}
+
+func EventStatsResponse(stats *models.EventStats) *Response {
 	return &Response{
 		Status:  200,
 		Message: "",
-		Body:    MakeEventListResponseBody(events),
+		Body:    MakeEventStatsResponseBody(stats),
+	}
+}
+
+func EventMapResponse(events []*models.Event) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    MakeEventMapResponseBody(events),
 	}
 }
 
@@ -87,6 +191,78 @@ func SubscribedResponse(result bool) *Response {
 	}
 }
 
+func RsvpResponse(rsvp *models.Rsvp) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body: models.RsvpResponseBody{
+			Status:     rsvp.Status,
+			Going:      rsvp.Going,
+			Interested: rsvp.Interested,
+		},
+	}
+}
+
+func AttendanceResponse(attendance *models.Attendance) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body: models.AttendanceResponseBody{
+			Attended:   attendance.Attended,
+			Registered: attendance.Registered,
+		},
+	}
+}
+
+func CheckInResponse(checkIn *models.CheckIn) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body: models.CheckInResponseBody{
+			UserId:      checkIn.UserId,
+			CheckedInAt: checkIn.CheckedInAt,
+			Attended:    checkIn.Attendance.Attended,
+			Registered:  checkIn.Attendance.Registered,
+		},
+	}
+}
+
+func CommentResponse(c *models.Comment) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    MakeCommentResponseBody(c),
+	}
+}
+
+func CommentListResponse(comments []*models.Comment, nextCursor string) *Response {
+	body := MakeCommentListResponseBody(comments)
+	body.NextCursor = nextCursor
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    body,
+	}
+}
+
+func ReviewResponse(r *models.Review) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    MakeReviewResponseBody(r),
+	}
+}
+
+func ReviewListResponse(reviews []*models.Review, nextCursor string) *Response {
+	body := MakeReviewListResponseBody(reviews)
+	body.NextCursor = nextCursor
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    body,
+	}
+}
+
 func FavouriteResponse(result bool) *Response {
 	return &Response{
 		Status:  200,
@@ -106,3 +282,11 @@ func CitiesResponse(cities []string) *Response {
 		},
 	}
 }
+
+func UserExportResponse(export *models.UserExport) *Response {
+	return &Response{
+		Status:  200,
+		Message: "",
+		Body:    MakeUserExportResponseBody(export),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-sanitize/sanitize"
 	"io"
 	"net/http"
+	"strings"
 )
 
 var (
@@ -50,21 +51,115 @@ func GetUserFromRequest(r io.Reader) (*models.User, error) {
 		Name:     userInput.Name,
 		Surname:  userInput.Surname,
 		Mail:     userInput.Mail,
-		Password: userInput.Password,
-		About:    userInput.About,
+		Password:   userInput.Password,
+		About:      userInput.About,
+		RememberMe: userInput.RememberMe,
 	}
 	return result, nil
 }
 
+func GetPasswordResetFromRequest(r io.Reader) (*models.PasswordResetResponseBody, error) {
+	resetInput := new(models.PasswordResetResponseBody)
+	err := json.NewDecoder(r).Decode(resetInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(resetInput)
+	if err != nil {
+		return nil, err
+	}
+	return resetInput, nil
+}
+
+func GetRoleFromRequest(r io.Reader) (*models.RoleResponseBody, error) {
+	roleInput := new(models.RoleResponseBody)
+	err := json.NewDecoder(r).Decode(roleInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(roleInput)
+	if err != nil {
+		return nil, err
+	}
+	return roleInput, nil
+}
+
+func GetRsvpFromRequest(r io.Reader) (*models.RsvpResponseBody, error) {
+	rsvpInput := new(models.RsvpResponseBody)
+	err := json.NewDecoder(r).Decode(rsvpInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(rsvpInput)
+	if err != nil {
+		return nil, err
+	}
+	return rsvpInput, nil
+}
+
+func GetTicketCodeFromRequest(r io.Reader) (*models.TicketCodeResponseBody, error) {
+	codeInput := new(models.TicketCodeResponseBody)
+	err := json.NewDecoder(r).Decode(codeInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(codeInput)
+	if err != nil {
+		return nil, err
+	}
+	return codeInput, nil
+}
+
+func GetTwoFactorFromRequest(r io.Reader) (*models.TwoFactorResponseBody, error) {
+	twoFactorInput := new(models.TwoFactorResponseBody)
+	err := json.NewDecoder(r).Decode(twoFactorInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(twoFactorInput)
+	if err != nil {
+		return nil, err
+	}
+	return twoFactorInput, nil
+}
+
+func GetMagicLinkFromRequest(r io.Reader) (*models.MagicLinkResponseBody, error) {
+	magicLinkInput := new(models.MagicLinkResponseBody)
+	err := json.NewDecoder(r).Decode(magicLinkInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(magicLinkInput)
+	if err != nil {
+		return nil, err
+	}
+	return magicLinkInput, nil
+}
+
+func GetEmailChangeFromRequest(r io.Reader) (*models.EmailChangeResponseBody, error) {
+	emailChangeInput := new(models.EmailChangeResponseBody)
+	err := json.NewDecoder(r).Decode(emailChangeInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(emailChangeInput)
+	if err != nil {
+		return nil, err
+	}
+	return emailChangeInput, nil
+}
+
 func MakeUserResponseBody(u *models.User) models.UserResponseBody {
 	return models.UserResponseBody{
-		ID:       u.ID,
-		Name:     u.Name,
-		Surname:  u.Surname,
-		About:    u.About,
-		ImgUrl:   u.ImgUrl,
-		Mail:     u.Mail,
-		Password: u.Password,
+		ID:          u.ID,
+		Name:        u.Name,
+		Surname:     u.Surname,
+		About:       u.About,
+		ImgUrl:      u.ImgUrl,
+		Mail:        u.Mail,
+		Password:    u.Password,
+		Reputation:  u.Reputation,
+		ReviewCount: u.ReviewCount,
 	}
 }
 
@@ -78,6 +173,76 @@ func MakeUserListResponseBody(users []*models.User) models.UserListResponseBody
 	}
 }
 
+func MakeSessionListResponseBody(sessions []*models.Session) models.SessionListResponseBody {
+	result := make([]models.SessionResponseBody, len(sessions))
+	for i := 0; i < len(sessions); i++ {
+		result[i] = models.SessionResponseBody{
+			ID:        sessions[i].ID,
+			CreatedAt: sessions[i].CreatedAt,
+			LastSeen:  sessions[i].LastSeen,
+			UserAgent: sessions[i].UserAgent,
+			IP:        sessions[i].IP,
+			Current:   sessions[i].Current,
+		}
+	}
+	return models.SessionListResponseBody{
+		Sessions: result,
+	}
+}
+
+func MakeAuthEventListResponseBody(events []*models.AuthEvent) models.AuthEventListResponseBody {
+	result := make([]models.AuthEventResponseBody, len(events))
+	for i := 0; i < len(events); i++ {
+		result[i] = models.AuthEventResponseBody{
+			ID:        events[i].ID,
+			UserId:    events[i].UserId,
+			Type:      events[i].Type,
+			Mail:      events[i].Mail,
+			IP:        events[i].IP,
+			UserAgent: events[i].UserAgent,
+			CreatedAt: events[i].CreatedAt,
+		}
+	}
+	return models.AuthEventListResponseBody{
+		Events: result,
+	}
+}
+
+func GetApiTokenFromRequest(r io.Reader) (*models.ApiTokenResponseBody, error) {
+	tokenInput := new(models.ApiTokenResponseBody)
+	err := json.NewDecoder(r).Decode(tokenInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(tokenInput)
+	if err != nil {
+		return nil, err
+	}
+	return tokenInput, nil
+}
+
+func MakeApiTokenResponseBody(token *models.ApiToken) models.ApiTokenResponseBody {
+	return models.ApiTokenResponseBody{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		Token:      token.Token,
+	}
+}
+
+func MakeApiTokenListResponseBody(tokens []*models.ApiToken) models.ApiTokenListResponseBody {
+	result := make([]models.ApiTokenResponseBody, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		result[i] = MakeApiTokenResponseBody(tokens[i])
+	}
+	return models.ApiTokenListResponseBody{
+		Tokens: result,
+	}
+}
+
 func GetEventFromRequest(r io.Reader) (*models.Event, error) {
 	eventInput := new(models.EventResponseBody)
 	err := json.NewDecoder(r).Decode(eventInput)
@@ -88,6 +253,11 @@ func GetEventFromRequest(r io.Reader) (*models.Event, error) {
 	if err != nil {
 		return nil, err
 	}
+	//viewed считает сервер, значение из запроса не используется
+	//Координаты передаются только парой
+	if (eventInput.Lat == nil) != (eventInput.Lon == nil) {
+		return nil, ErrValidation
+	}
 	result := &models.Event{
 		ID:          eventInput.ID,
 		Title:       eventInput.Title,
@@ -95,19 +265,25 @@ func GetEventFromRequest(r io.Reader) (*models.Event, error) {
 		Text:        eventInput.Text,
 		City:        eventInput.City,
 		Category:    eventInput.Category,
-		Viewed:      eventInput.Viewed,
 		ImgUrl:      eventInput.ImgUrl,
 		Tag:         eventInput.Tag,
 		Date:        eventInput.Date,
+		StartsAt:    eventInput.StartsAt,
+		EndsAt:      eventInput.EndsAt,
+		Timezone:    eventInput.Timezone,
 		Geo:         eventInput.Geo,
+		Capacity:    eventInput.Capacity,
 		Address:	 eventInput.Address,
 		
 	}
+	if eventInput.Lat != nil {
+		result.Location = &models.Location{Lat: *eventInput.Lat, Lon: *eventInput.Lon}
+	}
 	return result, nil
 }
 
 func MakeEventResponseBody(e *models.Event) models.EventResponseBody {
-	return models.EventResponseBody{
+	body := models.EventResponseBody{
 		ID:          e.ID,
 		Title:       e.Title,
 		Description: e.Description,
@@ -118,10 +294,23 @@ func MakeEventResponseBody(e *models.Event) models.EventResponseBody {
 		ImgUrl:      e.ImgUrl,
 		Tag:         e.Tag,
 		Date:        e.Date,
+		StartsAt:    e.StartsAt,
+		EndsAt:      e.EndsAt,
+		Timezone:    e.Timezone,
 		Geo:         e.Geo,
+		Capacity:    e.Capacity,
 		Address: 	 e.Address,
 		AuthorID:    e.AuthorId,
+		Snippet:     e.Snippet,
+		Rating:      e.RatingAvg,
+		RatingCount: e.RatingCount,
 	}
+	if e.Location != nil {
+		lat, lon := e.Location.Lat, e.Location.Lon
+		body.Lat = &lat
+		body.Lon = &lon
+	}
+	return body
 }
 
 func MakeEventListResponseBody(events []*models.Event) models.EventListResponseBody {
@@ -134,6 +323,146 @@ func MakeEventListResponseBody(events []*models.Event) models.EventListResponseB
 	}
 }
 
+//Из запроса берутся только текст и parentId, остальное заполняет сервер.
+//Текст, от которого после санитизации ничего не осталось, не принимается
+func GetCommentFromRequest(r io.Reader) (*models.Comment, error) {
+	commentInput := new(models.CommentResponseBody)
+	err := json.NewDecoder(r).Decode(commentInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(commentInput)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(commentInput.Text) == "" {
+		return nil, ErrValidation
+	}
+	return &models.Comment{
+		ParentId: commentInput.ParentId,
+		Text:     commentInput.Text,
+	}, nil
+}
+
+func MakeCommentResponseBody(c *models.Comment) models.CommentResponseBody {
+	return models.CommentResponseBody{
+		ID:        c.ID,
+		EventId:   c.EventId,
+		UserId:    c.UserId,
+		ParentId:  c.ParentId,
+		Text:      c.Text,
+		CreatedAt: c.CreatedAt,
+		EditedAt:  c.EditedAt,
+		Deleted:   c.Deleted,
+		Replies:   c.Replies,
+	}
+}
+
+func MakeCommentListResponseBody(comments []*models.Comment) models.CommentListResponseBody {
+	result := make([]models.CommentResponseBody, len(comments))
+	for i, c := range comments {
+		result[i] = MakeCommentResponseBody(c)
+	}
+	return models.CommentListResponseBody{
+		Comments: result,
+	}
+}
+
+//Из запроса берутся только оценка и текст. Оценку 0 (не передана) валидатор пропускает,
+//её отклоняет usecase
+func GetReviewFromRequest(r io.Reader) (*models.Review, error) {
+	reviewInput := new(models.ReviewResponseBody)
+	err := json.NewDecoder(r).Decode(reviewInput)
+	if err != nil {
+		return nil, ErrJSONDecoding
+	}
+	err = ValidateAndSanitize(reviewInput)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Review{
+		Rating: reviewInput.Rating,
+		Text:   strings.TrimSpace(reviewInput.Text),
+	}, nil
+}
+
+func MakeReviewResponseBody(r *models.Review) models.ReviewResponseBody {
+	return models.ReviewResponseBody{
+		ID:        r.ID,
+		EventId:   r.EventId,
+		UserId:    r.UserId,
+		Rating:    r.Rating,
+		Text:      r.Text,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func MakeReviewListResponseBody(reviews []*models.Review) models.ReviewListResponseBody {
+	result := make([]models.ReviewResponseBody, len(reviews))
+	for i, r := range reviews {
+		result[i] = MakeReviewResponseBody(r)
+	}
+	return models.ReviewListResponseBody{
+		Reviews: result,
+	}
+}
+
+func MakeEventStatsResponseBody(stats *models.EventStats) models.EventStatsResponseBody {
+	days := make([]models.DayStatsResponseBody, len(stats.Days))
+	for i, day := range stats.Days {
+		days[i] = models.DayStatsResponseBody{
+			Date:       day.Date,
+			Views:      day.Views,
+			Favourites: day.Favourites,
+		}
+	}
+	return models.EventStatsResponseBody{
+		EventID: stats.EventId,
+		Days:    days,
+	}
+}
+
+//На карту попадают только мероприятия с координатами
+func MakeEventMapResponseBody(events []*models.Event) models.GeoJSONFeatureCollection {
+	result := models.GeoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]models.GeoJSONFeature, 0, len(events)),
+	}
+	for _, e := range events {
+		if e.Location == nil {
+			continue
+		}
+		result.Features = append(result.Features, models.GeoJSONFeature{
+			Type: "Feature",
+			ID:   e.ID,
+			Geometry: models.GeoJSONPoint{
+				Type:        "Point",
+				Coordinates: [2]float64{e.Location.Lon, e.Location.Lat},
+			},
+			Properties: models.EventMapPropertiesBody{
+				Title:    e.Title,
+				Category: e.Category,
+				ImgUrl:   e.ImgUrl,
+				StartsAt: e.StartsAt,
+				EndsAt:   e.EndsAt,
+				Address:  e.Address,
+			},
+		})
+	}
+	return result
+}
+
+func SendResponseWithStatus(w http.ResponseWriter, status int, response interface{}) {
+	message := logMessage + "SendResponseWithStatus:"
+	w.WriteHeader(status)
+	b, err := json.Marshal(response)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return
+	}
+	w.Write(b)
+}
+
 func SendResponse(w http.ResponseWriter, response interface{}) {
 	message := logMessage + "SendResponse:"
 	w.WriteHeader(http.StatusOK)
@@ -144,3 +473,23 @@ func SendResponse(w http.ResponseWriter, response interface{}) {
 	}
 	w.Write(b)
 }
+
+//Images - загруженные самим пользователем картинки: аватар и обложки его мероприятий
+func MakeUserExportResponseBody(export *models.UserExport) models.UserExportResponseBody {
+	images := []string{}
+	if export.User.ImgUrl != "" {
+		images = append(images, export.User.ImgUrl)
+	}
+	for _, e := range export.CreatedEvents {
+		if e.ImgUrl != "" {
+			images = append(images, e.ImgUrl)
+		}
+	}
+	return models.UserExportResponseBody{
+		Profile:       MakeUserResponseBody(export.User),
+		CreatedEvents: MakeEventListResponseBody(export.CreatedEvents).Events,
+		Favourites:    MakeEventListResponseBody(export.Favourites).Events,
+		Subscriptions: MakeUserListResponseBody(export.Subscriptions).Users,
+		Images:        images,
+	}
+}
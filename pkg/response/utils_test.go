@@ -3,6 +3,7 @@ package response
 import (
 	"errors"
 	"github.com/stretchr/testify/require"
+	"strings"
 	"testing"
 )
 
@@ -10,3 +11,17 @@ func TestUtils(t *testing.T) {
 	err := errors.New("")
 	require.Error(t, err)
 }
+
+func TestGetCommentFromRequest(t *testing.T) {
+	c, err := GetCommentFromRequest(strings.NewReader(`{"text":"<b>Пойду</b><script>alert(1)</script>","parentId":"3","userId":"1"}`))
+	require.NoError(t, err)
+	require.NotContains(t, c.Text, "<")
+	require.Contains(t, c.Text, "Пойду")
+	require.Equal(t, "3", c.ParentId)
+	require.Equal(t, "", c.UserId)
+
+	_, err = GetCommentFromRequest(strings.NewReader(`{"text":"   "}`))
+	require.Equal(t, ErrValidation, err)
+	_, err = GetCommentFromRequest(strings.NewReader(`{"text":"ok","parentId":"x"}`))
+	require.Equal(t, ErrValidation, err)
+}
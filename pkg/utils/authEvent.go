@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"backend/pkg/models"
+	sql2 "database/sql"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	insertAuthEventQuery = `insert into "auth_event" (user_id, type, mail, ip, user_agent) values ($1, $2, $3, $4, $5)`
+
+	maxAuthEventMailLength      = 150
+	maxAuthEventIPLength        = 45
+	maxAuthEventUserAgentLength = 255
+)
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) > length {
+		return string(runes[:length])
+	}
+	return value
+}
+
+//Добавляет запись в журнал безопасности. Пишут в него и auth, и user-микросервис,
+//поэтому execer - *sqlx.DB или транзакция, в которой меняются данные
+func RecordAuthEvent(execer sqlx.Execer, event *models.AuthEvent) error {
+	userId := sql2.NullInt64{}
+	if id, err := strconv.Atoi(event.UserId); err == nil {
+		userId = sql2.NullInt64{Int64: int64(id), Valid: true}
+	}
+	_, err := execer.Exec(insertAuthEventQuery,
+		userId,
+		event.Type,
+		truncate(event.Mail, maxAuthEventMailLength),
+		truncate(event.IP, maxAuthEventIPLength),
+		truncate(event.UserAgent, maxAuthEventUserAgentLength))
+	return err
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"backend/pkg/models"
+	sql2 "database/sql"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAuthEvent(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+	sqlxDB := sqlx.NewDb(db, "sqlmock")
+
+	userAgent := strings.Repeat("a", 300)
+	mock.ExpectExec(insertAuthEventQuery).
+		WithArgs(sql2.NullInt64{Int64: 1, Valid: true}, models.AuthEventLogin, "", "127.0.0.1", userAgent[:maxAuthEventUserAgentLength]).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	err = RecordAuthEvent(sqlxDB, &models.AuthEvent{
+		UserId:    "1",
+		Type:      models.AuthEventLogin,
+		IP:        "127.0.0.1",
+		UserAgent: userAgent,
+	})
+	assert.NoError(t, err)
+
+	//Неудачный вход на несуществующую почту - без user_id
+	mock.ExpectExec(insertAuthEventQuery).
+		WithArgs(sql2.NullInt64{}, models.AuthEventLoginFailed, "unknown@mail.ru", "", "").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	err = RecordAuthEvent(sqlxDB, &models.AuthEvent{
+		Type: models.AuthEventLoginFailed,
+		Mail: "unknown@mail.ru",
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+//Данные клиента для журнала безопасности и защиты от перебора: gateway кладёт их
+//в метаданные исходящего запроса, микросервисы читают из входящего
+func ClientContext(ctx context.Context, ip string, userAgent string) context.Context {
+	if ip != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, ClientIPMetadataKey, ip)
+	}
+	if userAgent != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, UserAgentMetadataKey, userAgent)
+	}
+	return ctx
+}
+
+func ClientFromContext(ctx context.Context) (string, string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	return firstMetadataValue(md, ClientIPMetadataKey), firstMetadataValue(md, UserAgentMetadataKey)
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
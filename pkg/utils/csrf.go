@@ -0,0 +1,238 @@
+package utils
+
+import (
+	log "backend/pkg/logger"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/spf13/viper"
+)
+
+//Время жизни CSRF-токена. Ключ можно выводить из ротации (retired) не раньше,
+//чем через csrfTokenLifetime после того, как им перестали подписывать
+const csrfTokenLifetime = time.Hour * 7 * 24
+
+//Идентификатор ключа из переменной окружения CSRFSECRET. Им же проверяются
+//токены, выпущенные до появления kid в заголовке
+const CsrfLegacyKeyId = "default"
+
+var (
+	ErrCsrfNoSigningKey = errors.New("no active csrf signing key")
+	ErrCsrfDuplicateKey = errors.New("duplicate csrf key id")
+	ErrCsrfEmptyKey     = errors.New("csrf key id or secret is empty")
+	ErrCsrfUnknownKey   = errors.New("unknown or retired csrf key")
+)
+
+type CsrfKey struct {
+	ID        string
+	Secret    []byte
+	CreatedAt time.Time
+	//Ключом больше не подписывают и токены с ним не принимают
+	Retired bool
+}
+
+//Описание ключа в config.yml: секрет задаётся либо напрямую, либо именем переменной окружения
+type csrfKeyConfig struct {
+	ID        string `mapstructure:"id"`
+	Secret    string `mapstructure:"secret"`
+	SecretEnv string `mapstructure:"secret_env"`
+	CreatedAt string `mapstructure:"created_at"`
+	Retired   bool   `mapstructure:"retired"`
+}
+
+// CsrfKeyring подписывает токены самым новым активным ключом и проверяет их любым
+// не выведенным из ротации ключом, найденным по kid из заголовка JWT
+type CsrfKeyring struct {
+	keys    map[string]CsrfKey
+	signing CsrfKey
+}
+
+func NewCsrfKeyring(keys []CsrfKey) (*CsrfKeyring, error) {
+	keyring := &CsrfKeyring{
+		keys: make(map[string]CsrfKey, len(keys)),
+	}
+	found := false
+	for _, key := range keys {
+		//Пустым секретом токен подпишет кто угодно
+		if key.ID == "" || len(key.Secret) == 0 {
+			return nil, ErrCsrfEmptyKey
+		}
+		if _, ok := keyring.keys[key.ID]; ok {
+			return nil, ErrCsrfDuplicateKey
+		}
+		keyring.keys[key.ID] = key
+		if key.Retired {
+			continue
+		}
+		//При равных датах побеждает ключ, объявленный позже
+		if !found || !key.CreatedAt.Before(keyring.signing.CreatedAt) {
+			keyring.signing = key
+			found = true
+		}
+	}
+	if !found {
+		return nil, ErrCsrfNoSigningKey
+	}
+	return keyring, nil
+}
+
+func parseCsrfKeyDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// LoadCsrfKeyring читает ключи из секции csrf.keys конфига. Если секция пуста,
+// используется единственный ключ из CSRFSECRET, как было до ротации
+func LoadCsrfKeyring() (*CsrfKeyring, error) {
+	message := logMessage + "LoadCsrfKeyring:"
+	var configs []csrfKeyConfig
+	err := viper.UnmarshalKey("csrf.keys", &configs)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return nil, err
+	}
+	if len(configs) == 0 {
+		secret := os.Getenv("CSRFSECRET")
+		if secret == "" {
+			log.Error(message + "no csrf.keys in config and CSRFSECRET is empty")
+			return nil, ErrCsrfEmptyKey
+		}
+		return NewCsrfKeyring([]CsrfKey{{
+			ID:     CsrfLegacyKeyId,
+			Secret: []byte(secret),
+		}})
+	}
+	keys := make([]CsrfKey, 0, len(configs))
+	for _, config := range configs {
+		secret := config.Secret
+		if config.SecretEnv != "" {
+			secret = os.Getenv(config.SecretEnv)
+		}
+		if secret == "" {
+			log.Error(message+"empty secret for kid =", config.ID)
+			return nil, ErrCsrfEmptyKey
+		}
+		createdAt, err := parseCsrfKeyDate(config.CreatedAt)
+		if err != nil {
+			log.Error(message+"err =", err)
+			return nil, err
+		}
+		keys = append(keys, CsrfKey{
+			ID:        config.ID,
+			Secret:    []byte(secret),
+			CreatedAt: createdAt,
+			Retired:   config.Retired,
+		})
+	}
+	return NewCsrfKeyring(keys)
+}
+
+func (k *CsrfKeyring) SigningKeyId() string {
+	return k.signing.ID
+}
+
+func (k *CsrfKeyring) Sign(userId string) (string, error) {
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
+		ID:        userId,
+		ExpiresAt: jwt.At(time.Now().Add(csrfTokenLifetime)), //Week  P.S. Maybe Frontend should ask us
+	})
+	jwtToken.Header["kid"] = k.signing.ID
+	return jwtToken.SignedString(k.signing.Secret)
+}
+
+func (k *CsrfKeyring) keyFunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		return nil, jwt.ErrHashUnavailable
+	}
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		kid = CsrfLegacyKeyId
+	}
+	key, ok := k.keys[kid]
+	if !ok || key.Retired {
+		return nil, ErrCsrfUnknownKey
+	}
+	return key.Secret, nil
+}
+
+// Parse проверяет подпись и срок действия токена и возвращает userId
+func (k *CsrfKeyring) Parse(susToken string) (string, error) {
+	token, err := jwt.ParseWithClaims(susToken, &jwt.StandardClaims{}, k.keyFunc)
+	if err != nil {
+		return "", err
+	}
+	if claims, ok := token.Claims.(*jwt.StandardClaims); ok && token.Valid {
+		if claims.ExpiresAt.Before(time.Now()) {
+			return "", jwt.ErrHashUnavailable
+		}
+		return claims.ID, nil
+	}
+	return "", jwt.ErrHashUnavailable
+}
+
+var (
+	csrfKeyringMu sync.Mutex
+	csrfKeyring   *CsrfKeyring
+)
+
+// InitCsrfKeyring загружает ключи из конфига; вызывается при старте после чтения конфига
+func InitCsrfKeyring() error {
+	keyring, err := LoadCsrfKeyring()
+	if err != nil {
+		return err
+	}
+	SetCsrfKeyring(keyring)
+	return nil
+}
+
+func SetCsrfKeyring(keyring *CsrfKeyring) {
+	csrfKeyringMu.Lock()
+	defer csrfKeyringMu.Unlock()
+	csrfKeyring = keyring
+}
+
+// GetCsrfKeyring возвращает текущую связку ключей, при первом обращении загружая её из конфига
+func GetCsrfKeyring() (*CsrfKeyring, error) {
+	csrfKeyringMu.Lock()
+	defer csrfKeyringMu.Unlock()
+	if csrfKeyring != nil {
+		return csrfKeyring, nil
+	}
+	keyring, err := LoadCsrfKeyring()
+	if err != nil {
+		return nil, err
+	}
+	csrfKeyring = keyring
+	return csrfKeyring, nil
+}
+
+func GenerateCsrfToken(userId string) (string, error) {
+	message := logMessage + "GenerateCsrfToken:"
+	keyring, err := GetCsrfKeyring()
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", err
+	}
+	csrfToken, err := keyring.Sign(userId)
+	if err != nil {
+		log.Error(message+"err = ", err)
+		return "", err
+	}
+	return csrfToken, nil
+}
+
+func ParseCsrfToken(susToken string) (string, error) {
+	keyring, err := GetCsrfKeyring()
+	if err != nil {
+		return "", err
+	}
+	return keyring.Parse(susToken)
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCsrfKeys() []CsrfKey {
+	return []CsrfKey{
+		{ID: "old", Secret: []byte("old-secret"), CreatedAt: time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "new", Secret: []byte("new-secret"), CreatedAt: time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "retired", Secret: []byte("retired-secret"), CreatedAt: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC), Retired: true},
+	}
+}
+
+func signWithKey(t *testing.T, kid string, secret string) string {
+	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
+		ID:        "1",
+		ExpiresAt: jwt.At(time.Now().Add(time.Hour)),
+	})
+	if kid != "" {
+		jwtToken.Header["kid"] = kid
+	}
+	token, err := jwtToken.SignedString([]byte(secret))
+	assert.NoError(t, err)
+	return token
+}
+
+func TestNewCsrfKeyring(t *testing.T) {
+	keyring, err := NewCsrfKeyring(testCsrfKeys())
+	assert.NoError(t, err)
+	assert.Equal(t, "new", keyring.SigningKeyId())
+
+	_, err = NewCsrfKeyring([]CsrfKey{{ID: "a", Secret: []byte("a")}, {ID: "a", Secret: []byte("b")}})
+	assert.Equal(t, ErrCsrfDuplicateKey, err)
+
+	_, err = NewCsrfKeyring([]CsrfKey{{ID: "a", Secret: []byte("a"), Retired: true}})
+	assert.Equal(t, ErrCsrfNoSigningKey, err)
+
+	_, err = NewCsrfKeyring([]CsrfKey{{Secret: []byte("a")}})
+	assert.Equal(t, ErrCsrfEmptyKey, err)
+
+	_, err = NewCsrfKeyring([]CsrfKey{{ID: "a"}})
+	assert.Equal(t, ErrCsrfEmptyKey, err)
+}
+
+func TestCsrfKeyringSignAndParse(t *testing.T) {
+	keyring, err := NewCsrfKeyring(testCsrfKeys())
+	assert.NoError(t, err)
+
+	token, err := keyring.Sign("1")
+	assert.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.StandardClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "new", parsed.Header["kid"])
+	userId, err := keyring.Parse(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", userId)
+
+	var parseTests = []struct {
+		id      int
+		token   string
+		isError bool
+	}{
+		{1, signWithKey(t, "old", "old-secret"), false},
+		{2, signWithKey(t, "retired", "retired-secret"), true},
+		{3, signWithKey(t, "unknown", "old-secret"), true},
+		{4, signWithKey(t, "old", "new-secret"), true},
+		{5, signWithKey(t, "", "old-secret"), true},
+	}
+	for _, test := range parseTests {
+		userId, err := keyring.Parse(test.token)
+		if test.isError {
+			assert.Error(t, err, test.id)
+			continue
+		}
+		assert.NoError(t, err, test.id)
+		assert.Equal(t, "1", userId, test.id)
+	}
+}
+
+func TestCsrfKeyringLegacyToken(t *testing.T) {
+	keyring, err := NewCsrfKeyring([]CsrfKey{{ID: CsrfLegacyKeyId, Secret: []byte("secret")}})
+	assert.NoError(t, err)
+	userId, err := keyring.Parse(signWithKey(t, "", "secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1", userId)
+}
+
+func TestLoadCsrfKeyring(t *testing.T) {
+	defer viper.Reset()
+	t.Setenv("CSRF_KEY_NEW", "new-secret")
+	viper.Set("csrf.keys", []map[string]interface{}{
+		{"id": "old", "secret": "old-secret", "created_at": "2021-09-01"},
+		{"id": "new", "secret_env": "CSRF_KEY_NEW", "created_at": "2021-11-01T00:00:00Z"},
+	})
+	keyring, err := LoadCsrfKeyring()
+	assert.NoError(t, err)
+	assert.Equal(t, "new", keyring.SigningKeyId())
+	_, err = keyring.Parse(signWithKey(t, "new", "new-secret"))
+	assert.NoError(t, err)
+
+	viper.Set("csrf.keys", []map[string]interface{}{
+		{"id": "empty", "secret_env": "CSRF_KEY_MISSING"},
+	})
+	_, err = LoadCsrfKeyring()
+	assert.Equal(t, ErrCsrfEmptyKey, err)
+
+	viper.Reset()
+	t.Setenv("CSRFSECRET", "")
+	_, err = LoadCsrfKeyring()
+	assert.Equal(t, ErrCsrfEmptyKey, err)
+
+	t.Setenv("CSRFSECRET", "legacy")
+	keyring, err = LoadCsrfKeyring()
+	assert.NoError(t, err)
+	assert.Equal(t, CsrfLegacyKeyId, keyring.SigningKeyId())
+}
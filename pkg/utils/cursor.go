@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"backend/pkg/models"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid page cursor")
+	ErrInvalidLimit  = errors.New("invalid page limit")
+)
+
+//Курсор постраничной выдачи: значение ключа сортировки и id последней записи страницы.
+//Клиенту отдаётся непрозрачной строкой, следующая страница начинается строго после него
+type Cursor struct {
+	Key string `json:"k,omitempty"`
+	ID  int    `json:"id"`
+}
+
+func EncodeCursor(c *Cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+//Пустая строка - первая страница, курсор nil
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	c := &Cursor{}
+	err = json.Unmarshal(data, c)
+	if err != nil || c.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+func PageLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultPageLimit
+	}
+	if limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+	return limit
+}
+
+//Параметры страницы из query-строки: ?limit=&cursor=
+func GetPage(r *http.Request) (*models.Page, error) {
+	q := r.URL.Query()
+	page := &models.Page{Cursor: q.Get("cursor")}
+	if q.Get("limit") != "" {
+		limit, err := strconv.Atoi(q.Get("limit"))
+		if err != nil {
+			return nil, ErrInvalidLimit
+		}
+		page.Limit = limit
+	}
+	return page, nil
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"backend/pkg/models"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	encoded := EncodeCursor(&Cursor{Key: "15", ID: 7})
+	c, err := DecodeCursor(encoded)
+	require.NoError(t, err)
+	require.Equal(t, &Cursor{Key: "15", ID: 7}, c)
+
+	c, err = DecodeCursor("")
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	for _, s := range []string{"not base64!", EncodeCursor(&Cursor{Key: "1"}), "bm90IGpzb24"} {
+		_, err = DecodeCursor(s)
+		require.Equal(t, ErrInvalidCursor, err, s)
+	}
+}
+
+func TestPageLimit(t *testing.T) {
+	require.Equal(t, DefaultPageLimit, PageLimit(0))
+	require.Equal(t, DefaultPageLimit, PageLimit(-5))
+	require.Equal(t, 10, PageLimit(10))
+	require.Equal(t, MaxPageLimit, PageLimit(MaxPageLimit+1))
+}
+
+func TestGetPage(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/events?limit=5&cursor=abc", nil)
+	page, err := GetPage(r)
+	require.NoError(t, err)
+	require.Equal(t, &models.Page{Limit: 5, Cursor: "abc"}, page)
+
+	r = httptest.NewRequest(http.MethodGet, "/events", nil)
+	page, err = GetPage(r)
+	require.NoError(t, err)
+	require.Equal(t, &models.Page{}, page)
+
+	r = httptest.NewRequest(http.MethodGet, "/events?limit=a", nil)
+	_, err = GetPage(r)
+	require.Equal(t, ErrInvalidLimit, err)
+}
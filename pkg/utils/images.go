@@ -0,0 +1,42 @@
+package utils
+
+import (
+	log "backend/pkg/logger"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//Загруженные картинки лежат в ImagesDir и раздаются nginx по адресу ImagesUrl
+var (
+	ImagesDir = "/home/ubuntu/go/2021_2_Yo/static/images"
+	ImagesUrl = "https://bmstusa.ru/images/"
+)
+
+//Путь к файлу картинки по её адресу. Адреса не с нашего сервера (или ведущие
+//за пределы ImagesDir) файла не имеют
+func ImagePath(imgUrl string) (string, bool) {
+	if !strings.HasPrefix(imgUrl, ImagesUrl) {
+		return "", false
+	}
+	fileName := strings.TrimPrefix(imgUrl, ImagesUrl)
+	if fileName == "" || fileName != filepath.Base(fileName) || fileName == ".." {
+		return "", false
+	}
+	return filepath.Join(ImagesDir, fileName), true
+}
+
+//Ошибки только логируются: запись в базе уже удалена, а файл без ссылок на него безвреден
+func RemoveImages(imgUrls []string) {
+	message := logMessage + "RemoveImages:"
+	for _, imgUrl := range imgUrls {
+		path, ok := ImagePath(imgUrl)
+		if !ok {
+			continue
+		}
+		err := os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			log.Error(message+"err =", err)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagePath(t *testing.T) {
+	var imagePathTests = []struct {
+		id     int
+		imgUrl string
+		path   string
+		ok     bool
+	}{
+		{1, ImagesUrl + "image.png", filepath.Join(ImagesDir, "image.png"), true},
+		{2, "https://example.com/images/image.png", "", false},
+		{3, ImagesUrl + "../secret", "", false},
+		{4, ImagesUrl + "..", "", false},
+		{5, ImagesUrl, "", false},
+		{6, "", "", false},
+	}
+	for _, test := range imagePathTests {
+		path, ok := ImagePath(test.imgUrl)
+		assert.Equal(t, test.ok, ok, test.id)
+		assert.Equal(t, test.path, path, test.id)
+	}
+}
+
+func TestRemoveImages(t *testing.T) {
+	defaultDir := ImagesDir
+	defer func() { ImagesDir = defaultDir }()
+	ImagesDir = t.TempDir()
+
+	path := filepath.Join(ImagesDir, "image.png")
+	assert.NoError(t, os.WriteFile(path, []byte("png"), 0644))
+	RemoveImages([]string{ImagesUrl + "image.png", ImagesUrl + "missing.png", "https://example.com/other.png"})
+	_, err := os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argonTime    uint32 = 1
+	argonMemory  uint32 = 64 * 1024
+	argonThreads uint8  = 4
+	argonKeyLen  uint32 = 32
+	argonSaltLen        = 16
+	//Длина hex-строки старого хэша sha256 без соли
+	legacyHashLength = sha256.Size * 2
+)
+
+var (
+	ErrInvalidHash = errors.New("invalid password hash format")
+)
+
+// dummyPasswordHash - argon2id-хэш с текущими параметрами, которому не соответствует ни один
+// пароль пользователя. Проверка по нему занимает столько же, сколько настоящая
+const dummyPasswordHash = "$argon2id$v=19$m=65536,t=1,p=4$GI9HPHWVJYC0fJo6ErTM6Q$aAlgPbXeRVz6gCRx9Issy2Na0jaIB0vrUPbp036E+JQ"
+
+type argonParams struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+}
+
+// CreatePasswordHash возвращает argon2id-хэш со случайной солью в формате
+// $argon2id$v=19$m=65536,t=1,p=4$<salt>$<hash>, параметры хранятся вместе с хэшем
+func CreatePasswordHash(password string) (string, error) {
+	salt := make([]byte, argonSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	hash := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+	encoded := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argonMemory, argonTime, argonThreads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(hash))
+	return encoded, nil
+}
+
+// CheckPasswordHash сравнивает пароль с хэшем из БД. Второе значение сообщает,
+// что хэш устарел (sha256 без соли или старые параметры argon2) и его нужно пересчитать
+func CheckPasswordHash(password, encodedHash string) (bool, bool) {
+	if isLegacyPasswordHash(encodedHash) {
+		legacy := sha256.Sum256([]byte(password))
+		match := subtle.ConstantTimeCompare([]byte(hex.EncodeToString(legacy[:])), []byte(encodedHash)) == 1
+		return match, match
+	}
+	params, salt, hash, err := decodePasswordHash(encodedHash)
+	if err != nil {
+		return false, false
+	}
+	otherHash := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, uint32(len(hash)))
+	if subtle.ConstantTimeCompare(hash, otherHash) != 1 {
+		return false, false
+	}
+	needsRehash := params.memory != argonMemory || params.time != argonTime || params.threads != argonThreads ||
+		uint32(len(hash)) != argonKeyLen
+	return true, needsRehash
+}
+
+// CheckDummyPassword тратит на пароль столько же времени, сколько CheckPasswordHash,
+// чтобы по времени ответа нельзя было узнать, есть ли пользователь с такой почтой
+func CheckDummyPassword(password string) {
+	CheckPasswordHash(password, dummyPasswordHash)
+}
+
+func isLegacyPasswordHash(encodedHash string) bool {
+	if len(encodedHash) != legacyHashLength {
+		return false
+	}
+	_, err := hex.DecodeString(encodedHash)
+	return err == nil
+}
+
+func decodePasswordHash(encodedHash string) (*argonParams, []byte, []byte, error) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	params := &argonParams{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	hash, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(hash) == 0 {
+		return nil, nil, nil, ErrInvalidHash
+	}
+	return params, salt, hash, nil
+}
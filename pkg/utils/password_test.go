@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePasswordHash(t *testing.T) {
+	hash1, err := CreatePasswordHash("testPassword")
+	require.NoError(t, err)
+	hash2, err := CreatePasswordHash("testPassword")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hash1, "$argon2id$v=19$m=65536,t=1,p=4$"))
+	require.NotEqual(t, hash1, hash2)
+}
+
+var checkPasswordHashTests = []struct {
+	id          int
+	password    string
+	hash        string
+	match       bool
+	needsRehash bool
+}{
+	{
+		1,
+		"testPassword",
+		"",
+		true,
+		false,
+	},
+	{
+		2,
+		"wrongPassword",
+		"",
+		false,
+		false,
+	},
+	{
+		3,
+		"testPassword",
+		fmt.Sprintf("%x", sha256.Sum256([]byte("testPassword"))),
+		true,
+		true,
+	},
+	{
+		4,
+		"wrongPassword",
+		fmt.Sprintf("%x", sha256.Sum256([]byte("testPassword"))),
+		false,
+		false,
+	},
+	{
+		5,
+		"testPassword",
+		"$argon2id$v=19$m=1024,t=1,p=1$c29tZXNhbHQ$fYoKv1bS0nQ3pAF5RhWzp1/2SvDs1FfmXGo7aWAT8tY",
+		false,
+		false,
+	},
+	{
+		6,
+		"testPassword",
+		"not a hash",
+		false,
+		false,
+	},
+}
+
+func TestCheckPasswordHash(t *testing.T) {
+	defaultHash, err := CreatePasswordHash("testPassword")
+	require.NoError(t, err)
+	for _, test := range checkPasswordHashTests {
+		hash := test.hash
+		if hash == "" {
+			hash = defaultHash
+		}
+		match, needsRehash := CheckPasswordHash(test.password, hash)
+		require.Equal(t, test.match, match, test.id)
+		require.Equal(t, test.needsRehash, needsRehash, test.id)
+	}
+}
+
+// Проверка по dummyPasswordHash должна стоить столько же, сколько по хэшу с текущими параметрами
+func TestDummyPasswordHash(t *testing.T) {
+	params, _, hash, err := decodePasswordHash(dummyPasswordHash)
+	require.NoError(t, err)
+	require.Equal(t, &argonParams{memory: argonMemory, time: argonTime, threads: argonThreads}, params)
+	require.Len(t, hash, int(argonKeyLen))
+	match, _ := CheckPasswordHash("", dummyPasswordHash)
+	require.False(t, match)
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+//RFC 6238: HMAC-SHA1, 6 цифр, шаг 30 секунд - параметры по умолчанию для приложений-аутентификаторов
+const (
+	totpSecretLength = 20
+	totpDigits       = 6
+	totpPeriod       = 30
+	//Допускаем рассинхронизацию часов на один шаг в каждую сторону
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTotpSecret возвращает случайный секрет в base32 без паддинга
+func GenerateTotpSecret() (string, error) {
+	secret := make([]byte, totpSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TotpURI формирует otpauth:// ссылку для QR-кода в приложении-аутентификаторе
+func TotpURI(issuer string, account string, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", issuer)
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprint(totpDigits))
+	values.Set("period", fmt.Sprint(totpPeriod))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
+
+func totpCounterCode(key []byte, counter uint64) string {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(buf)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+func decodeTotpSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.TrimRight(strings.ReplaceAll(secret, " ", ""), "="))
+	return totpEncoding.DecodeString(secret)
+}
+
+// TotpCode возвращает код для момента t
+func TotpCode(secret string, t time.Time) (string, error) {
+	key, err := decodeTotpSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return totpCounterCode(key, uint64(t.Unix())/totpPeriod), nil
+}
+
+// CheckTotpCode проверяет код с учётом соседних временных шагов
+func CheckTotpCode(secret string, code string, t time.Time) bool {
+	key, err := decodeTotpSecret(secret)
+	if err != nil || len(code) != totpDigits {
+		return false
+	}
+	counter := int64(t.Unix()) / totpPeriod
+	for i := -totpSkew; i <= totpSkew; i++ {
+		expected := totpCounterCode(key, uint64(counter+int64(i)))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return true
+		}
+	}
+	return false
+}
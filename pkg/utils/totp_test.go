@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+//Секрет "12345678901234567890" из RFC 6238, коды - младшие 6 цифр эталонных значений
+const rfcTotpSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+var totpCodeTests = []struct {
+	id   int
+	unix int64
+	code string
+}{
+	{1, 59, "287082"},
+	{2, 1111111109, "081804"},
+	{3, 1234567890, "005924"},
+	{4, 2000000000, "279037"},
+}
+
+func TestTotpCode(t *testing.T) {
+	for _, test := range totpCodeTests {
+		code, err := TotpCode(rfcTotpSecret, time.Unix(test.unix, 0))
+		require.NoError(t, err, test.id)
+		require.Equal(t, test.code, code, test.id)
+	}
+}
+
+func TestCheckTotpCode(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	require.True(t, CheckTotpCode(rfcTotpSecret, "005924", now))
+	require.True(t, CheckTotpCode(rfcTotpSecret, "005924", now.Add(totpPeriod*time.Second)))
+	require.False(t, CheckTotpCode(rfcTotpSecret, "005924", now.Add(3*totpPeriod*time.Second)))
+	require.False(t, CheckTotpCode(rfcTotpSecret, "000000", now))
+	require.False(t, CheckTotpCode(rfcTotpSecret, "5924", now))
+	require.False(t, CheckTotpCode("not base32!", "005924", now))
+}
+
+func TestGenerateTotpSecret(t *testing.T) {
+	secret, err := GenerateTotpSecret()
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+	uri := TotpURI("BMSTUSA", "test@mail.ru", secret)
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/BMSTUSA:test@mail.ru?"))
+	require.Contains(t, uri, "secret="+secret)
+}
@@ -3,20 +3,18 @@ package utils
 import (
 	log "backend/pkg/logger"
 	"backend/pkg/response"
-	"crypto/sha256"
 	"errors"
 	"fmt"
-	"github.com/dgrijalva/jwt-go/v4"
 	"github.com/go-redis/redis"
 	"github.com/jmoiron/sqlx"
 	uuid "github.com/satori/go.uuid"
 	"github.com/spf13/viper"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
-	"time"
 )
 
 const logMessage = "config:"
@@ -25,6 +23,12 @@ var (
 	ErrFileExt = errors.New("wrong file extension")
 )
 
+//Ключи gRPC-метаданных, в которых gateway передаёт IP и User-Agent клиента
+const (
+	ClientIPMetadataKey  = "x-client-ip"
+	UserAgentMetadataKey = "x-user-agent"
+)
+
 func GetSecret() (string, error) {
 	message := logMessage + "getSecret:"
 	secret := os.Getenv("SECRET")
@@ -37,12 +41,6 @@ func GetSecret() (string, error) {
 	return secret, nil
 }
 
-func CreatePasswordHash(password string) string {
-	hash := sha256.New()
-	hash.Write([]byte(password))
-	return fmt.Sprintf("%x", hash.Sum(nil))
-}
-
 func InitPostgresDB() (*sqlx.DB, error) {
 	message := logMessage + "InitPostgresDB:"
 
@@ -108,7 +106,7 @@ func SaveImageFromRequest(r *http.Request, key string) (string, error) {
 	default:
 		return "", ErrFileExt
 	}
-	dst, err := os.Create(filepath.Join("/home/ubuntu/go/2021_2_Yo/static/images", filepath.Base(fileName)))
+	dst, err := os.Create(filepath.Join(ImagesDir, filepath.Base(fileName)))
 	if err != nil {
 		return "", err
 	}
@@ -117,23 +115,81 @@ func SaveImageFromRequest(r *http.Request, key string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	log.Debug(message+"imgUrl =", "https://bmstusa.ru/images/"+fileName)
-	return "https://bmstusa.ru/images/" + fileName, nil
+	log.Debug(message+"imgUrl =", ImagesUrl+fileName)
+	return ImagesUrl + fileName, nil
 }
 
-func GenerateCsrfToken(userId string) (string, error) {
-	message := logMessage + "GenerateCsrfToken:"
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.StandardClaims{
-		ID:        userId,
-		ExpiresAt: jwt.At(time.Now().Add(time.Hour * 7 * 24)), //Week  P.S. Maybe Frontend should ask us
-	})
-	secretWord := os.Getenv("CSRFSECRET")
-	csrfToken, err := jwtToken.SignedString([]byte(secretWord))
+//Адреса и подсети прокси из конфига trusted_proxies, которым можно верить в X-Forwarded-For и X-Real-IP
+func trustedProxies() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, proxy := range viper.GetStringSlice("trusted_proxies") {
+		if !strings.Contains(proxy, "/") {
+			if ip := net.ParseIP(proxy); ip != nil && ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			log.Error(logMessage+"trustedProxies:err =", err)
+			continue
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+func isTrustedProxy(ip string, proxies []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range proxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+//Заголовки прокси читаются, только если запрос пришёл от доверенного прокси, иначе
+//клиент подставил бы любой IP и обходил ограничение попыток входа по IP.
+//X-Forwarded-For разбирается справа: клиент - первый адрес не из доверенных прокси
+func GetClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
 	if err != nil {
-		log.Error(message+"err = ", err)
-		return "", err
+		host = r.RemoteAddr
+	}
+	proxies := trustedProxies()
+	if !isTrustedProxy(host, proxies) {
+		return host
+	}
+	forwarded := r.Header.Get("X-Forwarded-For")
+	if forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if !isTrustedProxy(hop, proxies) {
+				return hop
+			}
+		}
+	}
+	realIP := r.Header.Get("X-Real-IP")
+	if realIP != "" {
+		return realIP
+	}
+	return host
+}
+
+//Токен из заголовка "Authorization: Bearer <token>", пустая строка - заголовка нет
+func GetBearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	const prefix = "bearer "
+	if len(header) <= len(prefix) || strings.ToLower(header[:len(prefix)]) != prefix {
+		return ""
 	}
-	return csrfToken, err
+	return strings.TrimSpace(header[len(prefix):])
 }
 
 func CheckIfNoError(w *http.ResponseWriter, err error, msg string, status response.HttpStatus) bool {
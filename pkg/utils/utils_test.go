@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func newIPRequest(remoteAddr string, forwarded string) *http.Request {
+	r, _ := http.NewRequest("POST", "/login", nil)
+	r.RemoteAddr = remoteAddr
+	if forwarded != "" {
+		r.Header.Set("X-Forwarded-For", forwarded)
+	}
+	return r
+}
+
+func TestGetClientIP(t *testing.T) {
+	viper.Set("trusted_proxies", []string{"10.1.0.0/16", "192.168.0.1"})
+	defer viper.Set("trusted_proxies", nil)
+
+	//Без прокси заголовки игнорируются
+	require.Equal(t, "203.0.113.7", GetClientIP(newIPRequest("203.0.113.7:5000", "10.0.0.1")))
+	r := newIPRequest("203.0.113.7:5000", "")
+	r.Header.Set("X-Real-IP", "10.0.0.1")
+	require.Equal(t, "203.0.113.7", GetClientIP(r))
+
+	//Через доверенные прокси клиент - крайний правый не доверенный адрес
+	require.Equal(t, "203.0.113.7", GetClientIP(newIPRequest("192.168.0.1:5000", "203.0.113.7")))
+	require.Equal(t, "203.0.113.7", GetClientIP(newIPRequest("10.1.2.3:5000", "1.2.3.4, 203.0.113.7, 192.168.0.1")))
+	r = newIPRequest("192.168.0.1:5000", "")
+	r.Header.Set("X-Real-IP", "203.0.113.7")
+	require.Equal(t, "203.0.113.7", GetClientIP(r))
+	require.Equal(t, "192.168.0.1", GetClientIP(newIPRequest("192.168.0.1:5000", "")))
+}
+
+//Подменой X-Forwarded-For клиент не получает новый IP, а значит и новый счётчик попыток входа
+func TestGetClientIPSpoofed(t *testing.T) {
+	viper.Set("trusted_proxies", []string{"192.168.0.1"})
+	defer viper.Set("trusted_proxies", nil)
+
+	ip := GetClientIP(newIPRequest("203.0.113.7:5000", ""))
+	for _, spoofed := range []string{"1.1.1.1", "2.2.2.2, 192.168.0.1", "203.0.113.8"} {
+		require.Equal(t, ip, GetClientIP(newIPRequest("203.0.113.7:5000", spoofed)))
+	}
+	//Дописанный клиентом адрес слева от настоящего тоже не помогает
+	require.Equal(t, "203.0.113.7", GetClientIP(newIPRequest("192.168.0.1:5000", "1.1.1.1, 203.0.113.7")))
+}
@@ -0,0 +1,7 @@
+/*
+Подтверждение почты
+email_verified - пользователь перешёл по ссылке из письма.
+Уже существующие аккаунты считаем подтверждёнными, чтобы не заблокировать их
+*/
+ALTER TABLE "user" ADD COLUMN email_verified boolean default false not null;
+UPDATE "user" SET email_verified = true;
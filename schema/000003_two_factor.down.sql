@@ -0,0 +1,3 @@
+DROP TABLE "recovery_code";
+ALTER TABLE "user" DROP COLUMN totp_enabled;
+ALTER TABLE "user" DROP COLUMN totp_secret;
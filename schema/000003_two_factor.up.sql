@@ -0,0 +1,18 @@
+/*
+Двухфакторная аутентификация (TOTP, RFC 6238)
+totp_secret - секрет в base32, задаётся при подключении
+totp_enabled - подключение подтверждено первым кодом
+*/
+ALTER TABLE "user" ADD COLUMN totp_secret varchar(64) default '' not null;
+ALTER TABLE "user" ADD COLUMN totp_enabled boolean default false not null;
+
+/*
+Одноразовые коды восстановления
+code_hash - sha256 от кода, сам код показывается пользователю один раз
+*/
+CREATE TABLE "recovery_code" (
+                        id serial not null unique,
+                        user_id int references "user" (id) on delete cascade not null,
+                        code_hash varchar(64) not null,
+                        UNIQUE(user_id, code_hash)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE "event" DROP COLUMN hidden;
+ALTER TABLE "user" DROP COLUMN banned;
+ALTER TABLE "user" DROP COLUMN role;
@@ -0,0 +1,12 @@
+/*
+Роли пользователей
+role - user, moderator или admin
+banned - заблокированный пользователь не может войти, его сессии удаляются
+*/
+ALTER TABLE "user" ADD COLUMN role varchar(20) default 'user' not null;
+ALTER TABLE "user" ADD COLUMN banned boolean default false not null;
+
+/*
+hidden - мероприятие скрыто модератором и не показывается в выдаче
+*/
+ALTER TABLE "event" ADD COLUMN hidden boolean default false not null;
@@ -0,0 +1,16 @@
+/*
+Персональные API-токены
+token_hash - sha256 от токена, сам токен показывается пользователю один раз
+scopes - права токена: read, events:write
+expires_at - null, если токен бессрочный
+*/
+CREATE TABLE "api_token" (
+                        id serial not null unique,
+                        user_id int references "user" (id) on delete cascade not null,
+                        name varchar(50) not null,
+                        token_hash varchar(64) not null unique,
+                        scopes varchar(30)[] not null,
+                        created_at timestamptz default now() not null,
+                        expires_at timestamptz,
+                        last_used_at timestamptz
+);
@@ -0,0 +1,12 @@
+/*
+Привязка внешних аккаунтов (OIDC-провайдеров) к пользователю
+provider - имя провайдера из конфига (google, yandex, vk)
+subject - claim sub из ID token, стабильный идентификатор у провайдера
+*/
+CREATE TABLE "user_identity" (
+                        provider varchar(50) not null,
+                        subject varchar(255) not null,
+                        user_id int references "user" (id) on delete cascade not null,
+                        created_at timestamptz default now() not null,
+                        primary key (provider, subject)
+);
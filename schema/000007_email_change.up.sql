@@ -0,0 +1,18 @@
+/*
+Смена почты
+token_hash - sha256 от токена подтверждения, который уходит на новую почту
+cancel_token_hash - sha256 от токена отмены, который уходит на старую почту
+expires_at - до этого момента смену можно подтвердить
+confirmed_at - смена применена; по ссылке отмены её ещё можно откатить
+*/
+CREATE TABLE "email_change" (
+                        id serial not null unique,
+                        user_id int references "user" (id) on delete cascade not null,
+                        old_mail varchar(150) not null,
+                        new_mail varchar(150) not null,
+                        token_hash varchar(64) not null unique,
+                        cancel_token_hash varchar(64) not null unique,
+                        created_at timestamptz default now() not null,
+                        expires_at timestamptz not null,
+                        confirmed_at timestamptz
+);
@@ -0,0 +1,3 @@
+DROP TRIGGER auth_event_append_only ON "auth_event";
+DROP FUNCTION auth_event_append_only();
+DROP TABLE "auth_event";
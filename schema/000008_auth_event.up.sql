@@ -0,0 +1,31 @@
+/*
+Журнал безопасности: входы, выходы, смена пароля и почты, отзыв сессий
+user_id - null, если неудачный вход был на несуществующую почту
+mail - почта, к которой относится событие: на которую пытались войти, новая при смене почты
+Журнал только дополняется: update и delete запрещены триггером. Удаление каскадом
+вместе с пользователем разрешено (pg_trigger_depth() > 0)
+*/
+CREATE TABLE "auth_event" (
+                        id bigserial not null unique,
+                        user_id int references "user" (id) on delete cascade,
+                        type varchar(30) not null,
+                        mail varchar(150) default '' not null,
+                        ip varchar(45) default '' not null,
+                        user_agent varchar(255) default '' not null,
+                        created_at timestamptz default now() not null
+);
+
+CREATE INDEX auth_event_user_idx ON "auth_event" (user_id, created_at desc);
+CREATE INDEX auth_event_type_idx ON "auth_event" (type, created_at desc);
+
+CREATE FUNCTION auth_event_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'auth_event is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER auth_event_append_only
+    BEFORE UPDATE OR DELETE ON "auth_event"
+    FOR EACH ROW
+    WHEN (pg_trigger_depth() < 1)
+    EXECUTE PROCEDURE auth_event_append_only();
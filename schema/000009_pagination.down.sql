@@ -0,0 +1,4 @@
+DROP INDEX subscribe_subscriber_subscribed_idx;
+DROP INDEX visitor_user_event_idx;
+DROP INDEX event_author_id_idx;
+DROP INDEX event_viewed_id_idx;
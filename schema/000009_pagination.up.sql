@@ -0,0 +1,8 @@
+/*
+Индексы под постраничную выдачу по ключу (keyset):
+общая лента - по viewed и id, списки пользователя - по id связанной записи
+*/
+CREATE INDEX event_viewed_id_idx ON "event" (viewed desc, id desc) WHERE hidden = false;
+CREATE INDEX event_author_id_idx ON "event" (author_id, id desc);
+CREATE INDEX visitor_user_event_idx ON "visitor" (user_id, event_id desc);
+CREATE INDEX subscribe_subscriber_subscribed_idx ON "subscribe" (subscriber_id, subscribed_id desc);
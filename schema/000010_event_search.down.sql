@@ -0,0 +1,3 @@
+DROP INDEX event_search_idx;
+ALTER TABLE "event" DROP COLUMN search;
+DROP FUNCTION event_tags_text(varchar[]);
@@ -0,0 +1,23 @@
+/*
+Полнотекстовый поиск по мероприятиям
+search - tsvector по названию, описанию, тегам и тексту в русской и английской конфигурациях;
+вес A - название, B - описание и теги, C - текст
+event_tags_text - теги одной строкой: array_to_string не immutable, а для
+генерируемого столбца нужна immutable-функция
+*/
+CREATE FUNCTION event_tags_text(tags varchar[]) RETURNS text AS $$
+    SELECT coalesce(array_to_string(tags, ' '), '')
+$$ LANGUAGE sql IMMUTABLE;
+
+ALTER TABLE "event" ADD COLUMN search tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', title), 'A') ||
+    setweight(to_tsvector('english', title), 'A') ||
+    setweight(to_tsvector('russian', description), 'B') ||
+    setweight(to_tsvector('english', description), 'B') ||
+    setweight(to_tsvector('russian', event_tags_text(tag)), 'B') ||
+    setweight(to_tsvector('english', event_tags_text(tag)), 'B') ||
+    setweight(to_tsvector('russian', text), 'C') ||
+    setweight(to_tsvector('english', text), 'C')
+) STORED;
+
+CREATE INDEX event_search_idx ON "event" USING gin (search);
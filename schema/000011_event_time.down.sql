@@ -0,0 +1,9 @@
+ALTER TABLE "event" ADD COLUMN date varchar(10) default '' not null;
+UPDATE "event" SET date = to_char(starts_at AT TIME ZONE timezone, 'YYYY-MM-DD');
+ALTER TABLE "event" ALTER COLUMN date DROP DEFAULT;
+
+DROP INDEX event_ends_at_idx;
+DROP INDEX event_starts_at_idx;
+ALTER TABLE "event" DROP COLUMN timezone;
+ALTER TABLE "event" DROP COLUMN ends_at;
+ALTER TABLE "event" DROP COLUMN starts_at;
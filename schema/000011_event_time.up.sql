@@ -0,0 +1,34 @@
+/*
+Время мероприятия вместо строки date
+starts_at, ends_at - начало и конец
+timezone - часовой пояс мероприятия (имя из базы IANA), в нём показывается время
+и определяется день для фильтра по дате
+Старые значения date бывают в двух форматах: 2021-11-13 и 16.11.2021. Такое мероприятие
+считается идущим весь день по Москве. Строки в другом формате прерывают миграцию,
+их нужно исправить вручную
+*/
+ALTER TABLE "event" ADD COLUMN starts_at timestamptz;
+ALTER TABLE "event" ADD COLUMN ends_at timestamptz;
+ALTER TABLE "event" ADD COLUMN timezone varchar(64) default 'Europe/Moscow' not null;
+
+UPDATE "event" SET starts_at = (CASE
+    WHEN date ~ '^\d{4}-\d{2}-\d{2}$' THEN to_date(date, 'YYYY-MM-DD')
+    WHEN date ~ '^\d{2}\.\d{2}\.\d{4}$' THEN to_date(date, 'DD.MM.YYYY')
+END)::timestamp AT TIME ZONE timezone;
+UPDATE "event" SET ends_at = starts_at + interval '1 day';
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM "event" WHERE starts_at IS NULL) THEN
+        RAISE EXCEPTION 'event.date has unsupported format: %',
+            (SELECT string_agg(id || '=' || date, ', ') FROM "event" WHERE starts_at IS NULL);
+    END IF;
+END $$;
+
+ALTER TABLE "event" ALTER COLUMN starts_at SET NOT NULL;
+ALTER TABLE "event" ALTER COLUMN ends_at SET NOT NULL;
+ALTER TABLE "event" ADD CONSTRAINT event_time_check CHECK (ends_at >= starts_at);
+ALTER TABLE "event" DROP COLUMN date;
+
+CREATE INDEX event_starts_at_idx ON "event" (starts_at);
+CREATE INDEX event_ends_at_idx ON "event" (ends_at);
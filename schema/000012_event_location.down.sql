@@ -0,0 +1,8 @@
+DROP INDEX event_lat_lon_idx;
+DROP INDEX event_earth_idx;
+ALTER TABLE "event" DROP CONSTRAINT event_location_check;
+ALTER TABLE "event" DROP COLUMN lon;
+ALTER TABLE "event" DROP COLUMN lat;
+
+DROP EXTENSION IF EXISTS earthdistance;
+DROP EXTENSION IF EXISTS cube;
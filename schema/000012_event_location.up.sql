@@ -0,0 +1,24 @@
+/*
+Координаты мероприятия: lat, lon - широта и долгота в градусах, обе NULL у мероприятий без места
+Поиск в радиусе - через earthdistance (нужен cube), прямоугольник карты - по индексу (lat, lon)
+geo остаётся как есть: старые клиенты пишут туда "(широта, долгота)", такие строки
+переносятся в lat и lon; названия городов и прочий текст не трогаются
+*/
+CREATE EXTENSION IF NOT EXISTS cube;
+CREATE EXTENSION IF NOT EXISTS earthdistance;
+
+ALTER TABLE "event" ADD COLUMN lat double precision;
+ALTER TABLE "event" ADD COLUMN lon double precision;
+
+UPDATE "event" SET
+    lat = split_part(trim(both '() ' from geo), ',', 1)::double precision,
+    lon = split_part(trim(both '() ' from geo), ',', 2)::double precision
+WHERE geo ~ '^\(\s*-?\d+(\.\d+)?\s*,\s*-?\d+(\.\d+)?\s*\)$';
+UPDATE "event" SET lat = NULL, lon = NULL WHERE NOT (lat BETWEEN -90 AND 90 AND lon BETWEEN -180 AND 180);
+
+ALTER TABLE "event" ADD CONSTRAINT event_location_check CHECK (
+    (lat IS NULL AND lon IS NULL) OR (lat BETWEEN -90 AND 90 AND lon BETWEEN -180 AND 180)
+);
+
+CREATE INDEX event_earth_idx ON "event" USING gist (ll_to_earth(lat, lon));
+CREATE INDEX event_lat_lon_idx ON "event" (lat, lon);
@@ -0,0 +1,20 @@
+/*
+Счётчик viewed остаётся как есть: значения, которые присылал клиент, не восстановить
+Просмотры анонимных посетителей и повторные просмотры удаляются - без них
+снова выполняется UNIQUE(event_id, user_id)
+*/
+ALTER TABLE "event" ALTER COLUMN viewed DROP DEFAULT;
+
+DROP INDEX visitor_event_date_idx;
+ALTER TABLE "visitor" ALTER COLUMN date DROP DEFAULT;
+
+DROP INDEX view_event_date_idx;
+DROP INDEX view_visitor_day_idx;
+DROP INDEX view_user_day_idx;
+ALTER TABLE "view" DROP CONSTRAINT view_viewer_check;
+DELETE FROM "view" WHERE user_id IS NULL;
+DELETE FROM "view" a USING "view" b WHERE a.event_id = b.event_id AND a.user_id = b.user_id AND a.id > b.id;
+ALTER TABLE "view" ALTER COLUMN date DROP NOT NULL;
+ALTER TABLE "view" ALTER COLUMN date DROP DEFAULT;
+ALTER TABLE "view" DROP COLUMN visitor_id;
+ALTER TABLE "view" ADD CONSTRAINT view_event_id_user_id_key UNIQUE (event_id, user_id);
@@ -0,0 +1,25 @@
+/*
+Учёт просмотров
+view - просмотр страницы мероприятия: пользователем (user_id) или анонимным посетителем
+(visitor_id из cookie). Один просмотр в сутки (UTC) на зрителя, повторы отсекают
+частичные уникальные индексы
+viewed - счётчик, который теперь ведёт только сервер. Прежние значения присылал клиент,
+поэтому они сбрасываются к числу записанных просмотров
+visitor.date - день добавления в избранное, до этой миграции не заполнялся
+*/
+ALTER TABLE "view" DROP CONSTRAINT view_event_id_user_id_key;
+ALTER TABLE "view" ADD COLUMN visitor_id varchar(64);
+UPDATE "view" SET date = (now() at time zone 'UTC')::date WHERE date IS NULL;
+ALTER TABLE "view" ALTER COLUMN date SET DEFAULT (now() at time zone 'UTC')::date;
+ALTER TABLE "view" ALTER COLUMN date SET NOT NULL;
+ALTER TABLE "view" ADD CONSTRAINT view_viewer_check CHECK (user_id IS NOT NULL OR visitor_id IS NOT NULL);
+
+CREATE UNIQUE INDEX view_user_day_idx ON "view" (event_id, user_id, date) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX view_visitor_day_idx ON "view" (event_id, visitor_id, date) WHERE user_id IS NULL;
+CREATE INDEX view_event_date_idx ON "view" (event_id, date);
+
+ALTER TABLE "visitor" ALTER COLUMN date SET DEFAULT (now() at time zone 'UTC')::date;
+CREATE INDEX visitor_event_date_idx ON "visitor" (event_id, date);
+
+ALTER TABLE "event" ALTER COLUMN viewed SET DEFAULT 0;
+UPDATE "event" SET viewed = (SELECT count(*) FROM "view" WHERE event_id = "event".id);
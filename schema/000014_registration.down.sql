@@ -0,0 +1,2 @@
+DROP TABLE "registration";
+ALTER TABLE "event" DROP COLUMN capacity;
@@ -0,0 +1,20 @@
+/*
+Запись на мероприятие с ограничением мест
+capacity - число мест, NULL - без ограничения
+registration - запись пользователя: status registered - участник, waitlisted - в листе ожидания.
+Очередь листа ожидания - по id. promoted_at - когда переведён из листа ожидания в участники
+visitor по-прежнему означает только избранное
+*/
+ALTER TABLE "event" ADD COLUMN capacity int CHECK (capacity > 0);
+
+CREATE TABLE "registration" (
+                        id serial primary key,
+                        event_id int references "event" (id) on delete cascade not null,
+                        user_id int references "user" (id) on delete cascade not null,
+                        status varchar(16) not null CHECK (status IN ('registered', 'waitlisted')),
+                        created_at timestamptz default now() not null,
+                        promoted_at timestamptz,
+                        UNIQUE(event_id, user_id)
+);
+
+CREATE INDEX registration_event_status_idx ON "registration" (event_id, status, id);
@@ -0,0 +1,21 @@
+/*
+RSVP - ответ пользователя, пойдёт ли он на мероприятие: going, interested или not_going.
+Раньше участниками считались все, кто добавил мероприятие в избранное (visitor).
+Теперь visitor - только закладки, а список участников строится по rsvp.
+Добавившие в избранное до этой миграции переносятся как interested: это проявленный
+интерес, но не обещание прийти. Сами закладки остаются в visitor
+*/
+CREATE TABLE "rsvp" (
+                        id serial primary key,
+                        event_id int references "event" (id) on delete cascade not null,
+                        user_id int references "user" (id) on delete cascade not null,
+                        status varchar(16) not null CHECK (status IN ('going', 'interested', 'not_going')),
+                        updated_at timestamptz default now() not null,
+                        UNIQUE(event_id, user_id)
+);
+
+CREATE INDEX rsvp_event_status_idx ON "rsvp" (event_id, status, user_id);
+
+INSERT INTO "rsvp" (event_id, user_id, status, updated_at)
+SELECT event_id, user_id, 'interested', coalesce(date::timestamptz, now()) FROM "visitor"
+ON CONFLICT (event_id, user_id) DO NOTHING;
@@ -0,0 +1 @@
+ALTER TABLE "registration" DROP COLUMN checked_in_at;
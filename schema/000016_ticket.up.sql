@@ -0,0 +1,7 @@
+/*
+Билеты и отметка прохода
+Билет не хранится: это подписанный сервисом мероприятий токен с id записи (registration),
+его можно выпустить заново в любой момент. checked_in_at - когда организатор отсканировал
+билет на входе, NULL - участник ещё не пришёл
+*/
+ALTER TABLE "registration" ADD COLUMN checked_in_at timestamptz;
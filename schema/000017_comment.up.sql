@@ -0,0 +1,19 @@
+/*
+Комментарии к мероприятиям
+parent_id - комментарий, на который это ответ, NULL - верхний уровень ветки.
+Удаление мягкое: deleted_at и пустой text, сама строка остаётся, чтобы не терять ответы.
+edited_at - время последней правки, править можно только вскоре после публикации
+*/
+CREATE TABLE "comment" (
+                        id serial primary key,
+                        event_id int references "event" (id) on delete cascade not null,
+                        user_id int references "user" (id) on delete cascade not null,
+                        parent_id int references "comment" (id) on delete cascade,
+                        text varchar(2000) not null,
+                        created_at timestamptz default now() not null,
+                        edited_at timestamptz,
+                        deleted_at timestamptz
+);
+
+CREATE INDEX comment_event_parent_idx ON "comment" (event_id, parent_id, id);
+CREATE INDEX comment_parent_idx ON "comment" (parent_id);
@@ -0,0 +1,3 @@
+ALTER TABLE "event" DROP COLUMN rating_count;
+ALTER TABLE "event" DROP COLUMN rating_sum;
+DROP TABLE "review";
@@ -0,0 +1,21 @@
+/*
+Оценки и отзывы о прошедших мероприятиях
+Отзыв оставляют участники: записанные (registration.status = 'registered') и отмеченные
+на входе (checked_in_at). Один отзыв от пользователя на мероприятие.
+rating_sum и rating_count - сумма и число оценок мероприятия, увеличиваются тем же запросом,
+что добавляет отзыв. Из них считаются средняя оценка мероприятия и репутация организатора
+*/
+CREATE TABLE "review" (
+                        id serial primary key,
+                        event_id int references "event" (id) on delete cascade not null,
+                        user_id int references "user" (id) on delete cascade not null,
+                        rating smallint not null CHECK (rating BETWEEN 1 AND 5),
+                        text varchar(2000) default '' not null,
+                        created_at timestamptz default now() not null,
+                        UNIQUE(event_id, user_id)
+);
+
+CREATE INDEX review_event_idx ON "review" (event_id, id);
+
+ALTER TABLE "event" ADD COLUMN rating_sum int default 0 not null;
+ALTER TABLE "event" ADD COLUMN rating_count int default 0 not null;
@@ -0,0 +1 @@
+CREATE INDEX event_viewed_id_idx ON "event" (viewed desc, id desc) WHERE hidden = false;
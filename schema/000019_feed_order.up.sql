@@ -0,0 +1,6 @@
+/*
+Лента мероприятий листается по id, а не по viewed: viewed растёт с каждым просмотром,
+и мероприятия перескакивали через курсор между страницами.
+Индекс по viewed больше не нужен и только замедляет учёт просмотров
+*/
+DROP INDEX event_viewed_id_idx;
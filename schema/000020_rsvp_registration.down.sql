@@ -0,0 +1,3 @@
+/*
+Перевод going в interested не откатывается: прежний ответ не сохранён
+*/
@@ -0,0 +1,9 @@
+/*
+going в rsvp теперь только при подтверждённой записи (registration.status = 'registered'),
+иначе список участников мог превысить capacity и жил отдельно от регистрации.
+Ответы going без записи переводятся в interested
+*/
+UPDATE "rsvp" r SET status = 'interested', updated_at = now()
+WHERE r.status = 'going' AND NOT EXISTS (
+    SELECT 1 FROM "registration" g WHERE g.event_id = r.event_id AND g.user_id = r.user_id AND g.status = 'registered'
+);
@@ -83,11 +83,11 @@ func NewApp(opts *Options) (*App, error) {
 	authService := authUseCase.NewUseCase(authClient)
 	authD := authDelivery.NewDelivery(authService)
 
-	userPort := viper.GetString("user_port")
-	userHost := viper.GetString("user_host")
-	userMicroserviceAddr := userHost + ":" + userPort
+	eventPort := viper.GetString("event_port")
+	eventHost := viper.GetString("event_host")
+	eventMicroserviceAddr := eventHost + ":" + eventPort
 
-	userGrpcConn, err := grpc.Dial(userMicroserviceAddr, grpc.WithInsecure())
+	eventGrpcConn, err := grpc.Dial(eventMicroserviceAddr, grpc.WithInsecure())
 	if err != nil {
 		log.Error(message+"err = ", err)
 		if !opts.Testing {
@@ -95,15 +95,15 @@ func NewApp(opts *Options) (*App, error) {
 		}
 	}
 
-	userR := userRepository.NewRepositoryClient(userGrpcConn)
-	userUC := userUseCase.NewUseCase(userR)
-	userD := userDelivery.NewDelivery(userUC)
+	eventR := eventRepository.NewRepositoryClient(eventGrpcConn)
+	eventUC := eventUseCase.NewUseCase(eventR)
+	eventD := eventDelivery.NewDelivery(eventUC)
 
-	eventPort := viper.GetString("event_port")
-	eventHost := viper.GetString("event_host")
-	eventMicroserviceAddr := eventHost + ":" + eventPort
+	userPort := viper.GetString("user_port")
+	userHost := viper.GetString("user_host")
+	userMicroserviceAddr := userHost + ":" + userPort
 
-	eventGrpcConn, err := grpc.Dial(eventMicroserviceAddr, grpc.WithInsecure())
+	userGrpcConn, err := grpc.Dial(userMicroserviceAddr, grpc.WithInsecure())
 	if err != nil {
 		log.Error(message+"err = ", err)
 		if !opts.Testing {
@@ -111,9 +111,9 @@ func NewApp(opts *Options) (*App, error) {
 		}
 	}
 
-	eventR := eventRepository.NewRepositoryClient(eventGrpcConn)
-	eventUC := eventUseCase.NewUseCase(eventR)
-	eventD := eventDelivery.NewDelivery(eventUC)
+	userR := userRepository.NewRepositoryClient(userGrpcConn)
+	userUC := userUseCase.NewUseCase(userR, eventR)
+	userD := userDelivery.NewDelivery(userUC)
 
 	return &App{
 		Options:      opts,
@@ -147,17 +147,49 @@ func newRouterWithEndpoints(app *App) *mux.Router {
 
 	r.HandleFunc("/auth/signup", app.AuthManager.SignUp).Methods("POST")
 	r.HandleFunc("/auth/login", app.AuthManager.SignIn).Methods("POST")
+	r.HandleFunc("/auth/login/2fa", app.AuthManager.LoginTwoFactor).Methods("POST")
+	r.HandleFunc("/auth/password/reset", app.AuthManager.RequestPasswordReset).Methods("POST")
+	r.HandleFunc("/auth/password/reset/confirm", app.AuthManager.ConfirmPasswordReset).Methods("POST")
+	r.HandleFunc("/auth/magic-link", app.AuthManager.RequestMagicLink).Methods("POST")
+	r.HandleFunc("/auth/magic-link/confirm", app.AuthManager.ConfirmMagicLink).Methods("POST")
+	r.HandleFunc("/auth/verify", app.AuthManager.VerifyEmail).Methods("GET")
+	r.Handle("/auth/oidc/{provider}", mw.GetVars(http.HandlerFunc(app.AuthManager.OidcLogin))).Methods("GET")
+	r.Handle("/auth/oidc/{provider}/callback", mw.GetVars(http.HandlerFunc(app.AuthManager.OidcCallback))).Methods("GET")
 	logoutHandlerFunc := http.HandlerFunc(app.AuthManager.Logout)
 	r.Handle("/auth/logout", mw.Auth(logoutHandlerFunc))
+	resendVerificationHandlerFunc := http.HandlerFunc(app.AuthManager.ResendVerification)
+	r.Handle("/auth/verify/resend", mw.Auth(resendVerificationHandlerFunc)).Methods("POST")
+	getSessionsHandlerFunc := http.HandlerFunc(app.AuthManager.GetSessions)
+	r.Handle("/auth/sessions", mw.Auth(getSessionsHandlerFunc)).Methods("GET")
+	revokeAllOtherSessionsHandlerFunc := http.HandlerFunc(app.AuthManager.RevokeAllOtherSessions)
+	r.Handle("/auth/sessions", mw.Auth(revokeAllOtherSessionsHandlerFunc)).Methods("DELETE")
+	revokeSessionHandlerFunc := mw.GetVars(http.HandlerFunc(app.AuthManager.RevokeSession))
+	r.Handle("/auth/sessions/{id}", mw.Auth(revokeSessionHandlerFunc)).Methods("DELETE")
+	enrollTotpHandlerFunc := http.HandlerFunc(app.AuthManager.EnrollTotp)
+	r.Handle("/auth/2fa/enroll", mw.Auth(enrollTotpHandlerFunc)).Methods("POST")
+	confirmTotpHandlerFunc := http.HandlerFunc(app.AuthManager.ConfirmTotp)
+	r.Handle("/auth/2fa/confirm", mw.Auth(confirmTotpHandlerFunc)).Methods("POST")
+	disableTotpHandlerFunc := http.HandlerFunc(app.AuthManager.DisableTotp)
+	r.Handle("/auth/2fa/disable", mw.Auth(disableTotpHandlerFunc)).Methods("POST")
+	getApiTokensHandlerFunc := http.HandlerFunc(app.AuthManager.GetApiTokens)
+	r.Handle("/auth/tokens", mw.Auth(getApiTokensHandlerFunc)).Methods("GET")
+	createApiTokenHandlerFunc := http.HandlerFunc(app.AuthManager.CreateApiToken)
+	r.Handle("/auth/tokens", mw.Auth(createApiTokenHandlerFunc)).Methods("POST")
+	revokeApiTokenHandlerFunc := mw.GetVars(http.HandlerFunc(app.AuthManager.RevokeApiToken))
+	r.Handle("/auth/tokens/{id:[0-9]+}", mw.Auth(revokeApiTokenHandlerFunc)).Methods("DELETE")
 
 	eventRouter := r.PathPrefix("/events").Subrouter()
 	eventRouter.Methods("POST").Subrouter().Use(mw.CSRF)
 
-	register.EventHTTPEndpoints(eventRouter, app.EventManager, mw)
+	register.EventHTTPEndpoints(eventRouter, app.EventManager, app.UserManager, mw)
 
 	userRouter := r.PathPrefix("/user").Subrouter()
 	userRouter.Methods("POST").Subrouter().Use(mw.CSRF)
-	register.UserHTTPEndpoints(userRouter, app.UserManager, app.EventManager, mw)
+	register.UserHTTPEndpoints(userRouter, app.UserManager, app.EventManager, app.AuthManager, mw)
+
+	adminRouter := r.PathPrefix("/admin").Subrouter()
+	adminRouter.Methods("POST").Subrouter().Use(mw.CSRF)
+	register.AdminHTTPEndpoints(adminRouter, app.AuthManager, app.EventManager, mw)
 
 	r.Handle("/metrics", promhttp.Handler())
 
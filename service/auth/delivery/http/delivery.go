@@ -2,12 +2,20 @@ package http
 
 import (
 	log "backend/pkg/logger"
+	"backend/pkg/models"
 	"backend/pkg/response"
 	"backend/pkg/utils"
 	"backend/service/auth"
 	error2 "backend/service/auth/error"
 	"backend/service/email"
+	"crypto/subtle"
+	"errors"
+	"math"
 	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/viper"
 )
 
 const logMessage = "service:auth:delivery:http:"
@@ -22,12 +30,14 @@ func NewDelivery(useCase auth.UseCase) *Delivery {
 	}
 }
 
-func setSessionIdCookie(w http.ResponseWriter, sessionId string) {
+//maxAge = 0 - cookie живёт до закрытия браузера
+func setSessionIdCookie(w http.ResponseWriter, sessionId string, maxAge int) {
 	cookie := &http.Cookie{
 		Name:     "session_id",
 		Value:    sessionId,
 		HttpOnly: true,
 		Secure:   true,
+		MaxAge:   maxAge,
 		SameSite: http.SameSiteNoneMode,
 		Path:     "/",
 	}
@@ -47,6 +57,16 @@ func setExpiredCookie(w http.ResponseWriter) {
 	http.SetCookie(w, cookie)
 }
 
+func sendLockout(w http.ResponseWriter, lockout *error2.LockoutError, message string) {
+	log.Error(message+"err =", lockout)
+	retryAfter := int(math.Ceil(lockout.RetryAfter.Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	response.SendResponseWithStatus(w, http.StatusTooManyRequests, response.TooManyRequestsResponse(lockout.Error()))
+}
+
 func (h *Delivery) SignUp(w http.ResponseWriter, r *http.Request) {
 	message := logMessage + "SignUp:"
 	log.Debug(message + "started")
@@ -54,11 +74,11 @@ func (h *Delivery) SignUp(w http.ResponseWriter, r *http.Request) {
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	userId, err := h.UseCase.SignUp(u)
+	userId, err := h.UseCase.SignUp(u, utils.GetClientIP(r), r.UserAgent())
 	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
 		return
 	}
-	sessionId, err := h.UseCase.CreateSession(userId)
+	sessionId, maxAge, err := h.UseCase.CreateSession(userId, r.UserAgent(), utils.GetClientIP(r), false)
 	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
 		return
 	}
@@ -66,14 +86,28 @@ func (h *Delivery) SignUp(w http.ResponseWriter, r *http.Request) {
 	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
 		return
 	}
-	setSessionIdCookie(w, sessionId)
+	setSessionIdCookie(w, sessionId, maxAge)
 	log.Info(CSRFToken)
 	w.Header().Set("X-CSRF-Token", CSRFToken)
 	response.SendResponse(w, response.OkResponse())
-	email.SendEmail("Подтвержение регистрации", "Вы успешно зарегистрировались на BMSTUSA!", []string{u.Mail})
+	h.sendVerificationEmail(userId)
 	log.Debug(message + "ended")
 }
 
+func (h *Delivery) sendVerificationEmail(userId string) {
+	message := logMessage + "sendVerificationEmail:"
+	token, mail, err := h.UseCase.CreateVerificationToken(userId)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return
+	}
+	if token == "" {
+		return
+	}
+	link := viper.GetString("main_host") + "/verify?token=" + token
+	email.SendEmail("Подтверждение почты", "Вы успешно зарегистрировались на BMSTUSA! Чтобы подтвердить почту, перейдите по ссылке: "+link, []string{mail})
+}
+
 func (h *Delivery) SignIn(w http.ResponseWriter, r *http.Request) {
 	message := logMessage + "SignIn:"
 	log.Debug(message + "started")
@@ -81,20 +115,107 @@ func (h *Delivery) SignIn(w http.ResponseWriter, r *http.Request) {
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	userId, err := h.UseCase.SignIn(u)
+	userId, challengeToken, err := h.UseCase.SignIn(u, utils.GetClientIP(r), r.UserAgent())
+	var lockout *error2.LockoutError
+	if errors.As(err, &lockout) {
+		sendLockout(w, lockout, message)
+		return
+	}
 	if !utils.CheckIfNoError(&w, err, message, http.StatusNotFound) {
 		return
 	}
-	sessionId, err := h.UseCase.CreateSession(userId)
-	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
+	//Включена 2FA - вход завершится в LoginTwoFactor
+	if challengeToken != "" {
+		response.SendResponse(w, response.TwoFactorChallengeResponse(challengeToken))
+		log.Debug(message + "ended")
+		return
+	}
+	if !h.startSession(w, r, userId, u.RememberMe, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) LoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "LoginTwoFactor:"
+	log.Debug(message + "started")
+	in, err := response.GetTwoFactorFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	if in.Token == "" || in.Code == "" {
+		err = error2.ErrEmptyData
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	userId, err := h.UseCase.CompleteTwoFactorLogin(in.Token, in.Code)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusUnauthorized) {
 		return
 	}
+	if !h.startSession(w, r, userId, in.RememberMe, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+//Создаёт сессию и CSRF-токен, выставляет cookie и заголовок
+func (h *Delivery) startSession(w http.ResponseWriter, r *http.Request, userId string, rememberMe bool, message string) bool {
+	sessionId, maxAge, err := h.UseCase.CreateSession(userId, r.UserAgent(), utils.GetClientIP(r), rememberMe)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
+		return false
+	}
 	CSRFToken, err := h.UseCase.CreateToken(userId)
 	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
-		return
+		return false
 	}
-	setSessionIdCookie(w, sessionId)
+	setSessionIdCookie(w, sessionId, maxAge)
 	w.Header().Set("X-CSRF-Token", CSRFToken)
+	return true
+}
+
+func (h *Delivery) EnrollTotp(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "EnrollTotp:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	secret, uri, err := h.UseCase.EnrollTotp(userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.TotpEnrollmentResponse(secret, uri))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) ConfirmTotp(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ConfirmTotp:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	in, err := response.GetTwoFactorFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	codes, err := h.UseCase.ConfirmTotp(userId, in.Code)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.RecoveryCodesResponse(codes))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) DisableTotp(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "DisableTotp:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	in, err := response.GetTwoFactorFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	err = h.UseCase.DisableTotp(userId, in.Code)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
 	response.SendResponse(w, response.OkResponse())
 	log.Debug(message + "ended")
 }
@@ -110,10 +231,437 @@ func (h *Delivery) Logout(w http.ResponseWriter, r *http.Request) {
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	err = h.UseCase.DeleteSession(cookie.Value)
+	err = h.UseCase.DeleteSession(cookie.Value, utils.GetClientIP(r), r.UserAgent())
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+//Удаляет аккаунт после повторного ввода пароля: сессии отзываются в auth,
+//картинки удаляются здесь, остальные данные - каскадно в базе
+func (h *Delivery) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "DeleteAccount:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	u, err := response.GetUserFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	imgUrls, err := h.UseCase.DeleteAccount(userId, u.Password)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	utils.RemoveImages(imgUrls)
+	setExpiredCookie(w)
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) GetSessions(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetSessions:"
+	log.Debug(message + "started")
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		err = error2.ErrCookie
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	sessions, err := h.UseCase.ListSessions(cookie.Value)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.SessionListResponse(sessions))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RevokeSession:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	id := vars["id"]
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		err = error2.ErrCookie
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	err = h.UseCase.RevokeSession(cookie.Value, id, utils.GetClientIP(r), r.UserAgent())
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) RevokeAllOtherSessions(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RevokeAllOtherSessions:"
+	log.Debug(message + "started")
+	cookie, err := r.Cookie("session_id")
+	if err != nil {
+		err = error2.ErrCookie
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	err = h.UseCase.RevokeAllOtherSessions(cookie.Value, utils.GetClientIP(r), r.UserAgent())
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RequestPasswordReset:"
+	log.Debug(message + "started")
+	u, err := response.GetUserFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	if u.Mail == "" {
+		err = error2.ErrEmptyData
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	token, err := h.UseCase.RequestPasswordReset(u.Mail)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
+		return
+	}
+	//Ответ одинаковый независимо от того, есть ли такая почта
+	response.SendResponse(w, response.OkResponse())
+	if token != "" {
+		link := viper.GetString("main_host") + "/password/reset?token=" + token
+		email.SendEmail("Восстановление пароля", "Чтобы задать новый пароль, перейдите по ссылке: "+link+"\nСсылка действует 30 минут. Если вы не запрашивали восстановление, просто проигнорируйте это письмо.", []string{u.Mail})
+	}
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) ConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ConfirmPasswordReset:"
+	log.Debug(message + "started")
+	in, err := response.GetPasswordResetFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	if in.Token == "" || in.Password == "" {
+		err = error2.ErrEmptyData
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	err = h.UseCase.ConfirmPasswordReset(in.Token, in.Password, utils.GetClientIP(r), r.UserAgent())
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RequestMagicLink:"
+	log.Debug(message + "started")
+	u, err := response.GetUserFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	if u.Mail == "" {
+		err = error2.ErrEmptyData
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	token, err := h.UseCase.RequestMagicLink(u.Mail)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
+		return
+	}
+	//Ответ одинаковый независимо от того, есть ли такая почта
+	response.SendResponse(w, response.OkResponse())
+	if token != "" {
+		//Ссылка ведёт на фронтенд, который отправляет токен POST-запросом: GET по ссылке
+		//(например, предпросмотр в почтовом клиенте) токен не израсходует
+		link := viper.GetString("main_host") + "/login/magic?token=" + token
+		email.SendEmail("Вход на BMSTUSA", "Чтобы войти, перейдите по ссылке: "+link+"\nСсылка одноразовая и действует 15 минут. Если вы не запрашивали вход, просто проигнорируйте это письмо.", []string{u.Mail})
+	}
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) ConfirmMagicLink(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ConfirmMagicLink:"
+	log.Debug(message + "started")
+	in, err := response.GetMagicLinkFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	if in.Token == "" {
+		err = error2.ErrEmptyData
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	userId, challengeToken, err := h.UseCase.ConfirmMagicLink(in.Token)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusUnauthorized) {
+		return
+	}
+	//Включена 2FA - вход завершится в LoginTwoFactor
+	if challengeToken != "" {
+		response.SendResponse(w, response.TwoFactorChallengeResponse(challengeToken))
+		log.Debug(message + "ended")
+		return
+	}
+	if !h.startSession(w, r, userId, in.RememberMe, message) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "VerifyEmail:"
+	log.Debug(message + "started")
+	token := r.URL.Query().Get("token")
+	var err error
+	if token == "" {
+		err = error2.ErrEmptyData
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	err = h.UseCase.VerifyEmail(token)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ResendVerification:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	token, mail, err := h.UseCase.CreateVerificationToken(userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	link := viper.GetString("main_host") + "/verify?token=" + token
+	email.SendEmail("Подтверждение почты", "Чтобы подтвердить почту, перейдите по ссылке: "+link, []string{mail})
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) BanUser(w http.ResponseWriter, r *http.Request) {
+	h.setBanned(w, r, true, logMessage+"BanUser:")
+}
+
+func (h *Delivery) UnbanUser(w http.ResponseWriter, r *http.Request) {
+	h.setBanned(w, r, false, logMessage+"UnbanUser:")
+}
+
+func (h *Delivery) setBanned(w http.ResponseWriter, r *http.Request, banned bool, message string) {
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	id := vars["id"]
+	err := h.UseCase.BanUser(id, banned)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) SetRole(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "SetRole:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	id := vars["id"]
+	userId := r.Context().Value("userId").(string)
+	//Админ не может снять роль сам с себя, иначе можно остаться без админов
+	var err error
+	if id == userId {
+		err = error2.ErrForbidden
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusForbidden) {
+		return
+	}
+	in, err := response.GetRoleFromRequest(r.Body)
+	if err == nil && !models.IsValidRole(in.Role) {
+		err = error2.ErrInvalidRole
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	err = h.UseCase.SetRole(id, in.Role)
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
 	response.SendResponse(w, response.OkResponse())
 	log.Debug(message + "ended")
 }
+
+func (h *Delivery) GetApiTokens(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetApiTokens:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	tokens, err := h.UseCase.ListApiTokens(userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.ApiTokenListResponse(tokens))
+	log.Debug(message + "ended")
+}
+
+//Журнал безопасности текущего пользователя: входы, выходы, смена пароля и почты
+func (h *Delivery) GetSecurityLog(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetSecurityLog:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	events, err := h.UseCase.ListAuthEvents(userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.AuthEventListResponse(events))
+	log.Debug(message + "ended")
+}
+
+//Поиск по журналу безопасности: ?userId=&type=&mail=&ip=&from=&to=&limit=, from и to в RFC 3339
+func (h *Delivery) QueryAuthEvents(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "QueryAuthEvents:"
+	log.Debug(message + "started")
+	q := r.URL.Query()
+	query := &models.AuthEventQuery{
+		UserId: q.Get("userId"),
+		Type:   q.Get("type"),
+		Mail:   q.Get("mail"),
+		IP:     q.Get("ip"),
+		From:   q.Get("from"),
+		To:     q.Get("to"),
+	}
+	var err error
+	if q.Get("limit") != "" {
+		query.Limit, err = strconv.Atoi(q.Get("limit"))
+		if err != nil {
+			err = error2.ErrInvalidAuthEventFilter
+		}
+	}
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	events, err := h.UseCase.QueryAuthEvents(query)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.AuthEventListResponse(events))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) CreateApiToken(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "CreateApiToken:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	in, err := response.GetApiTokenFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	token, err := h.UseCase.CreateApiToken(userId, in.Name, in.Scopes, in.ExpiresIn)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.ApiTokenResponse(token))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) RevokeApiToken(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RevokeApiToken:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	id := vars["id"]
+	userId := r.Context().Value("userId").(string)
+	err := h.UseCase.RevokeApiToken(userId, id)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+const (
+	oidcStateCookie = "oidc_state"
+	oidcStateMaxAge = 10 * 60
+)
+
+//state привязывается к браузеру cookie: иначе можно подсунуть жертве callback
+//со своим code и залогинить её в чужой аккаунт
+func setOidcStateCookie(w http.ResponseWriter, state string, maxAge int) {
+	cookie := &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		HttpOnly: true,
+		Secure:   true,
+		MaxAge:   maxAge,
+		SameSite: http.SameSiteLaxMode,
+		Path:     "/auth/oidc",
+	}
+	http.SetCookie(w, cookie)
+}
+
+func oidcRedirect(w http.ResponseWriter, r *http.Request, path string) {
+	http.Redirect(w, r, viper.GetString("main_host")+path, http.StatusFound)
+}
+
+func (h *Delivery) OidcLogin(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "OidcLogin:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	authURL, state, err := h.UseCase.StartOidcLogin(vars["provider"])
+	if !utils.CheckIfNoError(&w, err, message, http.StatusNotFound) {
+		return
+	}
+	setOidcStateCookie(w, state, oidcStateMaxAge)
+	http.Redirect(w, r, authURL, http.StatusFound)
+	log.Debug(message + "ended")
+}
+
+//Провайдер возвращает браузер сюда, поэтому и результат, и ошибки отдаём перенаправлением на фронтенд
+func (h *Delivery) OidcCallback(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "OidcCallback:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	query := r.URL.Query()
+	state := query.Get("state")
+	cookie, err := r.Cookie(oidcStateCookie)
+	setOidcStateCookie(w, "", -1)
+	if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(state)) != 1 {
+		log.Error(message + "state mismatch")
+		oidcRedirect(w, r, "/login?error=oidc")
+		return
+	}
+	if query.Get("error") != "" {
+		log.Error(message+"provider error =", query.Get("error"))
+		oidcRedirect(w, r, "/login?error=oidc")
+		return
+	}
+	userId, challengeToken, err := h.UseCase.CompleteOidcLogin(vars["provider"], state, query.Get("code"))
+	if err != nil {
+		log.Error(message+"err =", err)
+		oidcRedirect(w, r, "/login?error=oidc")
+		return
+	}
+	if challengeToken != "" {
+		oidcRedirect(w, r, "/login/2fa?token="+url.QueryEscape(challengeToken))
+		return
+	}
+	sessionId, maxAge, err := h.UseCase.CreateSession(userId, r.UserAgent(), utils.GetClientIP(r), false)
+	if err != nil {
+		log.Error(message+"err =", err)
+		oidcRedirect(w, r, "/login?error=oidc")
+		return
+	}
+	//CSRF-токен фронтенд получит через GET /user, как после перезагрузки страницы
+	setSessionIdCookie(w, sessionId, maxAge)
+	oidcRedirect(w, r, "/")
+	log.Debug(message + "ended")
+}
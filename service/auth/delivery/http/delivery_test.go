@@ -3,8 +3,11 @@ package http
 import (
 	log "backend/pkg/logger"
 	"backend/pkg/models"
+	"backend/pkg/response"
+	error2 "backend/service/auth/error"
 	"backend/service/auth/usecase"
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/gorilla/mux"
@@ -12,7 +15,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
 )
 
 const logTestMessage = "auth:delivery:test"
@@ -85,9 +92,10 @@ func TestSignUp(t *testing.T) {
 		userModel := new(models.User)
 		userModel.Mail = test.input.Mail
 
-		useCaseMock.On("SignUp", userModel).Return("", test.useCaseErr1)
-		useCaseMock.On("CreateSession", "").Return("", test.useCaseErr2)
+		useCaseMock.On("SignUp", userModel, "", "").Return("", test.useCaseErr1)
+		useCaseMock.On("CreateSession", "", "", "", false).Return("", 0, test.useCaseErr2)
 		useCaseMock.On("CreateToken", "").Return("", test.useCaseErr3)
+		useCaseMock.On("CreateVerificationToken", "").Return("", "", nil)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/signup", deliveryTest.SignUp).Methods("POST")
@@ -168,8 +176,8 @@ func TestSignIn(t *testing.T) {
 		userModel.Mail = test.input.Mail
 		userModel.Password = test.input.Password
 
-		useCaseMock.On("SignIn", userModel).Return("", test.useCaseErr1)
-		useCaseMock.On("CreateSession", "").Return("", test.useCaseErr2)
+		useCaseMock.On("SignIn", userModel, "", "").Return("", "", test.useCaseErr1)
+		useCaseMock.On("CreateSession", "", "", "", false).Return("", 0, test.useCaseErr2)
 		useCaseMock.On("CreateToken", "").Return("", test.useCaseErr3)
 
 		bodyUserJSON, err := json.Marshal(test.input)
@@ -237,7 +245,7 @@ func TestLogout(t *testing.T) {
 		cookie := test.input
 		csrfToken := test.csrfToken
 
-		useCaseMock.On("DeleteSession", cookie.Value).Return(test.useCaseErr)
+		useCaseMock.On("DeleteSession", cookie.Value, "", "").Return(test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/logout", deliveryTest.Logout).Methods("GET")
@@ -253,3 +261,835 @@ func TestLogout(t *testing.T) {
 		r.ServeHTTP(w, req)
 	}
 }
+
+var requestPasswordResetTests = []struct {
+	id         int
+	input      *models.UserResponseBody
+	token      string
+	useCaseErr error
+	status     int
+}{
+	{
+		1,
+		&models.UserResponseBody{
+			Mail: "testMail@mail.ru",
+		},
+		"",
+		nil,
+		200,
+	},
+	{
+		2,
+		&models.UserResponseBody{},
+		"",
+		nil,
+		404,
+	},
+	{
+		3,
+		&models.UserResponseBody{
+			Mail: "testMail@mail.ru",
+		},
+		"",
+		errors.New("test_err"),
+		404,
+	},
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	for _, test := range requestPasswordResetTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("RequestPasswordReset", test.input.Mail).Return(test.token, test.useCaseErr)
+
+		bodyUserJSON, err := json.Marshal(test.input)
+		require.NoError(t, err, logTestMessage+"err =", err)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/password/reset", deliveryTest.RequestPasswordReset).Methods("POST")
+		req, err := http.NewRequest("POST", "/password/reset", bytes.NewBuffer(bodyUserJSON))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, resp.Status, test.id)
+	}
+}
+
+var confirmPasswordResetTests = []struct {
+	id         int
+	input      *models.PasswordResetResponseBody
+	useCaseErr error
+	status     int
+}{
+	{
+		1,
+		&models.PasswordResetResponseBody{
+			Token:    "token",
+			Password: "newPassword",
+		},
+		nil,
+		200,
+	},
+	{
+		2,
+		&models.PasswordResetResponseBody{
+			Password: "newPassword",
+		},
+		nil,
+		404,
+	},
+	{
+		3,
+		&models.PasswordResetResponseBody{
+			Token:    "token",
+			Password: "newPassword",
+		},
+		errors.New("test_err"),
+		404,
+	},
+}
+
+func TestConfirmPasswordReset(t *testing.T) {
+	for _, test := range confirmPasswordResetTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("ConfirmPasswordReset", test.input.Token, test.input.Password, "", "").Return(test.useCaseErr)
+
+		bodyJSON, err := json.Marshal(test.input)
+		require.NoError(t, err, logTestMessage+"err =", err)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/password/reset/confirm", deliveryTest.ConfirmPasswordReset).Methods("POST")
+		req, err := http.NewRequest("POST", "/password/reset/confirm", bytes.NewBuffer(bodyJSON))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, resp.Status, test.id)
+	}
+}
+
+var verifyEmailTests = []struct {
+	id         int
+	token      string
+	useCaseErr error
+	status     int
+}{
+	{
+		1,
+		"token",
+		nil,
+		200,
+	},
+	{
+		2,
+		"",
+		nil,
+		404,
+	},
+	{
+		3,
+		"token",
+		errors.New("test_err"),
+		404,
+	},
+}
+
+func TestVerifyEmail(t *testing.T) {
+	for _, test := range verifyEmailTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("VerifyEmail", test.token).Return(test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/verify", deliveryTest.VerifyEmail).Methods("GET")
+		req, err := http.NewRequest("GET", "/verify?token="+test.token, nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, resp.Status, test.id)
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("CreateVerificationToken", "1").Return("", "", errors.New("test_err"))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/verify/resend", deliveryTest.ResendVerification).Methods("POST")
+	req, err := http.NewRequest("POST", "/verify/resend", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req = req.WithContext(context.WithValue(req.Context(), "userId", "1"))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	resp := &response.Response{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 404, resp.Status)
+	require.Equal(t, "test_err", resp.Message)
+}
+
+func TestGetSessions(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	sessions := []*models.Session{
+		{
+			ID:        "abc",
+			UserAgent: "Mozilla/5.0",
+			Current:   true,
+		},
+	}
+	useCaseMock.On("ListSessions", "session").Return(sessions, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/sessions", deliveryTest.GetSessions).Methods("GET")
+	req, err := http.NewRequest("GET", "/sessions", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	resp := &struct {
+		Status int                            `json:"status"`
+		Body   models.SessionListResponseBody `json:"body"`
+	}{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 200, resp.Status)
+	require.Len(t, resp.Body.Sessions, 1)
+	require.True(t, resp.Body.Sessions[0].Current)
+}
+
+func TestRevokeSession(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("RevokeSession", "session", "abc", "", "").Return(nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/sessions/{id}", deliveryTest.RevokeSession).Methods("DELETE")
+	req, err := http.NewRequest("DELETE", "/sessions/abc", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req.AddCookie(&http.Cookie{Name: "session_id", Value: "session"})
+	req = req.WithContext(context.WithValue(req.Context(), "vars", map[string]string{"id": "abc"}))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	resp := &response.Response{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 200, resp.Status)
+	useCaseMock.AssertExpectations(t)
+}
+
+func TestSignInRememberMe(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	input := &models.UserResponseBody{
+		Mail:       "testMail@mail.ru",
+		Password:   "testPassword",
+		RememberMe: true,
+	}
+	userModel := &models.User{
+		Mail:       input.Mail,
+		Password:   input.Password,
+		RememberMe: true,
+	}
+	useCaseMock.On("SignIn", userModel, "", "").Return("1", "", nil)
+	useCaseMock.On("CreateSession", "1", "", "", true).Return("session", 2592000, nil)
+	useCaseMock.On("CreateToken", "1").Return("token", nil)
+
+	bodyUserJSON, err := json.Marshal(input)
+	require.NoError(t, err, logTestMessage+"err =", err)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/login", deliveryTest.SignIn).Methods("POST")
+	req, err := http.NewRequest("POST", "/login", bytes.NewBuffer(bodyUserJSON))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, "session", cookies[0].Value)
+	require.Equal(t, 2592000, cookies[0].MaxAge)
+}
+
+func TestSignInTwoFactorChallenge(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	input := &models.UserResponseBody{
+		Mail:     "testMail@mail.ru",
+		Password: "testPassword",
+	}
+	userModel := &models.User{
+		Mail:     input.Mail,
+		Password: input.Password,
+	}
+	useCaseMock.On("SignIn", userModel, "", "").Return("", "challenge", nil)
+
+	bodyUserJSON, err := json.Marshal(input)
+	require.NoError(t, err, logTestMessage+"err =", err)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/login", deliveryTest.SignIn).Methods("POST")
+	req, err := http.NewRequest("POST", "/login", bytes.NewBuffer(bodyUserJSON))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	resp := &struct {
+		Status int                                   `json:"status"`
+		Body   models.TwoFactorChallengeResponseBody `json:"body"`
+	}{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.True(t, resp.Body.TwoFactorRequired)
+	require.Equal(t, "challenge", resp.Body.ChallengeToken)
+	require.Empty(t, w.Result().Cookies())
+	useCaseMock.AssertNotCalled(t, "CreateSession", "", "", "", false)
+}
+
+var loginTwoFactorTests = []struct {
+	id         int
+	input      *models.TwoFactorResponseBody
+	useCaseErr error
+	status     int
+}{
+	{
+		1,
+		&models.TwoFactorResponseBody{
+			Token: "challenge",
+			Code:  "123456",
+		},
+		nil,
+		200,
+	},
+	{
+		2,
+		&models.TwoFactorResponseBody{
+			Token: "challenge",
+		},
+		nil,
+		404,
+	},
+	{
+		3,
+		&models.TwoFactorResponseBody{
+			Token: "challenge",
+			Code:  "000000",
+		},
+		errors.New("test_err"),
+		404,
+	},
+}
+
+func TestLoginTwoFactor(t *testing.T) {
+	for _, test := range loginTwoFactorTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("CompleteTwoFactorLogin", test.input.Token, test.input.Code).Return("1", test.useCaseErr)
+		useCaseMock.On("CreateSession", "1", "", "", false).Return("session", 0, nil)
+		useCaseMock.On("CreateToken", "1").Return("token", nil)
+
+		body, err := json.Marshal(test.input)
+		require.NoError(t, err, logTestMessage+"err =", err)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/login/2fa", deliveryTest.LoginTwoFactor).Methods("POST")
+		req, err := http.NewRequest("POST", "/login/2fa", bytes.NewBuffer(body))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, resp.Status, test.id)
+		if test.status == 200 {
+			require.Equal(t, "token", w.Header().Get("X-CSRF-Token"))
+		}
+	}
+}
+
+func TestConfirmTotp(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("ConfirmTotp", "1", "123456").Return([]string{"abcd-efgh"}, nil)
+
+	body, err := json.Marshal(&models.TwoFactorResponseBody{Code: "123456"})
+	require.NoError(t, err, logTestMessage+"err =", err)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/2fa/confirm", deliveryTest.ConfirmTotp).Methods("POST")
+	req, err := http.NewRequest("POST", "/2fa/confirm", bytes.NewBuffer(body))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req = req.WithContext(context.WithValue(req.Context(), "userId", "1"))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	resp := &struct {
+		Status int                              `json:"status"`
+		Body   models.RecoveryCodesResponseBody `json:"body"`
+	}{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 200, resp.Status)
+	require.Equal(t, []string{"abcd-efgh"}, resp.Body.Codes)
+}
+
+func TestSignInLockout(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	input := &models.UserResponseBody{
+		Mail:     "testMail@mail.ru",
+		Password: "testPassword",
+	}
+	userModel := &models.User{
+		Mail:     input.Mail,
+		Password: input.Password,
+	}
+	lockout := &error2.LockoutError{RetryAfter: 1500 * time.Millisecond}
+	useCaseMock.On("SignIn", userModel, "10.0.0.1", "").Return("", "", lockout)
+
+	bodyUserJSON, err := json.Marshal(input)
+	require.NoError(t, err, logTestMessage+"err =", err)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/login", deliveryTest.SignIn).Methods("POST")
+	req, err := http.NewRequest("POST", "/login", bytes.NewBuffer(bodyUserJSON))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req.RemoteAddr = "10.0.0.1:5000"
+	//Подменённый заголовок не меняет IP, по которому считаются попытки
+	req.Header.Set("X-Forwarded-For", "192.168.0.1")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "2", w.Header().Get("Retry-After"))
+	resp := &response.Response{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 429, resp.Status)
+	useCaseMock.AssertNotCalled(t, "CreateSession", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBanUser(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("BanUser", "2", true).Return(nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/users/{id}/ban", deliveryTest.BanUser).Methods("POST")
+	req, err := http.NewRequest("POST", "/users/2/ban", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req = req.WithContext(context.WithValue(req.Context(), "vars", map[string]string{"id": "2"}))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	resp := &response.Response{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 200, resp.Status)
+	useCaseMock.AssertExpectations(t)
+}
+
+var setRoleTests = []struct {
+	id     int
+	userId string
+	body   string
+	status int
+	called bool
+}{
+	{
+		1,
+		"1",
+		`{"role": "moderator"}`,
+		200,
+		true,
+	},
+	{
+		2,
+		"2",
+		`{"role": "moderator"}`,
+		404,
+		false,
+	},
+	{
+		3,
+		"1",
+		`{"role": ""}`,
+		404,
+		false,
+	},
+}
+
+func TestSetRole(t *testing.T) {
+	for _, test := range setRoleTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("SetRole", "2", "moderator").Return(nil)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/users/{id}/role", deliveryTest.SetRole).Methods("POST")
+		req, err := http.NewRequest("POST", "/users/2/role", strings.NewReader(test.body))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		ctx := context.WithValue(req.Context(), "vars", map[string]string{"id": "2"})
+		req = req.WithContext(context.WithValue(ctx, "userId", test.userId))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, resp.Status, test.id)
+		if test.called {
+			useCaseMock.AssertCalled(t, "SetRole", "2", "moderator")
+		} else {
+			useCaseMock.AssertNotCalled(t, "SetRole", "2", "moderator")
+		}
+	}
+}
+
+func TestCreateApiToken(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	token := &models.ApiToken{
+		ID:     "5",
+		Name:   "script",
+		Scopes: []string{"read"},
+		Token:  "bmsa_secret",
+	}
+	useCaseMock.On("CreateApiToken", "1", "script", []string{"read"}, 3600).Return(token, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/tokens", deliveryTest.CreateApiToken).Methods("POST")
+	body := `{"name": "script", "scopes": ["read"], "expiresIn": 3600}`
+	req, err := http.NewRequest("POST", "/tokens", strings.NewReader(body))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req = req.WithContext(context.WithValue(req.Context(), "userId", "1"))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	resp := &struct {
+		Status int                         `json:"status"`
+		Body   models.ApiTokenResponseBody `json:"body"`
+	}{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 200, resp.Status)
+	require.Equal(t, "bmsa_secret", resp.Body.Token)
+	useCaseMock.AssertExpectations(t)
+}
+
+func TestRevokeApiToken(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("RevokeApiToken", "1", "5").Return(error2.ErrApiTokenNotFound)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/tokens/{id}", deliveryTest.RevokeApiToken).Methods("DELETE")
+	req, err := http.NewRequest("DELETE", "/tokens/5", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	ctx := context.WithValue(req.Context(), "vars", map[string]string{"id": "5"})
+	req = req.WithContext(context.WithValue(ctx, "userId", "1"))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	resp := &response.Response{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 404, resp.Status)
+	require.Equal(t, error2.ErrApiTokenNotFound.Error(), resp.Message)
+}
+
+func TestOidcLogin(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("StartOidcLogin", "google").Return("https://accounts.google.com/o/oauth2/v2/auth?state=state", "state", nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/auth/oidc/{provider}", deliveryTest.OidcLogin).Methods("GET")
+	req, err := http.NewRequest("GET", "/auth/oidc/google", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req = req.WithContext(context.WithValue(req.Context(), "vars", map[string]string{"provider": "google"}))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusFound, w.Code)
+	require.Equal(t, "https://accounts.google.com/o/oauth2/v2/auth?state=state", w.Header().Get("Location"))
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, oidcStateCookie, cookies[0].Name)
+	require.Equal(t, "state", cookies[0].Value)
+	require.True(t, cookies[0].HttpOnly)
+}
+
+var oidcCallbackTests = []struct {
+	id             int
+	query          string
+	cookie         string
+	userId         string
+	challengeToken string
+	useCaseErr     error
+	location       string
+	sessionCookie  bool
+}{
+	{1, "?state=state&code=code", "state", "1", "", nil, "/", true},
+	{2, "?state=state&code=code", "state", "", "challenge", nil, "/login/2fa?token=challenge", false},
+	{3, "?state=state&code=code", "state", "", "", error2.ErrOidcEmailNotVerified, "/login?error=oidc", false},
+	{4, "?state=state&code=code", "other", "", "", nil, "/login?error=oidc", false},
+	{5, "?state=state&code=code", "", "", "", nil, "/login?error=oidc", false},
+	{6, "?state=state&error=access_denied", "state", "", "", nil, "/login?error=oidc", false},
+}
+
+func TestOidcCallback(t *testing.T) {
+	for _, test := range oidcCallbackTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("CompleteOidcLogin", "google", "state", "code").Return(test.userId, test.challengeToken, test.useCaseErr)
+		useCaseMock.On("CreateSession", "1", "", "", false).Return("session", 0, nil)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/auth/oidc/{provider}/callback", deliveryTest.OidcCallback).Methods("GET")
+		req, err := http.NewRequest("GET", "/auth/oidc/google/callback"+test.query, nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		if test.cookie != "" {
+			req.AddCookie(&http.Cookie{Name: oidcStateCookie, Value: test.cookie})
+		}
+		req = req.WithContext(context.WithValue(req.Context(), "vars", map[string]string{"provider": "google"}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusFound, w.Code, test.id)
+		require.Equal(t, test.location, w.Header().Get("Location"), test.id)
+		sessionCookie := false
+		for _, cookie := range w.Result().Cookies() {
+			if cookie.Name == "session_id" && cookie.Value == "session" {
+				sessionCookie = true
+			}
+		}
+		require.Equal(t, test.sessionCookie, sessionCookie, test.id)
+		if test.cookie != "state" {
+			useCaseMock.AssertNotCalled(t, "CompleteOidcLogin", "google", "state", "code")
+		}
+	}
+}
+
+func TestRequestMagicLink(t *testing.T) {
+	for _, test := range requestPasswordResetTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("RequestMagicLink", test.input.Mail).Return(test.token, test.useCaseErr)
+
+		bodyUserJSON, err := json.Marshal(test.input)
+		require.NoError(t, err, logTestMessage+"err =", err)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/auth/magic-link", deliveryTest.RequestMagicLink).Methods("POST")
+		req, err := http.NewRequest("POST", "/auth/magic-link", bytes.NewBuffer(bodyUserJSON))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, resp.Status, test.id)
+	}
+}
+
+var confirmMagicLinkTests = []struct {
+	id             int
+	input          *models.MagicLinkResponseBody
+	userId         string
+	challengeToken string
+	useCaseErr     error
+	status         int
+	csrfHeader     bool
+}{
+	{1, &models.MagicLinkResponseBody{Token: "token", RememberMe: true}, "1", "", nil, 200, true},
+	{2, &models.MagicLinkResponseBody{Token: "token"}, "", "challenge", nil, 200, false},
+	{3, &models.MagicLinkResponseBody{Token: "token"}, "", "", error2.ErrInvalidToken, 404, false},
+	{4, &models.MagicLinkResponseBody{}, "", "", nil, 404, false},
+}
+
+func TestConfirmMagicLink(t *testing.T) {
+	for _, test := range confirmMagicLinkTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("ConfirmMagicLink", test.input.Token).Return(test.userId, test.challengeToken, test.useCaseErr)
+		useCaseMock.On("CreateSession", "1", "", "", true).Return("session", 3600, nil)
+		useCaseMock.On("CreateToken", "1").Return("csrf", nil)
+
+		bodyJSON, err := json.Marshal(test.input)
+		require.NoError(t, err, logTestMessage+"err =", err)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/auth/magic-link/confirm", deliveryTest.ConfirmMagicLink).Methods("POST")
+		req, err := http.NewRequest("POST", "/auth/magic-link/confirm", bytes.NewBuffer(bodyJSON))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, resp.Status, test.id)
+		require.Equal(t, test.csrfHeader, w.Header().Get("X-CSRF-Token") == "csrf", test.id)
+		if test.input.Token == "" {
+			useCaseMock.AssertNotCalled(t, "ConfirmMagicLink", mock.Anything)
+		}
+	}
+}
+
+var deleteAccountTests = []struct {
+	id         int
+	password   string
+	useCaseErr error
+	status     int
+}{
+	{1, "password", nil, 200},
+	{2, "wrong", error2.ErrWrongPassword, 404},
+}
+
+func TestDeleteAccount(t *testing.T) {
+	for _, test := range deleteAccountTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+
+		useCaseMock.On("DeleteAccount", "1", test.password).Return([]string{}, test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/user/delete", deliveryTest.DeleteAccount).Methods("POST")
+		bodyUserJSON, err := json.Marshal(&models.UserResponseBody{Password: test.password})
+		require.NoError(t, err, logTestMessage+"Marshal error")
+		req, err := http.NewRequest("POST", "/user/delete", bytes.NewBuffer(bodyUserJSON))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		req = req.WithContext(context.WithValue(req.Context(), "userId", "1"))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, resp.Status, test.id)
+		cookieCleared := strings.Contains(w.Header().Get("Set-Cookie"), "session_id=;")
+		require.Equal(t, test.useCaseErr == nil, cookieCleared, test.id)
+	}
+}
+
+func TestGetSecurityLog(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	useCaseMock.On("ListAuthEvents", "1").Return([]*models.AuthEvent{
+		{ID: "2", UserId: "1", Type: models.AuthEventLogin, IP: "127.0.0.1", CreatedAt: "2022-05-01T12:00:00Z"},
+	}, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/user/security-log", deliveryTest.GetSecurityLog).Methods("GET")
+	req, err := http.NewRequest("GET", "/user/security-log", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req = req.WithContext(context.WithValue(req.Context(), "userId", "1"))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	resp := &struct {
+		Status int                              `json:"status"`
+		Body   models.AuthEventListResponseBody `json:"body"`
+	}{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, http.StatusOK, resp.Status)
+	require.Len(t, resp.Body.Events, 1)
+	require.Equal(t, models.AuthEventLogin, resp.Body.Events[0].Type)
+}
+
+var queryAuthEventsTests = []struct {
+	id     int
+	url    string
+	called bool
+	status int
+}{
+	{1, "/auth-events?type=login_failed&ip=10.0.0.1&limit=20", true, http.StatusOK},
+	{2, "/auth-events?limit=many", false, http.StatusNotFound},
+}
+
+func TestQueryAuthEvents(t *testing.T) {
+	for _, test := range queryAuthEventsTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+		query := &models.AuthEventQuery{Type: models.AuthEventLoginFailed, IP: "10.0.0.1", Limit: 20}
+		useCaseMock.On("QueryAuthEvents", query).Return([]*models.AuthEvent{}, nil)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/auth-events", deliveryTest.QueryAuthEvents).Methods("GET")
+		req, err := http.NewRequest("GET", test.url, nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, resp.Status, test.id)
+		if test.called {
+			useCaseMock.AssertCalled(t, "QueryAuthEvents", query)
+		} else {
+			useCaseMock.AssertNotCalled(t, "QueryAuthEvents", mock.Anything)
+		}
+	}
+}
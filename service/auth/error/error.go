@@ -1,11 +1,54 @@
 package error
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
-	ErrUserNotFound = errors.New("user not found")
-	ErrCookie       = errors.New("error with cookie")
-	ErrEmptyData    = errors.New("required data is empty")
-	ErrPostgres     = errors.New("internal DB server error")
-	ErrUserExists   = errors.New("user already exists")
+	ErrUserNotFound     = errors.New("user not found")
+	ErrCookie           = errors.New("error with cookie")
+	ErrEmptyData        = errors.New("required data is empty")
+	ErrPostgres         = errors.New("internal DB server error")
+	ErrUserExists       = errors.New("user already exists")
+	ErrInvalidToken     = errors.New("token is invalid or expired")
+	ErrEmailNotVerified = errors.New("email is not verified")
+	ErrAlreadyVerified  = errors.New("email is already verified")
+	ErrNoVerifySecret   = errors.New("verification signing key is not configured")
+	ErrSessionNotFound  = errors.New("session not found")
+	ErrSessionExpired   = errors.New("session expired")
+
+	ErrInvalidTotpCode    = errors.New("invalid two-factor code")
+	ErrTotpAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTotpNotEnrolled    = errors.New("two-factor authentication is not enrolled")
+	ErrTotpNotEnabled     = errors.New("two-factor authentication is not enabled")
+
+	ErrTooManyAttempts = errors.New("too many login attempts, try again later")
+
+	ErrForbidden   = errors.New("not enough rights")
+	ErrUserBanned  = errors.New("user is banned")
+	ErrInvalidRole = errors.New("invalid role")
+
+	ErrApiTokenNotFound = errors.New("api token not found")
+	ErrInvalidScope     = errors.New("invalid api token scope")
+	ErrScopeNotGranted  = errors.New("api token has no scope for this request")
+
+	ErrOidcEmailNotVerified = errors.New("email is not verified by the identity provider")
+
+	ErrWrongPassword = errors.New("wrong password")
+
+	ErrInvalidAuthEventFilter = errors.New("invalid auth event filter")
 )
+
+//LockoutError - вход временно заблокирован после серии неудачных попыток
+type LockoutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockoutError) Error() string {
+	return ErrTooManyAttempts.Error()
+}
+
+func (e *LockoutError) Unwrap() error {
+	return ErrTooManyAttempts
+}
@@ -5,11 +5,36 @@ import (
 )
 
 type UseCase interface {
-	SignUp(u *models.User) (string, error)
-	SignIn(u *models.User) (string, error)
-	CreateSession(userId string) (string, error)
-	CheckSession(SessionId string) (string, error)
-	DeleteSession(SessionId string) error
+	SignUp(u *models.User, ip string, userAgent string) (string, error)
+	SignIn(u *models.User, ip string, userAgent string) (string, string, error)
+	CreateSession(userId string, userAgent string, ip string, rememberMe bool) (string, int, error)
+	CheckSession(SessionId string) (string, string, error)
+	DeleteSession(SessionId string, ip string, userAgent string) error
+	ListSessions(SessionId string) ([]*models.Session, error)
+	RevokeSession(SessionId string, id string, ip string, userAgent string) error
+	RevokeAllOtherSessions(SessionId string, ip string, userAgent string) error
 	CreateToken(userId string) (string, error)
 	CheckToken(csrfToken string) (string, error)
+	RequestPasswordReset(mail string) (string, error)
+	ConfirmPasswordReset(token string, password string, ip string, userAgent string) error
+	CreateVerificationToken(userId string) (string, string, error)
+	VerifyEmail(token string) error
+	IsEmailVerified(userId string) (bool, error)
+	EnrollTotp(userId string) (string, string, error)
+	ConfirmTotp(userId string, code string) ([]string, error)
+	DisableTotp(userId string, code string) error
+	CompleteTwoFactorLogin(challengeToken string, code string) (string, error)
+	BanUser(userId string, banned bool) error
+	SetRole(userId string, role string) error
+	CreateApiToken(userId string, name string, scopes []string, expiresIn int) (*models.ApiToken, error)
+	ListApiTokens(userId string) ([]*models.ApiToken, error)
+	RevokeApiToken(userId string, id string) error
+	CheckApiToken(token string) (string, string, []string, error)
+	StartOidcLogin(provider string) (string, string, error)
+	CompleteOidcLogin(provider string, state string, code string) (string, string, error)
+	RequestMagicLink(mail string) (string, error)
+	ConfirmMagicLink(token string) (string, string, error)
+	DeleteAccount(userId string, password string) ([]string, error)
+	ListAuthEvents(userId string) ([]*models.AuthEvent, error)
+	QueryAuthEvents(query *models.AuthEventQuery) ([]*models.AuthEvent, error)
 }
@@ -9,28 +9,43 @@ type UseCaseMock struct {
 	mock.Mock
 }
 
-func (m *UseCaseMock) SignUp(u *models.User) (string, error) {
-	args := m.Called(u)
+func (m *UseCaseMock) SignUp(u *models.User, ip string, userAgent string) (string, error) {
+	args := m.Called(u, ip, userAgent)
 	return args.Get(0).(string), args.Error(1)
 }
 
-func (m *UseCaseMock) SignIn(u *models.User) (string, error) {
-	args := m.Called(u)
-	return args.Get(0).(string), args.Error(1)
+func (m *UseCaseMock) SignIn(u *models.User, ip string, userAgent string) (string, string, error) {
+	args := m.Called(u, ip, userAgent)
+	return args.String(0), args.String(1), args.Error(2)
 }
 
-func (m *UseCaseMock) CreateSession(userId string) (string, error) {
-	args := m.Called(userId)
-	return args.Get(0).(string), args.Error(1)
+func (m *UseCaseMock) CreateSession(userId string, userAgent string, ip string, rememberMe bool) (string, int, error) {
+	args := m.Called(userId, userAgent, ip, rememberMe)
+	return args.Get(0).(string), args.Int(1), args.Error(2)
 }
 
-func (m *UseCaseMock) CheckSession(SessionId string) (string, error) {
+func (m *UseCaseMock) CheckSession(SessionId string) (string, string, error) {
 	args := m.Called(SessionId)
-	return args.Get(0).(string), args.Error(1)
+	return args.String(0), args.String(1), args.Error(2)
 }
 
-func (m *UseCaseMock) DeleteSession(SessionId string) error {
+func (m *UseCaseMock) ListSessions(SessionId string) ([]*models.Session, error) {
 	args := m.Called(SessionId)
+	return args.Get(0).([]*models.Session), args.Error(1)
+}
+
+func (m *UseCaseMock) RevokeSession(SessionId string, id string, ip string, userAgent string) error {
+	args := m.Called(SessionId, id, ip, userAgent)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) RevokeAllOtherSessions(SessionId string, ip string, userAgent string) error {
+	args := m.Called(SessionId, ip, userAgent)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) DeleteSession(SessionId string, ip string, userAgent string) error {
+	args := m.Called(SessionId, ip, userAgent)
 	return args.Error(0)
 }
 
@@ -43,3 +58,114 @@ func (m *UseCaseMock) CheckToken(csrfToken string) (string, error) {
 	args := m.Called(csrfToken)
 	return args.Get(0).(string), args.Error(1)
 }
+
+func (m *UseCaseMock) RequestPasswordReset(mail string) (string, error) {
+	args := m.Called(mail)
+	return args.Get(0).(string), args.Error(1)
+}
+
+func (m *UseCaseMock) ConfirmPasswordReset(token string, password string, ip string, userAgent string) error {
+	args := m.Called(token, password, ip, userAgent)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) CreateVerificationToken(userId string) (string, string, error) {
+	args := m.Called(userId)
+	return args.Get(0).(string), args.Get(1).(string), args.Error(2)
+}
+
+func (m *UseCaseMock) VerifyEmail(token string) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) IsEmailVerified(userId string) (bool, error) {
+	args := m.Called(userId)
+	return args.Get(0).(bool), args.Error(1)
+}
+
+func (m *UseCaseMock) EnrollTotp(userId string) (string, string, error) {
+	args := m.Called(userId)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) ConfirmTotp(userId string, code string) ([]string, error) {
+	args := m.Called(userId, code)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *UseCaseMock) DisableTotp(userId string, code string) error {
+	args := m.Called(userId, code)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) CompleteTwoFactorLogin(challengeToken string, code string) (string, error) {
+	args := m.Called(challengeToken, code)
+	return args.String(0), args.Error(1)
+}
+
+func (m *UseCaseMock) BanUser(userId string, banned bool) error {
+	args := m.Called(userId, banned)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) SetRole(userId string, role string) error {
+	args := m.Called(userId, role)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) CreateApiToken(userId string, name string, scopes []string, expiresIn int) (*models.ApiToken, error) {
+	args := m.Called(userId, name, scopes, expiresIn)
+	return args.Get(0).(*models.ApiToken), args.Error(1)
+}
+
+func (m *UseCaseMock) ListApiTokens(userId string) ([]*models.ApiToken, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*models.ApiToken), args.Error(1)
+}
+
+func (m *UseCaseMock) RevokeApiToken(userId string, id string) error {
+	args := m.Called(userId, id)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) CheckApiToken(token string) (string, string, []string, error) {
+	args := m.Called(token)
+	scopes, _ := args.Get(2).([]string)
+	return args.String(0), args.String(1), scopes, args.Error(3)
+}
+
+func (m *UseCaseMock) StartOidcLogin(provider string) (string, string, error) {
+	args := m.Called(provider)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) CompleteOidcLogin(provider string, state string, code string) (string, string, error) {
+	args := m.Called(provider, state, code)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) RequestMagicLink(mail string) (string, error) {
+	args := m.Called(mail)
+	return args.String(0), args.Error(1)
+}
+
+func (m *UseCaseMock) ConfirmMagicLink(token string) (string, string, error) {
+	args := m.Called(token)
+	return args.String(0), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) DeleteAccount(userId string, password string) ([]string, error) {
+	args := m.Called(userId, password)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *UseCaseMock) ListAuthEvents(userId string) ([]*models.AuthEvent, error) {
+	args := m.Called(userId)
+	return args.Get(0).([]*models.AuthEvent), args.Error(1)
+}
+
+func (m *UseCaseMock) QueryAuthEvents(query *models.AuthEventQuery) ([]*models.AuthEvent, error) {
+	args := m.Called(query)
+	return args.Get(0).([]*models.AuthEvent), args.Error(1)
+}
@@ -3,7 +3,13 @@ package usecase
 import (
 	protoAuth "backend/microservice/auth/proto"
 	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
 	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UseCase struct {
@@ -16,14 +22,14 @@ func NewUseCase(authService protoAuth.AuthClient) *UseCase {
 	}
 }
 
-func (s *UseCase) SignUp(u *models.User) (string, error) {
+func (s *UseCase) SignUp(u *models.User, ip string, userAgent string) (string, error) {
 	in := &protoAuth.SignUpRequest{
 		Name:     u.Name,
 		Surname:  u.Surname,
 		Mail:     u.Mail,
 		Password: u.Password,
 	}
-	out, err := s.client.SignUp(context.Background(), in)
+	out, err := s.client.SignUp(utils.ClientContext(context.Background(), ip, userAgent), in)
 	if err != nil {
 		return "", err
 	}
@@ -31,48 +37,110 @@ func (s *UseCase) SignUp(u *models.User) (string, error) {
 	return userId, nil
 }
 
-func (s *UseCase) SignIn(u *models.User) (string, error) {
+//Блокировка входа приходит как ResourceExhausted с RetryInfo
+func toLockoutError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return err
+	}
+	lockout := &error2.LockoutError{}
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			lockout.RetryAfter = retryInfo.RetryDelay.AsDuration()
+		}
+	}
+	return lockout
+}
+
+//Если у пользователя включена 2FA, вместо userId возвращается токен подтверждения
+func (s *UseCase) SignIn(u *models.User, ip string, userAgent string) (string, string, error) {
 	in := &protoAuth.SignInRequest{
 		Mail:     u.Mail,
 		Password: u.Password,
 	}
-	out, err := s.client.SignIn(context.Background(), in)
+	out, err := s.client.SignIn(utils.ClientContext(context.Background(), ip, userAgent), in)
 	if err != nil {
-		return "", err
+		return "", "", toLockoutError(err)
 	}
-	userId := out.ID
-	return userId, nil
+	return out.ID, out.ChallengeToken, nil
 }
 
-func (s *UseCase) CreateSession(userId string) (string, error) {
-	in := &protoAuth.UserId{
-		ID: userId,
+func (s *UseCase) CreateSession(userId string, userAgent string, ip string, rememberMe bool) (string, int, error) {
+	in := &protoAuth.CreateSessionRequest{
+		UserId:     userId,
+		UserAgent:  userAgent,
+		IP:         ip,
+		RememberMe: rememberMe,
 	}
 	out, err := s.client.CreateSession(context.Background(), in)
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
 	sessionId := out.Session
-	return sessionId, nil
+	return sessionId, int(out.MaxAge), nil
 }
 
-func (s *UseCase) CheckSession(SessionId string) (string, error) {
+func (s *UseCase) CheckSession(SessionId string) (string, string, error) {
 	in := &protoAuth.Session{
 		Session: SessionId,
 	}
 	out, err := s.client.CheckSession(context.Background(), in)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	userId := out.ID
-	return userId, nil
+	return out.ID, out.Role, nil
 }
 
-func (s *UseCase) DeleteSession(SessionId string) error {
+func (s *UseCase) DeleteSession(SessionId string, ip string, userAgent string) error {
 	in := &protoAuth.Session{
 		Session: SessionId,
 	}
-	_, err := s.client.DeleteSession(context.Background(), in)
+	_, err := s.client.DeleteSession(utils.ClientContext(context.Background(), ip, userAgent), in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *UseCase) ListSessions(SessionId string) ([]*models.Session, error) {
+	in := &protoAuth.Session{
+		Session: SessionId,
+	}
+	out, err := s.client.ListSessions(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.Session, len(out.Sessions))
+	for i, session := range out.Sessions {
+		result[i] = &models.Session{
+			ID:        session.ID,
+			CreatedAt: session.CreatedAt,
+			LastSeen:  session.LastSeen,
+			UserAgent: session.UserAgent,
+			IP:        session.IP,
+			Current:   session.Current,
+		}
+	}
+	return result, nil
+}
+
+func (s *UseCase) RevokeSession(SessionId string, id string, ip string, userAgent string) error {
+	in := &protoAuth.RevokeSessionRequest{
+		Session: SessionId,
+		ID:      id,
+	}
+	_, err := s.client.RevokeSession(utils.ClientContext(context.Background(), ip, userAgent), in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *UseCase) RevokeAllOtherSessions(SessionId string, ip string, userAgent string) error {
+	in := &protoAuth.Session{
+		Session: SessionId,
+	}
+	_, err := s.client.RevokeAllOtherSessions(utils.ClientContext(context.Background(), ip, userAgent), in)
 	if err != nil {
 		return err
 	}
@@ -102,3 +170,303 @@ func (s *UseCase) CheckToken(csrfToken string) (string, error) {
 	userId := out.ID
 	return userId, nil
 }
+
+func (s *UseCase) RequestPasswordReset(mail string) (string, error) {
+	in := &protoAuth.PasswordResetRequest{
+		Mail: mail,
+	}
+	out, err := s.client.RequestPasswordReset(context.Background(), in)
+	if err != nil {
+		return "", err
+	}
+	token := out.Token
+	return token, nil
+}
+
+func (s *UseCase) ConfirmPasswordReset(token string, password string, ip string, userAgent string) error {
+	in := &protoAuth.ConfirmPasswordResetRequest{
+		Token:    token,
+		Password: password,
+	}
+	_, err := s.client.ConfirmPasswordReset(utils.ClientContext(context.Background(), ip, userAgent), in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *UseCase) CreateVerificationToken(userId string) (string, string, error) {
+	in := &protoAuth.UserId{
+		ID: userId,
+	}
+	out, err := s.client.CreateVerificationToken(context.Background(), in)
+	if err != nil {
+		return "", "", err
+	}
+	return out.Token, out.Mail, nil
+}
+
+func (s *UseCase) VerifyEmail(token string) error {
+	in := &protoAuth.VerificationToken{
+		Token: token,
+	}
+	_, err := s.client.VerifyEmail(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *UseCase) IsEmailVerified(userId string) (bool, error) {
+	in := &protoAuth.UserId{
+		ID: userId,
+	}
+	out, err := s.client.IsEmailVerified(context.Background(), in)
+	if err != nil {
+		return false, err
+	}
+	result := out.Result
+	return result, nil
+}
+
+func (s *UseCase) EnrollTotp(userId string) (string, string, error) {
+	in := &protoAuth.UserId{
+		ID: userId,
+	}
+	out, err := s.client.EnrollTotp(context.Background(), in)
+	if err != nil {
+		return "", "", err
+	}
+	return out.Secret, out.URI, nil
+}
+
+func (s *UseCase) ConfirmTotp(userId string, code string) ([]string, error) {
+	in := &protoAuth.TotpCodeRequest{
+		UserId: userId,
+		Code:   code,
+	}
+	out, err := s.client.ConfirmTotp(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return out.Codes, nil
+}
+
+func (s *UseCase) DisableTotp(userId string, code string) error {
+	in := &protoAuth.TotpCodeRequest{
+		UserId: userId,
+		Code:   code,
+	}
+	_, err := s.client.DisableTotp(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *UseCase) CompleteTwoFactorLogin(challengeToken string, code string) (string, error) {
+	in := &protoAuth.TwoFactorLoginRequest{
+		ChallengeToken: challengeToken,
+		Code:           code,
+	}
+	out, err := s.client.CompleteTwoFactorLogin(context.Background(), in)
+	if err != nil {
+		return "", err
+	}
+	userId := out.ID
+	return userId, nil
+}
+
+func (s *UseCase) BanUser(userId string, banned bool) error {
+	in := &protoAuth.BanUserRequest{
+		UserId: userId,
+		Banned: banned,
+	}
+	_, err := s.client.BanUser(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *UseCase) SetRole(userId string, role string) error {
+	in := &protoAuth.SetRoleRequest{
+		UserId: userId,
+		Role:   role,
+	}
+	_, err := s.client.SetRole(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func toModelApiToken(token *protoAuth.ApiTokenInfo) *models.ApiToken {
+	return &models.ApiToken{
+		ID:         token.ID,
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		Token:      token.Token,
+	}
+}
+
+func (s *UseCase) CreateApiToken(userId string, name string, scopes []string, expiresIn int) (*models.ApiToken, error) {
+	in := &protoAuth.CreateApiTokenRequest{
+		UserId:    userId,
+		Name:      name,
+		Scopes:    scopes,
+		ExpiresIn: int64(expiresIn),
+	}
+	out, err := s.client.CreateApiToken(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return toModelApiToken(out), nil
+}
+
+func (s *UseCase) ListApiTokens(userId string) ([]*models.ApiToken, error) {
+	in := &protoAuth.UserId{
+		ID: userId,
+	}
+	out, err := s.client.ListApiTokens(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.ApiToken, len(out.Tokens))
+	for i, token := range out.Tokens {
+		result[i] = toModelApiToken(token)
+	}
+	return result, nil
+}
+
+func (s *UseCase) RevokeApiToken(userId string, id string) error {
+	in := &protoAuth.RevokeApiTokenRequest{
+		UserId: userId,
+		ID:     id,
+	}
+	_, err := s.client.RevokeApiToken(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *UseCase) CheckApiToken(token string) (string, string, []string, error) {
+	in := &protoAuth.ApiToken{
+		Token: token,
+	}
+	out, err := s.client.CheckApiToken(context.Background(), in)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return out.ID, out.Role, out.Scopes, nil
+}
+
+//Возвращает адрес провайдера для перенаправления и state, который gateway привязывает к браузеру
+func (s *UseCase) StartOidcLogin(provider string) (string, string, error) {
+	in := &protoAuth.OidcStartRequest{
+		Provider: provider,
+	}
+	out, err := s.client.StartOidcLogin(context.Background(), in)
+	if err != nil {
+		return "", "", err
+	}
+	return out.AuthURL, out.State, nil
+}
+
+//Как и SignIn: при включённой 2FA вместо userId возвращается токен подтверждения
+func (s *UseCase) CompleteOidcLogin(provider string, state string, code string) (string, string, error) {
+	in := &protoAuth.OidcCallbackRequest{
+		Provider: provider,
+		State:    state,
+		Code:     code,
+	}
+	out, err := s.client.CompleteOidcLogin(context.Background(), in)
+	if err != nil {
+		return "", "", err
+	}
+	return out.ID, out.ChallengeToken, nil
+}
+
+//Пустой токен - почты нет или пользователь заблокирован, письмо не отправляем
+func (s *UseCase) RequestMagicLink(mail string) (string, error) {
+	in := &protoAuth.MagicLinkRequest{
+		Mail: mail,
+	}
+	out, err := s.client.RequestMagicLink(context.Background(), in)
+	if err != nil {
+		return "", err
+	}
+	return out.Token, nil
+}
+
+//Как и SignIn: при включённой 2FA вместо userId возвращается токен подтверждения
+func (s *UseCase) ConfirmMagicLink(token string) (string, string, error) {
+	in := &protoAuth.MagicLinkToken{
+		Token: token,
+	}
+	out, err := s.client.ConfirmMagicLink(context.Background(), in)
+	if err != nil {
+		return "", "", err
+	}
+	return out.ID, out.ChallengeToken, nil
+}
+
+func (s *UseCase) DeleteAccount(userId string, password string) ([]string, error) {
+	in := &protoAuth.DeleteAccountRequest{
+		UserId:   userId,
+		Password: password,
+	}
+	out, err := s.client.DeleteAccount(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return out.ImgUrls, nil
+}
+
+func toModelAuthEvents(out *protoAuth.AuthEventList) []*models.AuthEvent {
+	result := make([]*models.AuthEvent, len(out.Events))
+	for i, event := range out.Events {
+		result[i] = &models.AuthEvent{
+			ID:        event.ID,
+			UserId:    event.UserId,
+			Type:      event.Type,
+			Mail:      event.Mail,
+			IP:        event.IP,
+			UserAgent: event.UserAgent,
+			CreatedAt: event.CreatedAt,
+		}
+	}
+	return result
+}
+
+func (s *UseCase) ListAuthEvents(userId string) ([]*models.AuthEvent, error) {
+	in := &protoAuth.UserId{
+		ID: userId,
+	}
+	out, err := s.client.ListAuthEvents(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return toModelAuthEvents(out), nil
+}
+
+func (s *UseCase) QueryAuthEvents(query *models.AuthEventQuery) ([]*models.AuthEvent, error) {
+	in := &protoAuth.AuthEventQuery{
+		UserId: query.UserId,
+		Type:   query.Type,
+		Mail:   query.Mail,
+		IP:     query.IP,
+		From:   query.From,
+		To:     query.To,
+		Limit:  int64(query.Limit),
+	}
+	out, err := s.client.QueryAuthEvents(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return toModelAuthEvents(out), nil
+}
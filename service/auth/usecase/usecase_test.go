@@ -4,10 +4,19 @@ import (
 	protoAuth "backend/microservice/auth/proto"
 	"backend/microservice/auth/usecase"
 	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/auth/error"
 	"context"
 	"errors"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"testing"
+	"time"
 )
 
 var signUpTests = []struct {
@@ -48,54 +57,87 @@ func TestSignUp(t *testing.T) {
 			Password: test.input.Password,
 		}
 		clientMock.On("SignUp", context.Background(), in).Return(test.clientRes, test.clientErr)
-		res, err := useCaseTest.SignUp(test.input)
+		res, err := useCaseTest.SignUp(test.input, "", "")
 		require.Equal(t, test.clientErr, err)
 		require.Equal(t, test.output, res)
 	}
 }
 
 var signInTests = []struct {
-	id        int
-	input     *models.User
-	clientRes *protoAuth.UserId
-	clientErr error
-	output    string
+	id              int
+	input           *models.User
+	clientRes       *protoAuth.SignInResponse
+	clientErr       error
+	output          string
+	outputChallenge string
 }{
 	{
 		1,
 		&models.User{},
-		&protoAuth.UserId{
+		&protoAuth.SignInResponse{
 			ID: "test",
 		},
 		nil,
 		"test",
+		"",
 	},
 	{
 		2,
 		&models.User{},
-		&protoAuth.UserId{
+		&protoAuth.SignInResponse{
 			ID: "",
 		},
 		errors.New("test_err"),
 		"",
+		"",
+	},
+	{
+		3,
+		&models.User{},
+		&protoAuth.SignInResponse{
+			ChallengeToken: "challenge",
+		},
+		nil,
+		"",
+		"challenge",
 	},
 }
 
 func TestSignIn(t *testing.T) {
-	for _, test := range signUpTests {
+	for _, test := range signInTests {
 		clientMock := new(usecase.AuthClientMock)
 		useCaseTest := NewUseCase(clientMock)
 		in := &protoAuth.SignInRequest{
 			Mail:     test.input.Mail,
 			Password: test.input.Password,
 		}
-		clientMock.On("SignIn", context.Background(), in).Return(test.clientRes, test.clientErr)
-		res, err := useCaseTest.SignIn(test.input)
+		clientMock.On("SignIn", mock.MatchedBy(func(ctx context.Context) bool {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			return len(md.Get(utils.ClientIPMetadataKey)) == 1 && md.Get(utils.ClientIPMetadataKey)[0] == "127.0.0.1" &&
+				len(md.Get(utils.UserAgentMetadataKey)) == 1 && md.Get(utils.UserAgentMetadataKey)[0] == "Mozilla/5.0"
+		}), in).Return(test.clientRes, test.clientErr)
+		res, challenge, err := useCaseTest.SignIn(test.input, "127.0.0.1", "Mozilla/5.0")
 		require.Equal(t, test.clientErr, err)
 		require.Equal(t, test.output, res)
+		require.Equal(t, test.outputChallenge, challenge)
 	}
 }
 
+func TestSignInLockout(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	st, err := status.New(codes.ResourceExhausted, error2.ErrTooManyAttempts.Error()).
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Minute)})
+	require.NoError(t, err)
+	clientMock.On("SignIn", mock.Anything, mock.Anything).Return(&protoAuth.SignInResponse{}, st.Err())
+
+	_, _, err = useCaseTest.SignIn(&models.User{}, "", "")
+	lockout, ok := err.(*error2.LockoutError)
+	require.True(t, ok)
+	require.Equal(t, time.Minute, lockout.RetryAfter)
+	require.True(t, errors.Is(err, error2.ErrTooManyAttempts))
+}
+
 var createSessionTests = []struct {
 	id        int
 	input     string
@@ -108,6 +150,7 @@ var createSessionTests = []struct {
 		"test",
 		&protoAuth.Session{
 			Session: "test",
+			MaxAge:  2592000,
 		},
 		nil,
 		"test",
@@ -127,40 +170,48 @@ func TestCreateSession(t *testing.T) {
 	for _, test := range createSessionTests {
 		clientMock := new(usecase.AuthClientMock)
 		useCaseTest := NewUseCase(clientMock)
-		in := &protoAuth.UserId{
-			ID: test.input,
+		in := &protoAuth.CreateSessionRequest{
+			UserId:    test.input,
+			UserAgent:  "Mozilla/5.0",
+			IP:         "127.0.0.1",
+			RememberMe: true,
 		}
 		clientMock.On("CreateSession", context.Background(), in).Return(test.clientRes, test.clientErr)
-		res, err := useCaseTest.CreateSession(test.input)
+		res, maxAge, err := useCaseTest.CreateSession(test.input, "Mozilla/5.0", "127.0.0.1", true)
 		require.Equal(t, test.clientErr, err)
 		require.Equal(t, test.output, res)
+		require.Equal(t, int(test.clientRes.MaxAge), maxAge)
 	}
 }
 
 var checkSessionTests = []struct {
-	id        int
-	input     string
-	clientRes *protoAuth.UserId
-	clientErr error
-	output    string
+	id         int
+	input      string
+	clientRes  *protoAuth.SessionUser
+	clientErr  error
+	output     string
+	outputRole string
 }{
 	{
 		1,
 		"test",
-		&protoAuth.UserId{
-			ID: "test",
+		&protoAuth.SessionUser{
+			ID:   "test",
+			Role: "moderator",
 		},
 		nil,
 		"test",
+		"moderator",
 	},
 	{
 		2,
 		"test",
-		&protoAuth.UserId{
+		&protoAuth.SessionUser{
 			ID: "",
 		},
 		errors.New("test_err"),
 		"",
+		"",
 	},
 }
 
@@ -172,9 +223,10 @@ func TestCheckSession(t *testing.T) {
 			Session: test.input,
 		}
 		clientMock.On("CheckSession", context.Background(), in).Return(test.clientRes, test.clientErr)
-		res, err := useCaseTest.CheckSession(test.input)
+		res, role, err := useCaseTest.CheckSession(test.input)
 		require.Equal(t, test.clientErr, err)
 		require.Equal(t, test.output, res)
+		require.Equal(t, test.outputRole, role)
 	}
 }
 
@@ -210,7 +262,7 @@ func TestDeleteSession(t *testing.T) {
 			Session: test.input,
 		}
 		clientMock.On("DeleteSession", context.Background(), in).Return(&protoAuth.Success{}, test.clientErr)
-		err := useCaseTest.DeleteSession(test.input)
+		err := useCaseTest.DeleteSession(test.input, "", "")
 		require.Equal(t, test.clientErr, err)
 	}
 }
@@ -284,7 +336,7 @@ var checkTokenTests = []struct {
 }
 
 func TestCheckToken(t *testing.T) {
-	for _, test := range checkSessionTests {
+	for _, test := range checkTokenTests {
 		clientMock := new(usecase.AuthClientMock)
 		useCaseTest := NewUseCase(clientMock)
 		in := &protoAuth.CSRFToken{
@@ -296,3 +348,287 @@ func TestCheckToken(t *testing.T) {
 		require.Equal(t, test.output, res)
 	}
 }
+
+var requestPasswordResetTests = []struct {
+	id        int
+	input     string
+	clientRes *protoAuth.PasswordResetToken
+	clientErr error
+	output    string
+}{
+	{
+		1,
+		"test@mail.ru",
+		&protoAuth.PasswordResetToken{
+			Token: "token",
+		},
+		nil,
+		"token",
+	},
+	{
+		2,
+		"test@mail.ru",
+		&protoAuth.PasswordResetToken{},
+		errors.New("test_err"),
+		"",
+	},
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	for _, test := range requestPasswordResetTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.PasswordResetRequest{
+			Mail: test.input,
+		}
+		clientMock.On("RequestPasswordReset", context.Background(), in).Return(test.clientRes, test.clientErr)
+		res, err := useCaseTest.RequestPasswordReset(test.input)
+		require.Equal(t, test.clientErr, err)
+		require.Equal(t, test.output, res)
+	}
+}
+
+var confirmPasswordResetTests = []struct {
+	id        int
+	token     string
+	password  string
+	clientErr error
+}{
+	{
+		1,
+		"token",
+		"password",
+		nil,
+	},
+	{
+		2,
+		"token",
+		"password",
+		errors.New("test_err"),
+	},
+}
+
+func TestConfirmPasswordReset(t *testing.T) {
+	for _, test := range confirmPasswordResetTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.ConfirmPasswordResetRequest{
+			Token:    test.token,
+			Password: test.password,
+		}
+		clientMock.On("ConfirmPasswordReset", context.Background(), in).Return(&protoAuth.Success{}, test.clientErr)
+		err := useCaseTest.ConfirmPasswordReset(test.token, test.password, "", "")
+		require.Equal(t, test.clientErr, err)
+	}
+}
+
+var createVerificationTokenTests = []struct {
+	id        int
+	input     string
+	clientRes *protoAuth.VerificationToken
+	clientErr error
+	token     string
+	mail      string
+}{
+	{
+		1,
+		"1",
+		&protoAuth.VerificationToken{
+			Token: "token",
+			Mail:  "test@mail.ru",
+		},
+		nil,
+		"token",
+		"test@mail.ru",
+	},
+	{
+		2,
+		"1",
+		&protoAuth.VerificationToken{},
+		errors.New("test_err"),
+		"",
+		"",
+	},
+}
+
+func TestCreateVerificationToken(t *testing.T) {
+	for _, test := range createVerificationTokenTests {
+		clientMock := new(usecase.AuthClientMock)
+		useCaseTest := NewUseCase(clientMock)
+		in := &protoAuth.UserId{
+			ID: test.input,
+		}
+		clientMock.On("CreateVerificationToken", context.Background(), in).Return(test.clientRes, test.clientErr)
+		token, mail, err := useCaseTest.CreateVerificationToken(test.input)
+		require.Equal(t, test.clientErr, err)
+		require.Equal(t, test.token, token)
+		require.Equal(t, test.mail, mail)
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.VerificationToken{
+		Token: "token",
+	}
+	clientMock.On("VerifyEmail", context.Background(), in).Return(&protoAuth.Success{}, nil)
+	err := useCaseTest.VerifyEmail("token")
+	require.NoError(t, err)
+}
+
+func TestIsEmailVerified(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.UserId{
+		ID: "1",
+	}
+	clientMock.On("IsEmailVerified", context.Background(), in).Return(&protoAuth.EmailVerified{Result: true}, nil)
+	res, err := useCaseTest.IsEmailVerified("1")
+	require.NoError(t, err)
+	require.True(t, res)
+}
+
+func TestBanUser(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.BanUserRequest{
+		UserId: "2",
+		Banned: true,
+	}
+	clientMock.On("BanUser", context.Background(), in).Return(&protoAuth.Success{}, nil)
+	err := useCaseTest.BanUser("2", true)
+	require.NoError(t, err)
+	clientMock.AssertExpectations(t)
+}
+
+func TestSetRole(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.SetRoleRequest{
+		UserId: "2",
+		Role:   "moderator",
+	}
+	clientMock.On("SetRole", context.Background(), in).Return(&protoAuth.Success{}, errors.New("test_err"))
+	err := useCaseTest.SetRole("2", "moderator")
+	require.Equal(t, errors.New("test_err"), err)
+}
+
+func TestCreateApiToken(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.CreateApiTokenRequest{
+		UserId:    "1",
+		Name:      "script",
+		Scopes:    []string{"read"},
+		ExpiresIn: 60,
+	}
+	out := &protoAuth.ApiTokenInfo{
+		ID:     "5",
+		Name:   "script",
+		Scopes: []string{"read"},
+		Token:  "bmsa_secret",
+	}
+	clientMock.On("CreateApiToken", context.Background(), in).Return(out, nil)
+	token, err := useCaseTest.CreateApiToken("1", "script", []string{"read"}, 60)
+	require.NoError(t, err)
+	require.Equal(t, "5", token.ID)
+	require.Equal(t, "bmsa_secret", token.Token)
+}
+
+func TestCheckApiToken(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.ApiToken{
+		Token: "bmsa_secret",
+	}
+	out := &protoAuth.SessionUser{
+		ID:     "1",
+		Role:   "user",
+		Scopes: []string{"events:write"},
+	}
+	clientMock.On("CheckApiToken", context.Background(), in).Return(out, nil)
+	userId, role, scopes, err := useCaseTest.CheckApiToken("bmsa_secret")
+	require.NoError(t, err)
+	require.Equal(t, "1", userId)
+	require.Equal(t, "user", role)
+	require.Equal(t, []string{"events:write"}, scopes)
+}
+
+func TestStartOidcLogin(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.OidcStartRequest{
+		Provider: "google",
+	}
+	out := &protoAuth.OidcStartResponse{
+		AuthURL: "https://accounts.google.com/auth",
+		State:   "state",
+	}
+	clientMock.On("StartOidcLogin", context.Background(), in).Return(out, nil)
+	authURL, state, err := useCaseTest.StartOidcLogin("google")
+	require.NoError(t, err)
+	require.Equal(t, "https://accounts.google.com/auth", authURL)
+	require.Equal(t, "state", state)
+}
+
+func TestCompleteOidcLogin(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.OidcCallbackRequest{
+		Provider: "google",
+		State:    "state",
+		Code:     "code",
+	}
+	clientMock.On("CompleteOidcLogin", context.Background(), in).Return(&protoAuth.SignInResponse{ChallengeToken: "challenge"}, nil)
+	userId, challengeToken, err := useCaseTest.CompleteOidcLogin("google", "state", "code")
+	require.NoError(t, err)
+	require.Equal(t, "", userId)
+	require.Equal(t, "challenge", challengeToken)
+}
+
+func TestConfirmMagicLink(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.MagicLinkToken{
+		Token: "token",
+	}
+	clientMock.On("ConfirmMagicLink", context.Background(), in).Return(&protoAuth.SignInResponse{ID: "1"}, nil)
+	userId, challengeToken, err := useCaseTest.ConfirmMagicLink("token")
+	require.NoError(t, err)
+	require.Equal(t, "1", userId)
+	require.Equal(t, "", challengeToken)
+}
+
+func TestDeleteAccount(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.DeleteAccountRequest{
+		UserId:   "1",
+		Password: "password",
+	}
+	clientMock.On("DeleteAccount", context.Background(), in).Return(&protoAuth.DeletedAccount{ImgUrls: []string{"avatar.png"}}, nil)
+	imgUrls, err := useCaseTest.DeleteAccount("1", "password")
+	require.NoError(t, err)
+	require.Equal(t, []string{"avatar.png"}, imgUrls)
+}
+
+func TestQueryAuthEvents(t *testing.T) {
+	clientMock := new(usecase.AuthClientMock)
+	useCaseTest := NewUseCase(clientMock)
+	in := &protoAuth.AuthEventQuery{
+		UserId: "1",
+		From:   "2022-05-01T00:00:00Z",
+		Limit:  20,
+	}
+	clientMock.On("QueryAuthEvents", context.Background(), in).Return(&protoAuth.AuthEventList{
+		Events: []*protoAuth.AuthEvent{{ID: "2", UserId: "1", Type: models.AuthEventLogout}},
+	}, nil)
+	events, err := useCaseTest.QueryAuthEvents(&models.AuthEventQuery{
+		UserId: "1",
+		From:   "2022-05-01T00:00:00Z",
+		Limit:  20,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*models.AuthEvent{{ID: "2", UserId: "1", Type: models.AuthEventLogout}}, events)
+}
@@ -2,16 +2,26 @@ package http
 
 import (
 	log "backend/pkg/logger"
+	"backend/pkg/models"
 	"backend/pkg/response"
 	"backend/pkg/utils"
 	"backend/service/event"
+	"backend/service/email"
+	error2 "backend/service/event/error"
 	"errors"
 	"github.com/gorilla/mux"
+	"github.com/skip2/go-qrcode"
+	"github.com/spf13/viper"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
-const logMessage = "service:event:delivery:http:"
+const (
+	logMessage = "service:event:delivery:http:"
+	//Сторона QR-кода билета в пикселях
+	ticketQRSize = 256
+)
 
 type Delivery struct {
 	useCase event.UseCase
@@ -102,6 +112,39 @@ func (h *Delivery) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "ended")
 }
 
+func (h *Delivery) ForceDeleteEvent(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ForceDeleteEvent:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	eventId := vars["id"]
+	err := h.useCase.ForceDeleteEvent(eventId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) HideEvent(w http.ResponseWriter, r *http.Request) {
+	h.setEventHidden(w, r, true, logMessage+"HideEvent:")
+}
+
+func (h *Delivery) UnhideEvent(w http.ResponseWriter, r *http.Request) {
+	h.setEventHidden(w, r, false, logMessage+"UnhideEvent:")
+}
+
+func (h *Delivery) setEventHidden(w http.ResponseWriter, r *http.Request, hidden bool, message string) {
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	eventId := vars["id"]
+	err := h.useCase.SetEventHidden(eventId, hidden)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
 func (h *Delivery) GetEventById(w http.ResponseWriter, r *http.Request) {
 	message := logMessage + "GetEvent:"
 	log.Debug(message + "started")
@@ -112,9 +155,35 @@ func (h *Delivery) GetEventById(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	log.Debug("delivery:getEvent:resultEvent.authorId = ", resultEvent.AuthorId)
+	//userId и visitorId кладёт middleware Viewer; без них просмотр не записывается.
+	//Ошибка записи просмотра не мешает отдать мероприятие
+	userId, _ := r.Context().Value("userId").(string)
+	visitorId, _ := r.Context().Value("visitorId").(string)
+	if userId != "" || visitorId != "" {
+		err = h.useCase.RecordView(eventId, userId, visitorId)
+		if err != nil {
+			log.Error(message+"RecordView:err = ", err)
+		}
+	}
 	response.SendResponse(w, response.EventResponse(resultEvent))
 }
 
+//Просмотры и добавления в избранное по дням; from и to - 2006-01-02, включительно
+func (h *Delivery) GetEventStats(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetEventStats:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	eventId := vars["id"]
+	userId := r.Context().Value("userId").(string)
+	q := r.URL.Query()
+	stats, err := h.useCase.GetEventStats(eventId, userId, q.Get("from"), q.Get("to"))
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.EventStatsResponse(stats))
+	log.Debug(message + "ended")
+}
+
 type getEventsVars struct {
 	title    string   `valid:"type(string),length(0|50)" san:"xss"`
 	category string   `valid:"type(string),length(0|50)" san:"xss"`
@@ -154,12 +223,78 @@ func (h *Delivery) GetEvents(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message+"city = ", city)
 	log.Debug(message+"date = ", date)
 	log.Debug(message+"tags = ", tags)
+	page, err := utils.GetPage(r)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
 
-	eventsList, err := h.useCase.GetEvents(title, category, city, date, tags)
+	//from и to - RFC 3339, when - upcoming или past
+	filter := &models.EventFilter{
+		Query:    title,
+		Category: category,
+		City:     city,
+		Date:     date,
+		Tags:     tags,
+		From:     q.Get("from"),
+		To:       q.Get("to"),
+		When:     q.Get("when"),
+	}
+	//lat, lon, radius_km - только все три вместе
+	if q.Get("lat") != "" || q.Get("lon") != "" || q.Get("radius_km") != "" {
+		values, err := parseFloats([]string{q.Get("lat"), q.Get("lon"), q.Get("radius_km")})
+		if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+			return
+		}
+		filter.Near = &models.Location{Lat: values[0], Lon: values[1]}
+		filter.RadiusKm = values[2]
+	}
+
+	eventsList, nextCursor, err := h.useCase.GetEvents(filter, page)
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	response.SendResponse(w, response.EventListResponse(eventsList))
+	response.SendResponse(w, response.EventListResponse(eventsList, nextCursor))
+}
+
+//bbox - "minLon,minLat,maxLon,maxLat", порядок как в GeoJSON
+func (h *Delivery) GetMapEvents(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetMapEvents:"
+	log.Debug(message + "started")
+	q := r.URL.Query()
+	values, err := parseFloats(strings.Split(q.Get("bbox"), ","))
+	if err != nil || len(values) != 4 {
+		utils.CheckIfNoError(&w, error2.ErrInvalidGeoFilter, message, http.StatusBadRequest)
+		return
+	}
+	box := &models.BoundingBox{
+		MinLon: values[0],
+		MinLat: values[1],
+		MaxLon: values[2],
+		MaxLat: values[3],
+	}
+	filter := &models.EventFilter{
+		Category: q.Get("category"),
+		From:     q.Get("from"),
+		To:       q.Get("to"),
+		When:     q.Get("when"),
+	}
+	eventsList, err := h.useCase.GetMapEvents(box, filter)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.EventMapResponse(eventsList))
+}
+
+func parseFloats(values []string) ([]float64, error) {
+	result := make([]float64, len(values))
+	for i, value := range values {
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, error2.ErrInvalidGeoFilter
+		}
+		result[i] = f
+	}
+	return result, nil
 }
 
 func (h *Delivery) GetVisitedEvents(w http.ResponseWriter, r *http.Request) {
@@ -167,11 +302,15 @@ func (h *Delivery) GetVisitedEvents(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "started")
 	vars := mux.Vars(r)
 	userId := vars["id"]
-	eventList, err := h.useCase.GetVisitedEvents(userId)
+	page, err := utils.GetPage(r)
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	response.SendResponse(w, response.EventListResponse(eventList))
+	eventList, nextCursor, err := h.useCase.GetVisitedEvents(userId, page)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.EventListResponse(eventList, nextCursor))
 }
 
 func (h *Delivery) GetCreatedEvents(w http.ResponseWriter, r *http.Request) {
@@ -179,11 +318,239 @@ func (h *Delivery) GetCreatedEvents(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "started")
 	vars := mux.Vars(r)
 	userId := vars["id"]
-	eventList, err := h.useCase.GetCreatedEvents(userId)
+	page, err := utils.GetPage(r)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	eventList, nextCursor, err := h.useCase.GetCreatedEvents(userId, page)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.EventListResponse(eventList, nextCursor))
+}
+
+func (h *Delivery) Register(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "Register:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	eventId := vars["id"]
+	registration, err := h.useCase.Register(eventId, userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	notifyPromoted(eventId, registration)
+	response.SendResponse(w, response.RegistrationResponse(registration))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) CancelRegistration(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "CancelRegistration:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	eventId := vars["id"]
+	registration, err := h.useCase.CancelRegistration(eventId, userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	notifyPromoted(eventId, registration)
+	response.SendResponse(w, response.RegistrationResponse(registration))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) GetRegistration(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetRegistration:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	registration, err := h.useCase.GetRegistration(vars["id"], userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.RegistrationResponse(registration))
+	log.Debug(message + "ended")
+}
+
+//Письмо тем, кого перевели из листа ожидания в участники
+func notifyPromoted(eventId string, registration *models.Registration) {
+	if len(registration.Promoted) == 0 {
+		return
+	}
+	link := viper.GetString("main_host") + "/events/" + eventId
+	for _, p := range registration.Promoted {
+		email.SendEmail("Место на мероприятии", "Освободилось место на мероприятии «"+registration.EventTitle+
+			"», и вы переведены из листа ожидания в участники: "+link+
+			"\nЕсли планы изменились, отмените запись, чтобы место досталось следующему.", []string{p.Mail})
+	}
+}
+
+//Тело - {"status": "going" | "interested" | "not_going"}
+//going - только с подтверждённой записью на мероприятие, на закончившееся ответить нельзя
+func (h *Delivery) SetRsvp(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "SetRsvp:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	in, err := response.GetRsvpFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	rsvp, err := h.useCase.SetRsvp(vars["id"], userId, in.Status)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.RsvpResponse(rsvp))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) DeleteRsvp(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "DeleteRsvp:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	rsvp, err := h.useCase.DeleteRsvp(vars["id"], userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.RsvpResponse(rsvp))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) GetRsvp(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetRsvp:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	rsvp, err := h.useCase.GetRsvp(vars["id"], userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.RsvpResponse(rsvp))
+	log.Debug(message + "ended")
+}
+
+//Билет отдаётся картинкой - QR-кодом с подписанным токеном, его сканирует организатор
+func (h *Delivery) GetTicket(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetTicket:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	ticket, err := h.useCase.GetTicket(vars["id"], userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	png, err := qrcode.Encode(ticket.Code, qrcode.Medium, ticketQRSize)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "no-store")
+	_, err = w.Write(png)
+	if err != nil {
+		log.Error(message+"err =", err)
+		return
+	}
+	log.Debug(message + "ended")
+}
+
+//Тело - {"code": "<содержимое QR-кода билета>"}
+func (h *Delivery) CheckIn(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "CheckIn:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	in, err := response.GetTicketCodeFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	checkIn, err := h.useCase.CheckIn(vars["id"], userId, in.Code)
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	response.SendResponse(w, response.EventListResponse(eventList))
+	response.SendResponse(w, response.CheckInResponse(checkIn))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) GetAttendance(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetAttendance:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	attendance, err := h.useCase.GetAttendance(vars["id"], userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.AttendanceResponse(attendance))
+	log.Debug(message + "ended")
+}
+
+//?parent=<id> - ответы на комментарий, без него - верхний уровень ветки
+func (h *Delivery) GetComments(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetComments:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	page, err := utils.GetPage(r)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	parentId := r.URL.Query().Get("parent")
+	comments, nextCursor, err := h.useCase.GetComments(vars["id"], parentId, page)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.CommentListResponse(comments, nextCursor))
+	log.Debug(message + "ended")
+}
+
+//Тело - {"text": "...", "parentId": "<id комментария, если это ответ>"}
+func (h *Delivery) CreateComment(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "CreateComment:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	c, err := response.GetCommentFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	c.EventId = vars["id"]
+	c.UserId = userId
+	comment, err := h.useCase.CreateComment(c)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.CommentResponse(comment))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "UpdateComment:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	c, err := response.GetCommentFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	comment, err := h.useCase.UpdateComment(vars["commentId"], vars["id"], userId, c.Text)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.CommentResponse(comment))
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "DeleteComment:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	err := h.useCase.DeleteComment(vars["commentId"], vars["id"], userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
 }
 
 func (h *Delivery) Visit(w http.ResponseWriter, r *http.Request) {
@@ -256,3 +623,39 @@ func (h *Delivery) GetCities(w http.ResponseWriter, r *http.Request) {
 	response.SendResponse(w, response.CitiesResponse(res))
 	log.Debug(message + "ended")
 }
+
+func (h *Delivery) GetReviews(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetReviews:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	page, err := utils.GetPage(r)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	reviews, nextCursor, err := h.useCase.GetReviews(vars["id"], page)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.ReviewListResponse(reviews, nextCursor))
+	log.Debug(message + "ended")
+}
+
+//Тело - {"rating": 1..5, "text": "..."}, текст необязателен
+func (h *Delivery) CreateReview(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "CreateReview:"
+	log.Debug(message + "started")
+	vars := r.Context().Value("vars").(map[string]string)
+	userId := r.Context().Value("userId").(string)
+	rv, err := response.GetReviewFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	rv.EventId = vars["id"]
+	rv.UserId = userId
+	review, err := h.useCase.CreateReview(rv)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.ReviewResponse(review))
+	log.Debug(message + "ended")
+}
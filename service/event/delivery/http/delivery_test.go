@@ -2,6 +2,8 @@ package http
 
 import (
 	"backend/pkg/models"
+	"backend/pkg/utils"
+	error2 "backend/service/event/error"
 	"backend/service/event/usecase"
 	"bytes"
 	"context"
@@ -244,6 +246,222 @@ func TestGetEventById(t *testing.T) {
 	}
 }
 
+func TestGetEventByIdRecordsView(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	useCaseMock.On("GetEventById", "1").Return(&models.Event{ID: "1"}, nil)
+	useCaseMock.On("RecordView", "1", "", "visitor").Return(errors.New("test_err"))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/event/{id:[0-9]+}", deliveryTest.GetEventById).Methods("GET")
+	req, err := http.NewRequest("GET", "/event/1", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	req = req.WithContext(context.WithValue(req.Context(), "visitorId", "visitor"))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	//Ошибка записи просмотра не мешает отдать мероприятие
+	require.Contains(t, w.Body.String(), `"id":"1"`)
+	useCaseMock.AssertNumberOfCalls(t, "RecordView", 1)
+}
+
+func TestGetEventStats(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	stats := &models.EventStats{
+		EventId: "1",
+		Days:    []*models.DayStats{{Date: "2021-11-13", Views: 10, Favourites: 2}},
+	}
+	useCaseMock.On("GetEventStats", "1", "5", "2021-11-13", "").Return(stats, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events/{id}/stats", func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "vars", mux.Vars(r))
+		ctx = context.WithValue(ctx, "userId", "5")
+		deliveryTest.GetEventStats(w, r.WithContext(ctx))
+	}).Methods("GET")
+	req, err := http.NewRequest("GET", "/events/1/stats?from=2021-11-13", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), `"days":[{"date":"2021-11-13","views":10,"favourites":2}]`)
+}
+
+func TestGetRegistration(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	registration := &models.Registration{Status: models.RegistrationWaitlisted, Position: 1, Registered: 2, Capacity: 2}
+	useCaseMock.On("GetRegistration", "1", "5").Return(registration, nil)
+	useCaseMock.On("CancelRegistration", "1", "5").Return(&models.Registration{}, error2.ErrNotRegistered)
+
+	r := mux.NewRouter()
+	withUser := func(handler http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), "vars", mux.Vars(r))
+			ctx = context.WithValue(ctx, "userId", "5")
+			handler(w, r.WithContext(ctx))
+		}
+	}
+	r.HandleFunc("/events/{id}/registration", withUser(deliveryTest.GetRegistration)).Methods("GET")
+	r.HandleFunc("/events/{id}/registration", withUser(deliveryTest.CancelRegistration)).Methods("DELETE")
+	req, err := http.NewRequest("GET", "/events/1/registration", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), `"status":"waitlisted","position":1,"registered":2,"capacity":2`)
+
+	req, err = http.NewRequest("DELETE", "/events/1/registration", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), error2.ErrNotRegistered.Error())
+}
+
+func TestSetRsvp(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	rsvp := &models.Rsvp{Status: models.RsvpInterested, Going: 3, Interested: 7}
+	useCaseMock.On("SetRsvp", "1", "5", models.RsvpInterested).Return(rsvp, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events/{id}/rsvp", func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "vars", mux.Vars(r))
+		ctx = context.WithValue(ctx, "userId", "5")
+		deliveryTest.SetRsvp(w, r.WithContext(ctx))
+	}).Methods("POST")
+	req, err := http.NewRequest("POST", "/events/1/rsvp", strings.NewReader(`{"status":"interested"}`))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), `"status":"interested","going":3,"interested":7`)
+
+	req, err = http.NewRequest("POST", "/events/1/rsvp", strings.NewReader(`{"status":`))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	useCaseMock.AssertNumberOfCalls(t, "SetRsvp", 1)
+}
+
+func TestGetTicket(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	useCaseMock.On("GetTicket", "1", "5").Return(&models.Ticket{Code: "code", EventId: "1", UserId: "5"}, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events/{id}/ticket", func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "vars", mux.Vars(r))
+		ctx = context.WithValue(ctx, "userId", "5")
+		deliveryTest.GetTicket(w, r.WithContext(ctx))
+	}).Methods("GET")
+	req, err := http.NewRequest("GET", "/events/1/ticket", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Equal(t, "image/png", w.Header().Get("Content-Type"))
+	require.True(t, strings.HasPrefix(w.Body.String(), "\x89PNG"))
+}
+
+func TestCheckIn(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	checkIn := &models.CheckIn{
+		UserId:      "5",
+		CheckedInAt: "2021-11-13T18:30:00Z",
+		Attendance:  models.Attendance{Attended: 1, Registered: 2},
+	}
+	useCaseMock.On("CheckIn", "1", "9", "code").Return(checkIn, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events/{id}/checkin", func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "vars", mux.Vars(r))
+		ctx = context.WithValue(ctx, "userId", "9")
+		deliveryTest.CheckIn(w, r.WithContext(ctx))
+	}).Methods("POST")
+	req, err := http.NewRequest("POST", "/events/1/checkin", strings.NewReader(`{"code":"code"}`))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), `"userId":"5","checkedInAt":"2021-11-13T18:30:00Z","attended":1,"registered":2`)
+}
+
+func TestCreateComment(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	//Разметка из текста убирается до usecase
+	in := &models.Comment{EventId: "1", UserId: "5", Text: " b Пойду /b "}
+	useCaseMock.On("CreateComment", in).Return(&models.Comment{
+		ID:        "3",
+		EventId:   "1",
+		UserId:    "5",
+		Text:      " b Пойду /b ",
+		CreatedAt: "2021-11-13T18:30:00Z",
+	}, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events/{id}/comments", func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "vars", mux.Vars(r))
+		ctx = context.WithValue(ctx, "userId", "5")
+		deliveryTest.CreateComment(w, r.WithContext(ctx))
+	}).Methods("POST")
+	req, err := http.NewRequest("POST", "/events/1/comments", strings.NewReader(`{"text":"<b>Пойду</b>"}`))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), `"id":"3"`)
+	useCaseMock.AssertNumberOfCalls(t, "CreateComment", 1)
+}
+
+func TestGetComments(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	comments := []*models.Comment{{ID: "3", EventId: "1", UserId: "5", Deleted: true, Replies: 2}}
+	useCaseMock.On("GetComments", "1", "2", &models.Page{Limit: 5}).Return(comments, "next", nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events/{id}/comments", func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "vars", mux.Vars(r))
+		deliveryTest.GetComments(w, r.WithContext(ctx))
+	}).Methods("GET")
+	req, err := http.NewRequest("GET", "/events/1/comments?parent=2&limit=5", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), `"text":"","deleted":true,"replies":2`)
+	require.Contains(t, w.Body.String(), `"nextCursor":"next"`)
+}
+
+func TestCreateReview(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	in := &models.Review{EventId: "1", UserId: "5", Rating: 4, Text: "Хороший концерт"}
+	useCaseMock.On("CreateReview", in).Return(&models.Review{
+		ID:        "3",
+		EventId:   "1",
+		UserId:    "5",
+		Rating:    4,
+		Text:      "Хороший концерт",
+		CreatedAt: "2021-11-13T18:30:00Z",
+	}, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events/{id}/reviews", func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), "vars", mux.Vars(r))
+		ctx = context.WithValue(ctx, "userId", "5")
+		deliveryTest.CreateReview(w, r.WithContext(ctx))
+	}).Methods("POST")
+	req, err := http.NewRequest("POST", "/events/1/reviews", strings.NewReader(`{"rating":4,"text":" Хороший концерт "}`))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), `"rating":4`)
+
+	//Оценка вне 1..5 до usecase не доходит
+	req, err = http.NewRequest("POST", "/events/1/reviews", strings.NewReader(`{"rating":7}`))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	useCaseMock.AssertNumberOfCalls(t, "CreateReview", 1)
+}
+
 var getEventsTests = []struct {
 	id         int
 	vars       map[string]string
@@ -259,8 +477,10 @@ var getEventsTests = []struct {
 			"city":     "testCity",
 			"date":     "testDate",
 			"tags":     "testTags|testTags|testTags",
+			"from":     "2021-11-13T00:00:00Z",
+			"when":     "upcoming",
 		},
-		"?query=testQuery&category=testCategory&city=testCity&date=testDate&tags=testTags|testTags|testTags",
+		"?query=testQuery&category=testCategory&city=testCity&date=testDate&tags=testTags|testTags|testTags&from=2021-11-13T00:00:00Z&when=upcoming",
 		nil,
 		nil,
 	},
@@ -289,7 +509,16 @@ func TestGetEvents(t *testing.T) {
 		tag := test.vars["tags"]
 		tags := strings.Split(tag, "|")
 
-		useCaseMock.On("GetEvents", title, category, city, date, tags).Return(test.eventList, test.useCaseErr)
+		filter := &models.EventFilter{
+			Query:    title,
+			Category: category,
+			City:     city,
+			Date:     date,
+			Tags:     tags,
+			From:     test.vars["from"],
+			When:     test.vars["when"],
+		}
+		useCaseMock.On("GetEvents", filter, &models.Page{}).Return(test.eventList, "", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/events", deliveryTest.GetEvents).Methods("GET")
@@ -301,6 +530,77 @@ func TestGetEvents(t *testing.T) {
 	}
 }
 
+func TestGetEventsInvalidLimit(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events", deliveryTest.GetEvents).Methods("GET")
+	req, err := http.NewRequest("GET", "/events?limit=many", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), utils.ErrInvalidLimit.Error())
+	useCaseMock.AssertNotCalled(t, "GetEvents")
+}
+
+func TestGetEventsNear(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	filter := &models.EventFilter{
+		Tags:     []string{""},
+		Near:     &models.Location{Lat: 55.75, Lon: 37.62},
+		RadiusKm: 5,
+	}
+	useCaseMock.On("GetEvents", filter, &models.Page{}).Return([]*models.Event{}, "", nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events", deliveryTest.GetEvents).Methods("GET")
+	req, err := http.NewRequest("GET", "/events?lat=55.75&lon=37.62&radius_km=5", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	useCaseMock.AssertNumberOfCalls(t, "GetEvents", 1)
+
+	req, err = http.NewRequest("GET", "/events?lat=55.75&radius_km=5", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), error2.ErrInvalidGeoFilter.Error())
+	useCaseMock.AssertNumberOfCalls(t, "GetEvents", 1)
+}
+
+func TestGetMapEvents(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	box := &models.BoundingBox{MinLat: 55, MinLon: 37, MaxLat: 56, MaxLon: 38}
+	filter := &models.EventFilter{When: models.EventsUpcoming}
+	events := []*models.Event{
+		{ID: "1", Title: "test", Location: &models.Location{Lat: 55.76, Lon: 37.61}},
+		{ID: "2", Title: "test"},
+	}
+	useCaseMock.On("GetMapEvents", box, filter).Return(events, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/events/map", deliveryTest.GetMapEvents).Methods("GET")
+	req, err := http.NewRequest("GET", "/events/map?bbox=37,55,38,56&when=upcoming", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	require.Contains(t, w.Body.String(), `"type":"FeatureCollection"`)
+	require.Contains(t, w.Body.String(), `"coordinates":[37.61,55.76]`)
+	require.NotContains(t, w.Body.String(), `"id":"2"`)
+
+	for _, bbox := range []string{"", "37,55,38", "a,55,38,56"} {
+		req, err = http.NewRequest("GET", "/events/map?bbox="+bbox, nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Contains(t, w.Body.String(), error2.ErrInvalidGeoFilter.Error(), bbox)
+	}
+	useCaseMock.AssertNumberOfCalls(t, "GetMapEvents", 1)
+}
+
 var getEventsFromAuthorTests = []struct {
 	id         int
 	vars       map[string]string
@@ -332,7 +632,7 @@ func TestGetEventsFromAuthor(t *testing.T) {
 
 		authorId := test.vars["authorid"]
 
-		useCaseMock.On("GetCreatedEvents", authorId).Return(test.eventList, test.useCaseErr)
+		useCaseMock.On("GetCreatedEvents", authorId, &models.Page{}).Return(test.eventList, "", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("{id:[0-9]+}", deliveryTest.GetCreatedEvents).
@@ -372,14 +672,18 @@ func TestGetVisitedEvents(t *testing.T) {
 		useCaseMock := new(usecase.UseCaseMock)
 		deliveryTest := NewDelivery(useCaseMock)
 
-		useCaseMock.On("GetVisitedEvents", test.userId).Return([]*models.Event{}, test.useCaseErr)
+		page := &models.Page{Limit: 2, Cursor: "cursor"}
+		useCaseMock.On("GetVisitedEvents", test.userId, page).Return([]*models.Event{}, "next", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/{id:[0-9]+}", deliveryTest.GetVisitedEvents).Methods("GET")
-		req, err := http.NewRequest("GET", "/"+test.userId, nil)
+		req, err := http.NewRequest("GET", "/"+test.userId+"?limit=2&cursor=cursor", nil)
 		require.NoError(t, err, logTestMessage+"NewRequest error")
 		w := httptest.NewRecorder()
 		r.ServeHTTP(w, req)
+		if test.useCaseErr == nil {
+			require.Contains(t, w.Body.String(), `"nextCursor":"next"`)
+		}
 	}
 }
 
@@ -410,7 +714,7 @@ func TestGetCreatedEvents(t *testing.T) {
 		useCaseMock := new(usecase.UseCaseMock)
 		deliveryTest := NewDelivery(useCaseMock)
 
-		useCaseMock.On("GetCreatedEvents", test.userId).Return([]*models.Event{}, test.useCaseErr)
+		useCaseMock.On("GetCreatedEvents", test.userId, &models.Page{}).Return([]*models.Event{}, "", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/{id:[0-9]+}", deliveryTest.GetCreatedEvents).Methods("GET")
@@ -637,3 +941,40 @@ func TestGetCities(t *testing.T) {
 		r.ServeHTTP(w, req)
 	}
 }
+
+func TestForceDeleteEvent(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("ForceDeleteEvent", "100").Return(nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/admin/events/{id}", deliveryTest.ForceDeleteEvent).Methods("DELETE")
+	req, err := http.NewRequest("DELETE", "/admin/events/100", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+
+	w := httptest.NewRecorder()
+	varsContext := context.WithValue(context.Background(), "vars", map[string]string{"id": "100"})
+	r.ServeHTTP(w, req.WithContext(varsContext))
+	useCaseMock.AssertExpectations(t)
+}
+
+func TestHideEvent(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+
+	useCaseMock.On("SetEventHidden", "100", true).Return(nil)
+	useCaseMock.On("SetEventHidden", "100", false).Return(nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/admin/events/{id}/hidden", deliveryTest.HideEvent).Methods("POST")
+	r.HandleFunc("/admin/events/{id}/hidden", deliveryTest.UnhideEvent).Methods("DELETE")
+	varsContext := context.WithValue(context.Background(), "vars", map[string]string{"id": "100"})
+	for _, method := range []string{"POST", "DELETE"} {
+		req, err := http.NewRequest(method, "/admin/events/100/hidden", nil)
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req.WithContext(varsContext))
+	}
+	useCaseMock.AssertExpectations(t)
+}
@@ -9,4 +9,31 @@ var (
 	ErrAtoi          = errors.New("cant cast string to int")
 	ErrNotAllowed    = errors.New("user is not allowed to do this")
 	ErrNoRows        = errors.New("no rows in a query result")
+
+	ErrInvalidEventTime  = errors.New("invalid event start or end time")
+	ErrInvalidTimezone   = errors.New("unknown time zone")
+	ErrInvalidTimeFilter = errors.New("invalid time filter")
+
+	ErrInvalidLocation  = errors.New("invalid latitude or longitude")
+	ErrInvalidGeoFilter = errors.New("invalid geo filter")
+
+	ErrInvalidStatsRange = errors.New("invalid stats date range")
+
+	ErrInvalidCapacity = errors.New("invalid event capacity")
+	ErrNotRegistered   = errors.New("user is not registered for this event")
+
+	ErrInvalidRsvpStatus = errors.New("invalid rsvp status")
+	ErrEventFinished     = errors.New("event has already finished")
+
+	ErrInvalidTicket    = errors.New("ticket is invalid or cancelled")
+	ErrAlreadyCheckedIn = errors.New("ticket is already checked in")
+	ErrNoTicketSecret   = errors.New("ticket signing key is not configured")
+
+	ErrCommentNotFound   = errors.New("comment not found")
+	ErrEditWindowExpired = errors.New("comment can no longer be edited")
+
+	ErrInvalidRating    = errors.New("rating must be from 1 to 5")
+	ErrEventNotFinished = errors.New("event has not finished yet")
+	ErrNotAttended      = errors.New("user did not attend this event")
+	ErrAlreadyReviewed  = errors.New("user has already reviewed this event")
 )
@@ -10,13 +10,39 @@ type UseCase interface {
 	DeleteEvent(eventId string, userId string) error
 	//
 	GetEventById(eventId string) (*models.Event, error)
-	GetEvents(title string, category string, city string, date string, tags []string) ([]*models.Event, error)
-	GetCreatedEvents(authorId string) ([]*models.Event, error)
-	GetVisitedEvents(userId string) ([]*models.Event, error)
+	RecordView(eventId string, userId string, visitorId string) error
+	GetEventStats(eventId string, userId string, from string, to string) (*models.EventStats, error)
+	GetEvents(filter *models.EventFilter, page *models.Page) ([]*models.Event, string, error)
+	GetCreatedEvents(authorId string, page *models.Page) ([]*models.Event, string, error)
+	GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error)
+	GetMapEvents(box *models.BoundingBox, filter *models.EventFilter) ([]*models.Event, error)
 	//
 	Visit(eventId string, userId string) error
 	Unvisit(eventId string, userId string) error
 	IsVisited(eventId string, userId string) (bool, error)
 	//
+	Register(eventId string, userId string) (*models.Registration, error)
+	CancelRegistration(eventId string, userId string) (*models.Registration, error)
+	GetRegistration(eventId string, userId string) (*models.Registration, error)
+	//
+	SetRsvp(eventId string, userId string, status string) (*models.Rsvp, error)
+	DeleteRsvp(eventId string, userId string) (*models.Rsvp, error)
+	GetRsvp(eventId string, userId string) (*models.Rsvp, error)
+	//
+	GetTicket(eventId string, userId string) (*models.Ticket, error)
+	CheckIn(eventId string, organizerId string, code string) (*models.CheckIn, error)
+	GetAttendance(eventId string, organizerId string) (*models.Attendance, error)
+	//
+	CreateComment(c *models.Comment) (*models.Comment, error)
+	UpdateComment(commentId string, eventId string, userId string, text string) (*models.Comment, error)
+	DeleteComment(commentId string, eventId string, userId string) error
+	GetComments(eventId string, parentId string, page *models.Page) ([]*models.Comment, string, error)
+	//
+	CreateReview(r *models.Review) (*models.Review, error)
+	GetReviews(eventId string, page *models.Page) ([]*models.Review, string, error)
+	//
 	GetCities() ([]string, error)
+	//
+	ForceDeleteEvent(eventId string) error
+	SetEventHidden(eventId string, hidden bool) error
 }
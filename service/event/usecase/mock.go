@@ -29,18 +29,108 @@ func (m *UseCaseMock) GetEventById(eventId string) (*models.Event, error) {
 	return args.Get(0).(*models.Event), args.Error(1)
 }
 
-func (m *UseCaseMock) GetEvents(title string, category string, city string, date string, tags []string) ([]*models.Event, error) {
-	args := m.Called(title, category, city, date, tags)
-	return args.Get(0).([]*models.Event), args.Error(1)
+func (m *UseCaseMock) GetEvents(filter *models.EventFilter, page *models.Page) ([]*models.Event, string, error) {
+	args := m.Called(filter, page)
+	return args.Get(0).([]*models.Event), args.String(1), args.Error(2)
 }
 
-func (m *UseCaseMock) GetCreatedEvents(authorId string) ([]*models.Event, error) {
-	args := m.Called(authorId)
-	return args.Get(0).([]*models.Event), args.Error(1)
+func (m *UseCaseMock) GetCreatedEvents(authorId string, page *models.Page) ([]*models.Event, string, error) {
+	args := m.Called(authorId, page)
+	return args.Get(0).([]*models.Event), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error) {
+	args := m.Called(userId, page)
+	return args.Get(0).([]*models.Event), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) RecordView(eventId string, userId string, visitorId string) error {
+	args := m.Called(eventId, userId, visitorId)
+	return args.Error(0)
 }
 
-func (m *UseCaseMock) GetVisitedEvents(userId string) ([]*models.Event, error) {
-	args := m.Called(userId)
+func (m *UseCaseMock) GetEventStats(eventId string, userId string, from string, to string) (*models.EventStats, error) {
+	args := m.Called(eventId, userId, from, to)
+	return args.Get(0).(*models.EventStats), args.Error(1)
+}
+
+func (m *UseCaseMock) Register(eventId string, userId string) (*models.Registration, error) {
+	args := m.Called(eventId, userId)
+	return args.Get(0).(*models.Registration), args.Error(1)
+}
+
+func (m *UseCaseMock) CancelRegistration(eventId string, userId string) (*models.Registration, error) {
+	args := m.Called(eventId, userId)
+	return args.Get(0).(*models.Registration), args.Error(1)
+}
+
+func (m *UseCaseMock) GetRegistration(eventId string, userId string) (*models.Registration, error) {
+	args := m.Called(eventId, userId)
+	return args.Get(0).(*models.Registration), args.Error(1)
+}
+
+func (m *UseCaseMock) SetRsvp(eventId string, userId string, status string) (*models.Rsvp, error) {
+	args := m.Called(eventId, userId, status)
+	return args.Get(0).(*models.Rsvp), args.Error(1)
+}
+
+func (m *UseCaseMock) DeleteRsvp(eventId string, userId string) (*models.Rsvp, error) {
+	args := m.Called(eventId, userId)
+	return args.Get(0).(*models.Rsvp), args.Error(1)
+}
+
+func (m *UseCaseMock) GetRsvp(eventId string, userId string) (*models.Rsvp, error) {
+	args := m.Called(eventId, userId)
+	return args.Get(0).(*models.Rsvp), args.Error(1)
+}
+
+func (m *UseCaseMock) GetTicket(eventId string, userId string) (*models.Ticket, error) {
+	args := m.Called(eventId, userId)
+	return args.Get(0).(*models.Ticket), args.Error(1)
+}
+
+func (m *UseCaseMock) CheckIn(eventId string, organizerId string, code string) (*models.CheckIn, error) {
+	args := m.Called(eventId, organizerId, code)
+	return args.Get(0).(*models.CheckIn), args.Error(1)
+}
+
+func (m *UseCaseMock) GetAttendance(eventId string, organizerId string) (*models.Attendance, error) {
+	args := m.Called(eventId, organizerId)
+	return args.Get(0).(*models.Attendance), args.Error(1)
+}
+
+func (m *UseCaseMock) CreateComment(c *models.Comment) (*models.Comment, error) {
+	args := m.Called(c)
+	return args.Get(0).(*models.Comment), args.Error(1)
+}
+
+func (m *UseCaseMock) UpdateComment(commentId string, eventId string, userId string, text string) (*models.Comment, error) {
+	args := m.Called(commentId, eventId, userId, text)
+	return args.Get(0).(*models.Comment), args.Error(1)
+}
+
+func (m *UseCaseMock) DeleteComment(commentId string, eventId string, userId string) error {
+	args := m.Called(commentId, eventId, userId)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) GetComments(eventId string, parentId string, page *models.Page) ([]*models.Comment, string, error) {
+	args := m.Called(eventId, parentId, page)
+	return args.Get(0).([]*models.Comment), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) CreateReview(r *models.Review) (*models.Review, error) {
+	args := m.Called(r)
+	return args.Get(0).(*models.Review), args.Error(1)
+}
+
+func (m *UseCaseMock) GetReviews(eventId string, page *models.Page) ([]*models.Review, string, error) {
+	args := m.Called(eventId, page)
+	return args.Get(0).([]*models.Review), args.String(1), args.Error(2)
+}
+
+func (m *UseCaseMock) GetMapEvents(box *models.BoundingBox, filter *models.EventFilter) ([]*models.Event, error) {
+	args := m.Called(box, filter)
 	return args.Get(0).([]*models.Event), args.Error(1)
 }
 
@@ -63,3 +153,13 @@ func (m *UseCaseMock) GetCities() ([]string, error) {
 	args := m.Called()
 	return args.Get(0).([]string), args.Error(1)
 }
+
+func (m *UseCaseMock) ForceDeleteEvent(eventId string) error {
+	args := m.Called(eventId)
+	return args.Error(0)
+}
+
+func (m *UseCaseMock) SetEventHidden(eventId string, hidden bool) error {
+	args := m.Called(eventId, hidden)
+	return args.Error(0)
+}
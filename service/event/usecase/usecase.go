@@ -10,6 +10,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -37,14 +38,45 @@ func MakeProtoEvent(e *models.Event) *proto.Event {
 		ImgUrl:      e.ImgUrl,
 		Tag:         e.Tag,
 		Date:        e.Date,
+		StartsAt:    e.StartsAt,
+		EndsAt:      e.EndsAt,
+		Timezone:    e.Timezone,
 		Geo:         e.Geo,
+		Location:    makeProtoLocation(e.Location),
+		Capacity:    int32(e.Capacity),
 		Address:     e.Address,
 		AuthorId:    e.AuthorId,
 	}
 }
 
+func makeProtoLocation(l *models.Location) *proto.Location {
+	if l == nil {
+		return nil
+	}
+	return &proto.Location{Lat: l.Lat, Lon: l.Lon}
+}
+
+func MakeUserEventsRequest(userId string, page *models.Page) *proto.UserEventsRequest {
+	in := &proto.UserEventsRequest{
+		UserId: userId,
+	}
+	if page != nil {
+		in.Limit = int32(page.Limit)
+		in.Cursor = page.Cursor
+	}
+	return in
+}
+
+func MakeModelEvents(out *proto.Events) []*models.Event {
+	result := make([]*models.Event, len(out.Events))
+	for i, protoEvent := range out.Events {
+		result[i] = MakeModelEvent(protoEvent)
+	}
+	return result
+}
+
 func MakeModelEvent(out *proto.Event) *models.Event {
-	return &models.Event{
+	result := &models.Event{
 		ID:          out.ID,
 		Title:       out.Title,
 		Description: out.Description,
@@ -55,15 +87,26 @@ func MakeModelEvent(out *proto.Event) *models.Event {
 		ImgUrl:      out.ImgUrl,
 		Tag:         out.Tag,
 		Date:        out.Date,
+		StartsAt:    out.StartsAt,
+		EndsAt:      out.EndsAt,
+		Timezone:    out.Timezone,
 		Geo:         out.Geo,
+		Capacity:    int(out.Capacity),
 		Address:     out.Address,
 		AuthorId:    out.AuthorId,
+		Snippet:     out.Snippet,
+		RatingAvg:   out.RatingAvg,
+		RatingCount: int(out.RatingCount),
+	}
+	if out.Location != nil {
+		result.Location = &models.Location{Lat: out.Location.Lat, Lon: out.Location.Lon}
 	}
+	return result
 }
 
-func cityAndAddrByCoordinates(latitude, longitude string) (string, string, error) {
+func cityAndAddrByCoordinates(l *models.Location) (string, string, error) {
 	url := "https://suggestions.dadata.ru/suggestions/api/4_1/rs/geolocate/address"
-	url += "?lat=" + latitude + "&lon=" + longitude
+	url += "?lat=" + strconv.FormatFloat(l.Lat, 'f', -1, 64) + "&lon=" + strconv.FormatFloat(l.Lon, 'f', -1, 64)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return "", "", err
@@ -81,12 +124,12 @@ func cityAndAddrByCoordinates(latitude, longitude string) (string, string, error
 		return "", "", err
 	}
 	type Data struct {
-		City string `json:"city,omittempty`
+		City string `json:"city,omitempty"`
 	}
 
 	type AddrInfo struct {
-		Value              string `json:"value,omittempty`
-		Unrestricted_value string `json:"unresticted_value,omitempty`
+		Value              string `json:"value,omitempty"`
+		Unrestricted_value string `json:"unresticted_value,omitempty"`
 		Data               Data   `json:"data,omitempty"`
 	}
 
@@ -110,11 +153,42 @@ func cityAndAddrByCoordinates(latitude, longitude string) (string, string, error
 	return city, addr, nil
 }
 
-func parseCoordinates(coords string) (string, string) {
-	coordsArr := strings.Split(coords, " ")
-	lat := coordsArr[0][1 : len(coordsArr[0])-1]
-	lng := coordsArr[1][:len(coordsArr[1])-1]
-	return lat, lng
+//Старые клиенты передают координаты только в geo, строкой "(широта, долгота)"
+func parseCoordinates(coords string) (*models.Location, error) {
+	coords = strings.TrimSpace(coords)
+	if !strings.HasPrefix(coords, "(") || !strings.HasSuffix(coords, ")") {
+		return nil, error2.ErrInvalidLocation
+	}
+	coordsArr := strings.Split(coords[1:len(coords)-1], ",")
+	if len(coordsArr) != 2 {
+		return nil, error2.ErrInvalidLocation
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(coordsArr[0]), 64)
+	if err != nil {
+		return nil, error2.ErrInvalidLocation
+	}
+	lng, err := strconv.ParseFloat(strings.TrimSpace(coordsArr[1]), 64)
+	if err != nil {
+		return nil, error2.ErrInvalidLocation
+	}
+	return &models.Location{Lat: lat, Lon: lng}, nil
+}
+
+//Город и адрес определяются по координатам; в geo может быть и просто название места
+func fillEventPlace(e *models.Event) {
+	if e.Location == nil {
+		location, err := parseCoordinates(e.Geo)
+		if err != nil {
+			return
+		}
+		e.Location = location
+	}
+	city, address, err := cityAndAddrByCoordinates(e.Location)
+	if err != nil {
+		log.Error(logMessage+"fillEventPlace:err = ", err)
+	}
+	e.City = city
+	e.Address = address
 }
 
 func (a *UseCase) CreateEvent(e *models.Event) (string, error) {
@@ -124,16 +198,7 @@ func (a *UseCase) CreateEvent(e *models.Event) (string, error) {
 	for i, tag := range e.Tag {
 		e.Tag[i] = strings.ToLower(tag)
 	}
-	lat, lng := parseCoordinates(e.Geo)
-	log.Debug(lat, " ", lng)
-	var city string
-	var address string
-	city, address, err := cityAndAddrByCoordinates(lat, lng)
-	if err != nil {
-		log.Error(logMessage+"CreateEvent:err = ", err)
-	}
-	e.City = city
-	e.Address = address
+	fillEventPlace(e)
 
 	in := MakeProtoEvent(e)
 	res, err := a.eventRepo.CreateEvent(context.Background(), in)
@@ -150,20 +215,13 @@ func (a *UseCase) UpdateEvent(e *models.Event, userId string) error {
 	for i, tag := range e.Tag {
 		e.Tag[i] = strings.ToLower(tag)
 	}
-	lat, lng := parseCoordinates(e.Geo)
-	city, address, err := cityAndAddrByCoordinates(lat, lng)
-	if err != nil {
-		city = ""
-		address = ""
-	}
-	e.City = city
-	e.Address = address
+	fillEventPlace(e)
 
 	in := &proto.UpdateEventRequest{
 		Event:  MakeProtoEvent(e),
 		UserId: userId,
 	}
-	_, err = a.eventRepo.UpdateEvent(context.Background(), in)
+	_, err := a.eventRepo.UpdateEvent(context.Background(), in)
 	log.Debug(logMessage + "UpdateEvent:HERE")
 	return err
 }
@@ -193,62 +251,123 @@ func (a *UseCase) GetEventById(eventId string) (*models.Event, error) {
 	return result, nil
 }
 
-func (a *UseCase) GetEvents(title string, category string, city string, date string, tags []string) ([]*models.Event, error) {
+//Просмотр засчитывается пользователю, а без входа - анонимному посетителю
+func (a *UseCase) RecordView(eventId string, userId string, visitorId string) error {
+	if eventId == "" || (userId == "" && visitorId == "") {
+		return error2.ErrEmptyData
+	}
+	in := &proto.ViewRequest{
+		EventId:   eventId,
+		UserId:    userId,
+		VisitorId: visitorId,
+	}
+	_, err := a.eventRepo.RecordView(context.Background(), in)
+	return err
+}
+
+func (a *UseCase) GetEventStats(eventId string, userId string, from string, to string) (*models.EventStats, error) {
+	if eventId == "" || userId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.EventStatsRequest{
+		EventId: eventId,
+		UserId:  userId,
+		From:    from,
+		To:      to,
+	}
+	out, err := a.eventRepo.GetEventStats(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.EventStats{
+		EventId: eventId,
+		Days:    make([]*models.DayStats, len(out.Days)),
+	}
+	for i, day := range out.Days {
+		result.Days[i] = &models.DayStats{
+			Date:       day.Date,
+			Views:      day.Views,
+			Favourites: day.Favourites,
+		}
+	}
+	return result, nil
+}
+
+func (a *UseCase) GetEvents(filter *models.EventFilter, page *models.Page) ([]*models.Event, string, error) {
+	tags := filter.Tags
 	if tags != nil && tags[0] == "" {
 		tags = nil
 	}
+	if page == nil {
+		page = &models.Page{}
+	}
 	in := &proto.GetEventsRequest{
-		Title:    title,
-		Category: category,
-		City:     city,
-		Date:     date,
+		Title:    filter.Query,
+		Category: filter.Category,
+		City:     filter.City,
+		Date:     filter.Date,
 		Tags:     tags,
+		Limit:    int32(page.Limit),
+		Cursor:   page.Cursor,
+		From:     filter.From,
+		To:       filter.To,
+		When:     filter.When,
+		Near:     makeProtoLocation(filter.Near),
+		RadiusKm: filter.RadiusKm,
 	}
 	out, err := a.eventRepo.GetEvents(context.Background(), in)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	result := make([]*models.Event, len(out.Events))
-	for i, protoEvent := range out.Events {
-		result[i] = MakeModelEvent(protoEvent)
-	}
-	return result, nil
+	return MakeModelEvents(out), out.NextCursor, nil
 }
 
-func (a *UseCase) GetVisitedEvents(userId string) ([]*models.Event, error) {
-	if userId == "" {
+func (a *UseCase) GetMapEvents(box *models.BoundingBox, filter *models.EventFilter) ([]*models.Event, error) {
+	if box == nil {
 		return nil, error2.ErrEmptyData
 	}
-	in := &proto.UserId{
-		ID: userId,
-	}
-	out, err := a.eventRepo.GetVisitedEvents(context.Background(), in)
+	if filter == nil {
+		filter = &models.EventFilter{}
+	}
+	in := &proto.MapRequest{
+		Box: &proto.BoundingBox{
+			MinLat: box.MinLat,
+			MinLon: box.MinLon,
+			MaxLat: box.MaxLat,
+			MaxLon: box.MaxLon,
+		},
+		Category: filter.Category,
+		From:     filter.From,
+		To:       filter.To,
+		When:     filter.When,
+	}
+	out, err := a.eventRepo.GetMapEvents(context.Background(), in)
 	if err != nil {
 		return nil, err
 	}
-	result := make([]*models.Event, len(out.Events))
-	for i, protoEvent := range out.Events {
-		result[i] = MakeModelEvent(protoEvent)
-	}
-	return result, nil
+	return MakeModelEvents(out), nil
 }
 
-func (a *UseCase) GetCreatedEvents(userId string) ([]*models.Event, error) {
+func (a *UseCase) GetVisitedEvents(userId string, page *models.Page) ([]*models.Event, string, error) {
 	if userId == "" {
-		return nil, error2.ErrEmptyData
+		return nil, "", error2.ErrEmptyData
 	}
-	in := &proto.UserId{
-		ID: userId,
-	}
-	out, err := a.eventRepo.GetCreatedEvents(context.Background(), in)
+	out, err := a.eventRepo.GetVisitedEvents(context.Background(), MakeUserEventsRequest(userId, page))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	result := make([]*models.Event, len(out.Events))
-	for i, protoEvent := range out.Events {
-		result[i] = MakeModelEvent(protoEvent)
+	return MakeModelEvents(out), out.NextCursor, nil
+}
+
+func (a *UseCase) GetCreatedEvents(userId string, page *models.Page) ([]*models.Event, string, error) {
+	if userId == "" {
+		return nil, "", error2.ErrEmptyData
 	}
-	return result, nil
+	out, err := a.eventRepo.GetCreatedEvents(context.Background(), MakeUserEventsRequest(userId, page))
+	if err != nil {
+		return nil, "", err
+	}
+	return MakeModelEvents(out), out.NextCursor, nil
 }
 
 func (a *UseCase) Visit(eventId string, userId string) error {
@@ -288,8 +407,348 @@ func (a *UseCase) IsVisited(eventId string, userId string) (bool, error) {
 	return result, err
 }
 
+func makeModelRegistration(out *proto.Registration) *models.Registration {
+	result := &models.Registration{
+		Status:     out.Status,
+		Position:   int(out.Position),
+		Registered: int(out.Registered),
+		Capacity:   int(out.Capacity),
+		EventTitle: out.EventTitle,
+	}
+	for _, p := range out.Promoted {
+		result.Promoted = append(result.Promoted, &models.Promotion{UserId: p.UserId, Mail: p.Mail})
+	}
+	return result
+}
+
+func (a *UseCase) Register(eventId string, userId string) (*models.Registration, error) {
+	if eventId == "" || userId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.VisitRequest{
+		EventId: eventId,
+		UserId:  userId,
+	}
+	out, err := a.eventRepo.Register(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelRegistration(out), nil
+}
+
+func (a *UseCase) CancelRegistration(eventId string, userId string) (*models.Registration, error) {
+	if eventId == "" || userId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.VisitRequest{
+		EventId: eventId,
+		UserId:  userId,
+	}
+	out, err := a.eventRepo.CancelRegistration(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelRegistration(out), nil
+}
+
+func (a *UseCase) GetRegistration(eventId string, userId string) (*models.Registration, error) {
+	if eventId == "" || userId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.VisitRequest{
+		EventId: eventId,
+		UserId:  userId,
+	}
+	out, err := a.eventRepo.GetRegistration(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelRegistration(out), nil
+}
+
+func makeModelRsvp(out *proto.Rsvp) *models.Rsvp {
+	return &models.Rsvp{
+		Status:     out.Status,
+		Going:      int(out.Going),
+		Interested: int(out.Interested),
+	}
+}
+
+func (a *UseCase) SetRsvp(eventId string, userId string, status string) (*models.Rsvp, error) {
+	if eventId == "" || userId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	if !models.IsValidRsvpStatus(status) {
+		return nil, error2.ErrInvalidRsvpStatus
+	}
+	in := &proto.RsvpRequest{
+		EventId: eventId,
+		UserId:  userId,
+		Status:  status,
+	}
+	out, err := a.eventRepo.SetRsvp(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelRsvp(out), nil
+}
+
+func (a *UseCase) DeleteRsvp(eventId string, userId string) (*models.Rsvp, error) {
+	if eventId == "" || userId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.VisitRequest{
+		EventId: eventId,
+		UserId:  userId,
+	}
+	out, err := a.eventRepo.DeleteRsvp(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelRsvp(out), nil
+}
+
+func (a *UseCase) GetRsvp(eventId string, userId string) (*models.Rsvp, error) {
+	if eventId == "" || userId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.VisitRequest{
+		EventId: eventId,
+		UserId:  userId,
+	}
+	out, err := a.eventRepo.GetRsvp(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelRsvp(out), nil
+}
+
+func (a *UseCase) GetTicket(eventId string, userId string) (*models.Ticket, error) {
+	if eventId == "" || userId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.VisitRequest{
+		EventId: eventId,
+		UserId:  userId,
+	}
+	out, err := a.eventRepo.GetTicket(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Ticket{
+		Code:        out.Code,
+		EventId:     out.EventId,
+		UserId:      out.UserId,
+		EventTitle:  out.EventTitle,
+		CheckedInAt: out.CheckedInAt,
+	}, nil
+}
+
+func makeModelAttendance(out *proto.Attendance) models.Attendance {
+	return models.Attendance{
+		Attended:   int(out.GetAttended()),
+		Registered: int(out.GetRegistered()),
+	}
+}
+
+func (a *UseCase) CheckIn(eventId string, organizerId string, code string) (*models.CheckIn, error) {
+	if eventId == "" || organizerId == "" || code == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.CheckInRequest{
+		EventId: eventId,
+		UserId:  organizerId,
+		Code:    code,
+	}
+	out, err := a.eventRepo.CheckIn(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CheckIn{
+		UserId:      out.UserId,
+		CheckedInAt: out.CheckedInAt,
+		Attendance:  makeModelAttendance(out.Attendance),
+	}, nil
+}
+
+func (a *UseCase) GetAttendance(eventId string, organizerId string) (*models.Attendance, error) {
+	if eventId == "" || organizerId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.VisitRequest{
+		EventId: eventId,
+		UserId:  organizerId,
+	}
+	out, err := a.eventRepo.GetAttendance(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	attendance := makeModelAttendance(out)
+	return &attendance, nil
+}
+
+func makeModelComment(out *proto.Comment) *models.Comment {
+	return &models.Comment{
+		ID:        out.Id,
+		EventId:   out.EventId,
+		UserId:    out.UserId,
+		ParentId:  out.ParentId,
+		Text:      out.Text,
+		CreatedAt: out.CreatedAt,
+		EditedAt:  out.EditedAt,
+		Deleted:   out.Deleted,
+		Replies:   int(out.Replies),
+	}
+}
+
+func (a *UseCase) CreateComment(c *models.Comment) (*models.Comment, error) {
+	if c.EventId == "" || c.UserId == "" || c.Text == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.Comment{
+		EventId:  c.EventId,
+		UserId:   c.UserId,
+		ParentId: c.ParentId,
+		Text:     c.Text,
+	}
+	out, err := a.eventRepo.CreateComment(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelComment(out), nil
+}
+
+func (a *UseCase) UpdateComment(commentId string, eventId string, userId string, text string) (*models.Comment, error) {
+	if commentId == "" || eventId == "" || userId == "" || text == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.CommentRequest{
+		CommentId: commentId,
+		EventId:   eventId,
+		UserId:    userId,
+		Text:      text,
+	}
+	out, err := a.eventRepo.UpdateComment(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelComment(out), nil
+}
+
+func (a *UseCase) DeleteComment(commentId string, eventId string, userId string) error {
+	if commentId == "" || eventId == "" || userId == "" {
+		return error2.ErrEmptyData
+	}
+	in := &proto.CommentRequest{
+		CommentId: commentId,
+		EventId:   eventId,
+		UserId:    userId,
+	}
+	_, err := a.eventRepo.DeleteComment(context.Background(), in)
+	return err
+}
+
+func (a *UseCase) GetComments(eventId string, parentId string, page *models.Page) ([]*models.Comment, string, error) {
+	if eventId == "" {
+		return nil, "", error2.ErrEmptyData
+	}
+	in := &proto.CommentsRequest{
+		EventId:  eventId,
+		ParentId: parentId,
+	}
+	if page != nil {
+		in.Limit = int32(page.Limit)
+		in.Cursor = page.Cursor
+	}
+	out, err := a.eventRepo.GetComments(context.Background(), in)
+	if err != nil {
+		return nil, "", err
+	}
+	result := make([]*models.Comment, len(out.Comments))
+	for i, c := range out.Comments {
+		result[i] = makeModelComment(c)
+	}
+	return result, out.NextCursor, nil
+}
+
+func makeModelReview(out *proto.Review) *models.Review {
+	return &models.Review{
+		ID:        out.Id,
+		EventId:   out.EventId,
+		UserId:    out.UserId,
+		Rating:    int(out.Rating),
+		Text:      out.Text,
+		CreatedAt: out.CreatedAt,
+	}
+}
+
+func (a *UseCase) CreateReview(r *models.Review) (*models.Review, error) {
+	if r.EventId == "" || r.UserId == "" {
+		return nil, error2.ErrEmptyData
+	}
+	if r.Rating < 1 || r.Rating > 5 {
+		return nil, error2.ErrInvalidRating
+	}
+	in := &proto.Review{
+		EventId: r.EventId,
+		UserId:  r.UserId,
+		Rating:  int32(r.Rating),
+		Text:    r.Text,
+	}
+	out, err := a.eventRepo.CreateReview(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelReview(out), nil
+}
+
+func (a *UseCase) GetReviews(eventId string, page *models.Page) ([]*models.Review, string, error) {
+	if eventId == "" {
+		return nil, "", error2.ErrEmptyData
+	}
+	in := &proto.ReviewsRequest{
+		EventId: eventId,
+	}
+	if page != nil {
+		in.Limit = int32(page.Limit)
+		in.Cursor = page.Cursor
+	}
+	out, err := a.eventRepo.GetReviews(context.Background(), in)
+	if err != nil {
+		return nil, "", err
+	}
+	result := make([]*models.Review, len(out.Reviews))
+	for i, r := range out.Reviews {
+		result[i] = makeModelReview(r)
+	}
+	return result, out.NextCursor, nil
+}
+
 func (a *UseCase) GetCities() ([]string, error) {
 	out, err := a.eventRepo.GetCities(context.Background(), &proto.Empty{})
 	result := out.Cities
 	return result, err
 }
+
+func (a *UseCase) ForceDeleteEvent(eventId string) error {
+	if eventId == "" {
+		return error2.ErrEmptyData
+	}
+	in := &proto.EventId{
+		ID: eventId,
+	}
+	_, err := a.eventRepo.ForceDeleteEvent(context.Background(), in)
+	return err
+}
+
+func (a *UseCase) SetEventHidden(eventId string, hidden bool) error {
+	if eventId == "" {
+		return error2.ErrEmptyData
+	}
+	in := &proto.SetEventHiddenRequest{
+		EventId: eventId,
+		Hidden:  hidden,
+	}
+	_, err := a.eventRepo.SetEventHidden(context.Background(), in)
+	return err
+}
@@ -24,6 +24,7 @@ var createEventTests = []struct {
 		&models.Event{
 			AuthorId: "test",
 			Geo:      "(1.23232323, 4.3223232323)",
+			Location: &models.Location{Lat: 1.23232323, Lon: 4.3223232323},
 			Tag:      []string{"test"},
 		},
 		nil,
@@ -38,6 +39,7 @@ var createEventTests = []struct {
 		&models.Event{
 			AuthorId: "test",
 			Geo:      "(1.23232323, 4.3223232323)",
+			Location: &models.Location{Lat: 1.23232323, Lon: 4.3223232323},
 		},
 		errors.New("test_err"),
 		"",
@@ -70,6 +72,7 @@ var updateEventTests = []struct {
 			ID:       "test",
 			AuthorId: "test",
 			Geo:      "(1.23232323, 4.3223232323)",
+			Location: &models.Location{Lat: 1.23232323, Lon: 4.3223232323},
 			Tag:      []string{"test"},
 		},
 		"test",
@@ -85,6 +88,7 @@ var updateEventTests = []struct {
 			ID:       "test",
 			AuthorId: "test",
 			Geo:      "(1.23232323, 4.3223232323)",
+			Location: &models.Location{Lat: 1.23232323, Lon: 4.3223232323},
 		},
 		"test",
 		errors.New("test_err"),
@@ -223,11 +227,32 @@ func TestGetEvents(t *testing.T) {
 			City:     test.city,
 			Date:     test.date,
 			Tags:     test.tags,
+			Limit:    5,
+			Cursor:   "cursor",
+			From:     "2021-11-13T00:00:00Z",
+			When:     models.EventsUpcoming,
+			Near:     &eventGrpc.Location{Lat: 55.75, Lon: 37.62},
+			RadiusKm: 5,
 		}
-		repositoryMock.On("GetEvents", context.Background(), in).Return(&eventGrpc.Events{}, test.outputErr)
-		actualRes, actualErr := useCaseTest.GetEvents(test.title, test.category, test.city, test.date, test.tags)
+		repositoryMock.On("GetEvents", context.Background(), in).Return(&eventGrpc.Events{NextCursor: "next"}, test.outputErr)
+		filter := &models.EventFilter{
+			Query:    test.title,
+			Category: test.category,
+			City:     test.city,
+			Date:     test.date,
+			Tags:     test.tags,
+			From:     "2021-11-13T00:00:00Z",
+			When:     models.EventsUpcoming,
+			Near:     &models.Location{Lat: 55.75, Lon: 37.62},
+			RadiusKm: 5,
+		}
+		page := &models.Page{Limit: 5, Cursor: "cursor"}
+		actualRes, nextCursor, actualErr := useCaseTest.GetEvents(filter, page)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes)
+		if actualErr == nil {
+			require.Equal(t, "next", nextCursor)
+		}
 	}
 }
 
@@ -260,15 +285,15 @@ func TestGetVisitedEvents(t *testing.T) {
 	for _, test := range getVisitedEventsTests {
 		repositoryMock := new(repository.RepositoryClientMock)
 		useCaseTest := NewUseCase(repositoryMock)
-		in := &eventGrpc.UserId{
-			ID: test.userId,
+		in := &eventGrpc.UserEventsRequest{
+			UserId: test.userId,
 		}
 		repositoryMock.On("GetVisitedEvents", context.Background(), in).Return(&eventGrpc.Events{
 			Events: []*eventGrpc.Event{
 				&eventGrpc.Event{},
 			},
 		}, test.outputErr)
-		actualRes, actualErr := useCaseTest.GetVisitedEvents(test.userId)
+		actualRes, _, actualErr := useCaseTest.GetVisitedEvents(test.userId, nil)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes)
 	}
@@ -303,15 +328,15 @@ func TestGetCreatedEvents(t *testing.T) {
 	for _, test := range getCreatedEventsTests {
 		repositoryMock := new(repository.RepositoryClientMock)
 		useCaseTest := NewUseCase(repositoryMock)
-		in := &eventGrpc.UserId{
-			ID: test.userId,
+		in := &eventGrpc.UserEventsRequest{
+			UserId: test.userId,
 		}
 		repositoryMock.On("GetCreatedEvents", context.Background(), in).Return(&eventGrpc.Events{
 			Events: []*eventGrpc.Event{
 				&eventGrpc.Event{},
 			},
 		}, test.outputErr)
-		actualRes, actualErr := useCaseTest.GetCreatedEvents(test.userId)
+		actualRes, _, actualErr := useCaseTest.GetCreatedEvents(test.userId, nil)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes)
 	}
@@ -463,3 +488,200 @@ func TestGetCities(t *testing.T) {
 		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 	}
 }
+
+func TestForceDeleteEvent(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.EventId{
+		ID: "1",
+	}
+	repositoryMock.On("ForceDeleteEvent", context.Background(), in).Return(&eventGrpc.Empty{}, nil)
+	err := useCaseTest.ForceDeleteEvent("1")
+	require.NoError(t, err)
+
+	err = useCaseTest.ForceDeleteEvent("")
+	require.Equal(t, error2.ErrEmptyData, err)
+	repositoryMock.AssertNumberOfCalls(t, "ForceDeleteEvent", 1)
+}
+
+func TestSetEventHidden(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.SetEventHiddenRequest{
+		EventId: "1",
+		Hidden:  true,
+	}
+	repositoryMock.On("SetEventHidden", context.Background(), in).Return(&eventGrpc.Empty{}, errors.New("test_err"))
+	err := useCaseTest.SetEventHidden("1", true)
+	require.Equal(t, errors.New("test_err"), err)
+}
+
+func TestGetMapEvents(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.MapRequest{
+		Box:      &eventGrpc.BoundingBox{MinLat: 55, MinLon: 37, MaxLat: 56, MaxLon: 38},
+		Category: "music",
+		When:     models.EventsUpcoming,
+	}
+	repositoryMock.On("GetMapEvents", context.Background(), in).Return(&eventGrpc.Events{
+		Events: []*eventGrpc.Event{{ID: "1", Location: &eventGrpc.Location{Lat: 55.76, Lon: 37.61}}},
+	}, nil)
+	box := &models.BoundingBox{MinLat: 55, MinLon: 37, MaxLat: 56, MaxLon: 38}
+	events, err := useCaseTest.GetMapEvents(box, &models.EventFilter{Category: "music", When: models.EventsUpcoming})
+	require.NoError(t, err)
+	require.Equal(t, []*models.Event{{ID: "1", Location: &models.Location{Lat: 55.76, Lon: 37.61}}}, events)
+
+	_, err = useCaseTest.GetMapEvents(nil, nil)
+	require.Equal(t, error2.ErrEmptyData, err)
+	repositoryMock.AssertNumberOfCalls(t, "GetMapEvents", 1)
+}
+
+func TestParseCoordinates(t *testing.T) {
+	location, err := parseCoordinates("(55.7558, 37.6173)")
+	require.NoError(t, err)
+	require.Equal(t, &models.Location{Lat: 55.7558, Lon: 37.6173}, location)
+
+	for _, geo := range []string{"", "Москва", "(55.7558)", "(a, b)", "55.7558, 37.6173"} {
+		_, err = parseCoordinates(geo)
+		require.Equal(t, error2.ErrInvalidLocation, err, geo)
+	}
+}
+
+func TestRecordView(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.ViewRequest{EventId: "1", VisitorId: "visitor"}
+	repositoryMock.On("RecordView", context.Background(), in).Return(&eventGrpc.Empty{}, nil)
+	err := useCaseTest.RecordView("1", "", "visitor")
+	require.NoError(t, err)
+
+	err = useCaseTest.RecordView("1", "", "")
+	require.Equal(t, error2.ErrEmptyData, err)
+	repositoryMock.AssertNumberOfCalls(t, "RecordView", 1)
+}
+
+func TestGetEventStats(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.EventStatsRequest{EventId: "1", UserId: "5", From: "2021-11-13", To: "2021-11-14"}
+	repositoryMock.On("GetEventStats", context.Background(), in).Return(&eventGrpc.EventStats{
+		Days: []*eventGrpc.DayStats{{Date: "2021-11-13", Views: 10, Favourites: 2}},
+	}, nil)
+	stats, err := useCaseTest.GetEventStats("1", "5", "2021-11-13", "2021-11-14")
+	require.NoError(t, err)
+	require.Equal(t, &models.EventStats{
+		EventId: "1",
+		Days:    []*models.DayStats{{Date: "2021-11-13", Views: 10, Favourites: 2}},
+	}, stats)
+
+	_, err = useCaseTest.GetEventStats("1", "", "", "")
+	require.Equal(t, error2.ErrEmptyData, err)
+	repositoryMock.AssertNumberOfCalls(t, "GetEventStats", 1)
+}
+
+func TestRegister(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.VisitRequest{EventId: "1", UserId: "5"}
+	repositoryMock.On("Register", context.Background(), in).Return(&eventGrpc.Registration{
+		Status:     models.RegistrationWaitlisted,
+		Position:   1,
+		Registered: 2,
+		Capacity:   2,
+		EventTitle: "Концерт",
+		Promoted:   []*eventGrpc.Promotion{{UserId: "8", Mail: "next@mail.ru"}},
+	}, nil)
+	registration, err := useCaseTest.Register("1", "5")
+	require.NoError(t, err)
+	require.Equal(t, &models.Registration{
+		Status:     models.RegistrationWaitlisted,
+		Position:   1,
+		Registered: 2,
+		Capacity:   2,
+		EventTitle: "Концерт",
+		Promoted:   []*models.Promotion{{UserId: "8", Mail: "next@mail.ru"}},
+	}, registration)
+
+	_, err = useCaseTest.Register("1", "")
+	require.Equal(t, error2.ErrEmptyData, err)
+	repositoryMock.AssertNumberOfCalls(t, "Register", 1)
+}
+
+func TestSetRsvp(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.RsvpRequest{EventId: "1", UserId: "5", Status: models.RsvpGoing}
+	repositoryMock.On("SetRsvp", context.Background(), in).Return(&eventGrpc.Rsvp{
+		Status:     models.RsvpGoing,
+		Going:      3,
+		Interested: 7,
+	}, nil)
+	rsvp, err := useCaseTest.SetRsvp("1", "5", models.RsvpGoing)
+	require.NoError(t, err)
+	require.Equal(t, &models.Rsvp{Status: models.RsvpGoing, Going: 3, Interested: 7}, rsvp)
+
+	_, err = useCaseTest.SetRsvp("1", "5", "maybe")
+	require.Equal(t, error2.ErrInvalidRsvpStatus, err)
+	_, err = useCaseTest.SetRsvp("", "5", models.RsvpGoing)
+	require.Equal(t, error2.ErrEmptyData, err)
+	repositoryMock.AssertNumberOfCalls(t, "SetRsvp", 1)
+}
+
+func TestCheckIn(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.CheckInRequest{EventId: "1", UserId: "9", Code: "code"}
+	repositoryMock.On("CheckIn", context.Background(), in).Return(&eventGrpc.CheckInResult{
+		UserId:      "5",
+		CheckedInAt: "2021-11-13T18:30:00Z",
+		Attendance:  &eventGrpc.Attendance{Attended: 1, Registered: 2},
+	}, nil)
+	checkIn, err := useCaseTest.CheckIn("1", "9", "code")
+	require.NoError(t, err)
+	require.Equal(t, &models.CheckIn{
+		UserId:      "5",
+		CheckedInAt: "2021-11-13T18:30:00Z",
+		Attendance:  models.Attendance{Attended: 1, Registered: 2},
+	}, checkIn)
+
+	_, err = useCaseTest.CheckIn("1", "9", "")
+	require.Equal(t, error2.ErrEmptyData, err)
+	repositoryMock.AssertNumberOfCalls(t, "CheckIn", 1)
+}
+
+func TestGetComments(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.CommentsRequest{EventId: "1", ParentId: "2", Limit: 5, Cursor: "cursor"}
+	repositoryMock.On("GetComments", context.Background(), in).Return(&eventGrpc.Comments{
+		Comments:   []*eventGrpc.Comment{{Id: "3", EventId: "1", UserId: "5", ParentId: "2", Text: "Пойду", Replies: 1}},
+		NextCursor: "next",
+	}, nil)
+	comments, nextCursor, err := useCaseTest.GetComments("1", "2", &models.Page{Limit: 5, Cursor: "cursor"})
+	require.NoError(t, err)
+	require.Equal(t, []*models.Comment{{ID: "3", EventId: "1", UserId: "5", ParentId: "2", Text: "Пойду", Replies: 1}}, comments)
+	require.Equal(t, "next", nextCursor)
+
+	_, _, err = useCaseTest.GetComments("", "", nil)
+	require.Equal(t, error2.ErrEmptyData, err)
+	repositoryMock.AssertNumberOfCalls(t, "GetComments", 1)
+}
+
+func TestCreateReview(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock)
+	in := &eventGrpc.Review{EventId: "1", UserId: "5", Rating: 4, Text: "Хороший концерт"}
+	repositoryMock.On("CreateReview", context.Background(), in).
+		Return(&eventGrpc.Review{Id: "3", EventId: "1", UserId: "5", Rating: 4, Text: "Хороший концерт"}, nil)
+	review, err := useCaseTest.CreateReview(&models.Review{EventId: "1", UserId: "5", Rating: 4, Text: "Хороший концерт"})
+	require.NoError(t, err)
+	require.Equal(t, &models.Review{ID: "3", EventId: "1", UserId: "5", Rating: 4, Text: "Хороший концерт"}, review)
+
+	//Оценка не передана
+	_, err = useCaseTest.CreateReview(&models.Review{EventId: "1", UserId: "5"})
+	require.Equal(t, error2.ErrInvalidRating, err)
+	_, err = useCaseTest.CreateReview(&models.Review{EventId: "1", Rating: 4})
+	require.Equal(t, error2.ErrEmptyData, err)
+	repositoryMock.AssertNumberOfCalls(t, "CreateReview", 1)
+}
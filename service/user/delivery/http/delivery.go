@@ -1,18 +1,29 @@
 package http
 
 import (
+	"archive/zip"
 	log "backend/pkg/logger"
+	"backend/pkg/models"
 	"backend/pkg/response"
 	"backend/pkg/utils"
 	"backend/service/email"
 	"backend/service/user"
+	error2 "backend/service/user/error"
+	"encoding/json"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
 )
 
-const logMessage = "service:user:delivery:http:"
+const (
+	logMessage     = "service:user:delivery:http:"
+	exportFileName = "bmstusa-export.zip"
+)
 
 type Delivery struct {
 	useCase user.UseCase
@@ -91,7 +102,7 @@ func (h *Delivery) UpdateUserPassword(w http.ResponseWriter, r *http.Request) {
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	err = h.useCase.UpdateUserPassword(userId, u.Password)
+	err = h.useCase.UpdateUserPassword(userId, u.Password, utils.GetClientIP(r), r.UserAgent())
 	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
 		return
 	}
@@ -105,11 +116,15 @@ func (h *Delivery) GetSubscribers(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "started")
 	vars := mux.Vars(r)
 	userId := vars["id"]
-	subscribers, err := h.useCase.GetSubscribers(userId)
+	page, err := utils.GetPage(r)
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	response.SendResponse(w, response.UserListResponse(subscribers))
+	subscribers, nextCursor, err := h.useCase.GetSubscribers(userId, page)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.UserListResponse(subscribers, nextCursor))
 	log.Debug(message + "ended")
 }
 
@@ -118,23 +133,32 @@ func (h *Delivery) GetSubscribes(w http.ResponseWriter, r *http.Request) {
 	log.Debug(message + "started")
 	vars := mux.Vars(r)
 	userId := vars["id"]
-	subscribers, err := h.useCase.GetSubscribes(userId)
+	page, err := utils.GetPage(r)
 	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	response.SendResponse(w, response.UserListResponse(subscribers))
+	subscribers, nextCursor, err := h.useCase.GetSubscribes(userId, page)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.UserListResponse(subscribers, nextCursor))
 	log.Debug(message + "ended")
 }
 
-func (h *Delivery) GetVisitors(w http.ResponseWriter, r *http.Request) {
-	message := logMessage + "GetVisitors:"
+func (h *Delivery) GetAttendees(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "GetAttendees:"
 	log.Debug(message + "started")
-	eventId := r.Context().Value("eventId").(string)
-	userList, err := h.useCase.GetVisitors(eventId)
-	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
+	vars := r.Context().Value("vars").(map[string]string)
+	page, err := utils.GetPage(r)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
 		return
 	}
-	response.SendResponse(w, response.UserListResponse(userList))
+	status := r.URL.Query().Get("status")
+	userList, nextCursor, err := h.useCase.GetAttendees(vars["id"], status, page)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.UserListResponse(userList, nextCursor))
 	log.Debug(message + "ended")
 }
 
@@ -179,3 +203,130 @@ func (h *Delivery) IsSubscribed(w http.ResponseWriter, r *http.Request) {
 	response.SendResponse(w, response.SubscribedResponse(res))
 	log.Debug(message + "ended")
 }
+
+//По умолчанию выгрузка отдаётся JSON-ответом, с ?format=zip - архивом,
+//в котором кроме data.json лежат сами картинки
+func (h *Delivery) ExportUserData(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ExportUserData:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "zip" {
+		utils.CheckIfNoError(&w, error2.ErrExportFormat, message, http.StatusBadRequest)
+		return
+	}
+	export, err := h.useCase.ExportUserData(userId)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusInternalServerError) {
+		return
+	}
+	if format != "zip" {
+		response.SendResponse(w, response.UserExportResponse(export))
+		log.Debug(message + "ended")
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+exportFileName+`"`)
+	err = writeExportArchive(w, response.MakeUserExportResponseBody(export))
+	if err != nil {
+		//Заголовки уже отправлены - остаётся только оборвать архив
+		log.Error(message+"err =", err)
+		return
+	}
+	log.Debug(message + "ended")
+}
+
+func writeExportArchive(w io.Writer, body models.UserExportResponseBody) error {
+	archive := zip.NewWriter(w)
+	data, err := archive.Create("data.json")
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(data)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(body)
+	if err != nil {
+		return err
+	}
+	for _, imgUrl := range body.Images {
+		err = addExportImage(archive, imgUrl)
+		if err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+//Картинки, которых нет на диске (или не с нашего сервера), пропускаются - их адреса есть в data.json
+func addExportImage(archive *zip.Writer, imgUrl string) error {
+	path, ok := utils.ImagePath(imgUrl)
+	if !ok {
+		return nil
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	dst, err := archive.Create("images/" + filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, file)
+	return err
+}
+
+//Почта меняется только после перехода по ссылке из письма на новый адрес.
+//На старый уходит уведомление со ссылкой отмены
+func (h *Delivery) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "RequestEmailChange:"
+	log.Debug(message + "started")
+	userId := r.Context().Value("userId").(string)
+	in, err := response.GetEmailChangeFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	change, err := h.useCase.RequestEmailChange(userId, in.Mail)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	mainHost := viper.GetString("main_host")
+	confirmLink := mainHost + "/email/confirm?token=" + change.Token
+	email.SendEmail("Смена почты", "Чтобы сделать этот адрес почтой аккаунта BMSTUSA, перейдите по ссылке: "+confirmLink, []string{change.NewMail})
+	cancelLink := mainHost + "/email/cancel?token=" + change.CancelToken
+	email.SendEmail("Смена почты", "Запрошена смена почты вашего аккаунта на "+change.NewMail+". Если это были не вы, отмените смену по ссылке: "+cancelLink, []string{change.OldMail})
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "ConfirmEmailChange:"
+	log.Debug(message + "started")
+	in, err := response.GetEmailChangeFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	_, err = h.useCase.ConfirmEmailChange(in.Token, utils.GetClientIP(r), r.UserAgent())
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
+
+func (h *Delivery) CancelEmailChange(w http.ResponseWriter, r *http.Request) {
+	message := logMessage + "CancelEmailChange:"
+	log.Debug(message + "started")
+	in, err := response.GetEmailChangeFromRequest(r.Body)
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	_, err = h.useCase.CancelEmailChange(in.Token, utils.GetClientIP(r), r.UserAgent())
+	if !utils.CheckIfNoError(&w, err, message, http.StatusBadRequest) {
+		return
+	}
+	response.SendResponse(w, response.OkResponse())
+	log.Debug(message + "ended")
+}
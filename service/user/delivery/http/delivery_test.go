@@ -1,8 +1,10 @@
 package http
 
 import (
+	"archive/zip"
 	"backend/pkg/models"
 	"backend/pkg/response"
+	"backend/pkg/utils"
 	error2 "backend/service/user/error"
 	"backend/service/user/usecase"
 	"bytes"
@@ -13,6 +15,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -207,7 +212,7 @@ func TestUpdateUserPassword(t *testing.T) {
 
 		useCaseMock.On("UpdateUserPassword",
 			userId,
-			userModel.Password).Return(test.useCaseErr)
+			userModel.Password, "", "").Return(test.useCaseErr)
 
 		bodyUserJSON, err := json.Marshal(test.user)
 		require.NoError(t, err, logTestMessage+"err =", err)
@@ -249,7 +254,7 @@ func TestGetSubscribers(t *testing.T) {
 		useCaseMock := new(usecase.UseCaseMock)
 		deliveryTest := NewDelivery(useCaseMock)
 
-		useCaseMock.On("GetSubscribers", test.userId).Return([]*models.User{}, test.useCaseErr)
+		useCaseMock.On("GetSubscribers", test.userId, &models.Page{}).Return([]*models.User{}, "", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/{id:[0-9]+}", deliveryTest.GetSubscribers).Methods("GET")
@@ -282,18 +287,22 @@ func TestGetSubscribes(t *testing.T) {
 		useCaseMock := new(usecase.UseCaseMock)
 		deliveryTest := NewDelivery(useCaseMock)
 
-		useCaseMock.On("GetSubscribes", test.userId).Return([]*models.User{}, test.useCaseErr)
+		page := &models.Page{Limit: 10, Cursor: "cursor"}
+		useCaseMock.On("GetSubscribes", test.userId, page).Return([]*models.User{}, "next", test.useCaseErr)
 
 		r := mux.NewRouter()
 		r.HandleFunc("/{id:[0-9]+}", deliveryTest.GetSubscribes).Methods("GET")
-		req, err := http.NewRequest("GET", "/"+test.userId, nil)
+		req, err := http.NewRequest("GET", "/"+test.userId+"?limit=10&cursor=cursor", nil)
 		require.NoError(t, err, logTestMessage+"NewRequest error")
 		w := httptest.NewRecorder()
 		r.ServeHTTP(w, req)
+		if test.useCaseErr == nil {
+			require.Contains(t, w.Body.String(), `"nextCursor":"next"`)
+		}
 	}
 }
 
-var getVisitorsTests = []struct {
+var getAttendeesTests = []struct {
 	id         int
 	eventId    string
 	useCaseErr error
@@ -310,20 +319,21 @@ var getVisitorsTests = []struct {
 	},
 }
 
-func TestGetVisitors(t *testing.T) {
-	for _, test := range getVisitorsTests {
+func TestGetAttendees(t *testing.T) {
+	for _, test := range getAttendeesTests {
 		useCaseMock := new(usecase.UseCaseMock)
 		deliveryTest := NewDelivery(useCaseMock)
 
-		useCaseMock.On("GetVisitors", test.eventId).Return([]*models.User{}, test.useCaseErr)
+		useCaseMock.On("GetAttendees", test.eventId, models.RsvpInterested, &models.Page{}).Return([]*models.User{}, "", test.useCaseErr)
 
 		r := mux.NewRouter()
-		r.HandleFunc("/test", deliveryTest.GetVisitors).Methods("GET")
-		req, err := http.NewRequest("GET", "/test", nil)
+		r.HandleFunc("/test", deliveryTest.GetAttendees).Methods("GET")
+		req, err := http.NewRequest("GET", "/test?status=interested", nil)
 		require.NoError(t, err, logTestMessage+"NewRequest error")
 		w := httptest.NewRecorder()
-		eventIdCtx := context.WithValue(context.Background(), "eventId", test.eventId)
-		r.ServeHTTP(w, req.WithContext(eventIdCtx))
+		varsCtx := context.WithValue(context.Background(), "vars", map[string]string{"id": test.eventId})
+		r.ServeHTTP(w, req.WithContext(varsCtx))
+		useCaseMock.AssertNumberOfCalls(t, "GetAttendees", 1)
 	}
 }
 
@@ -494,3 +504,137 @@ func TestIsSubscribed(t *testing.T) {
 		r.ServeHTTP(w, req)
 	}
 }
+
+var testExport = &models.UserExport{
+	User: &models.User{
+		ID:     "1",
+		ImgUrl: utils.ImagesUrl + "avatar.png",
+	},
+	CreatedEvents: []*models.Event{
+		{ID: "2", ImgUrl: utils.ImagesUrl + "missing.png"},
+	},
+	Favourites:    []*models.Event{},
+	Subscriptions: []*models.User{},
+}
+
+func TestExportUserData(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	useCaseMock.On("ExportUserData", "1").Return(testExport, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/user/export", deliveryTest.ExportUserData).Methods("GET")
+	req, err := http.NewRequest("GET", "/user/export", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), "userId", "1")))
+
+	resp := &struct {
+		Status int                           `json:"status"`
+		Body   models.UserExportResponseBody `json:"body"`
+	}{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 200, resp.Status)
+	require.Equal(t, "1", resp.Body.Profile.ID)
+	require.Equal(t, []string{testExport.User.ImgUrl, testExport.CreatedEvents[0].ImgUrl}, resp.Body.Images)
+
+	req, err = http.NewRequest("GET", "/user/export?format=xml", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), "userId", "1")))
+	errResp := &response.Response{}
+	err = json.Unmarshal(w.Body.Bytes(), errResp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, error2.ErrExportFormat.Error(), errResp.Message)
+}
+
+func TestExportUserDataZip(t *testing.T) {
+	defaultDir := utils.ImagesDir
+	defer func() { utils.ImagesDir = defaultDir }()
+	utils.ImagesDir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(utils.ImagesDir, "avatar.png"), []byte("png"), 0644))
+
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	useCaseMock.On("ExportUserData", "1").Return(testExport, nil)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/user/export", deliveryTest.ExportUserData).Methods("GET")
+	req, err := http.NewRequest("GET", "/user/export?format=zip", nil)
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), "userId", "1")))
+
+	require.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+	archive, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	require.NoError(t, err)
+	//Отсутствующей на диске обложки в архиве нет
+	require.Len(t, archive.File, 2)
+	require.Equal(t, "data.json", archive.File[0].Name)
+	require.Equal(t, "images/avatar.png", archive.File[1].Name)
+}
+
+var requestEmailChangeTests = []struct {
+	id         int
+	mail       string
+	useCaseErr error
+	status     int
+}{
+	{1, "new@mail.ru", nil, 200},
+	{2, "new@mail.ru", error2.ErrMailExists, 404},
+	{3, "not-a-mail", nil, 404},
+}
+
+func TestRequestEmailChange(t *testing.T) {
+	for _, test := range requestEmailChangeTests {
+		useCaseMock := new(usecase.UseCaseMock)
+		deliveryTest := NewDelivery(useCaseMock)
+		useCaseMock.On("RequestEmailChange", "1", test.mail).Return(&models.EmailChange{
+			OldMail: "old@mail.ru",
+			NewMail: test.mail,
+		}, test.useCaseErr)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/user/email", deliveryTest.RequestEmailChange).Methods("POST")
+		body, err := json.Marshal(&models.EmailChangeResponseBody{Mail: test.mail})
+		require.NoError(t, err, logTestMessage+"Marshal error")
+		req, err := http.NewRequest("POST", "/user/email", bytes.NewBuffer(body))
+		require.NoError(t, err, logTestMessage+"NewRequest error")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), "userId", "1")))
+
+		resp := &response.Response{}
+		err = json.Unmarshal(w.Body.Bytes(), resp)
+		require.NoError(t, err, logTestMessage+"err =", err)
+		require.Equal(t, test.status, int(resp.Status), test.id)
+	}
+}
+
+func TestConfirmEmailChange(t *testing.T) {
+	useCaseMock := new(usecase.UseCaseMock)
+	deliveryTest := NewDelivery(useCaseMock)
+	useCaseMock.On("ConfirmEmailChange", "token", "", "").Return(&models.EmailChange{}, nil)
+	useCaseMock.On("CancelEmailChange", "stale", "", "").Return(&models.EmailChange{}, error2.ErrInvalidToken)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/user/email/confirm", deliveryTest.ConfirmEmailChange).Methods("POST")
+	r.HandleFunc("/user/email/cancel", deliveryTest.CancelEmailChange).Methods("POST")
+
+	req, err := http.NewRequest("POST", "/user/email/confirm", strings.NewReader(`{"token":"token"}`))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	resp := &response.Response{}
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, 200, int(resp.Status))
+
+	req, err = http.NewRequest("POST", "/user/email/cancel", strings.NewReader(`{"token":"stale"}`))
+	require.NoError(t, err, logTestMessage+"NewRequest error")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	err = json.Unmarshal(w.Body.Bytes(), resp)
+	require.NoError(t, err, logTestMessage+"err =", err)
+	require.Equal(t, error2.ErrInvalidToken.Error(), resp.Message)
+}
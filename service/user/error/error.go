@@ -8,4 +8,12 @@ var (
 	ErrEmptyData    = errors.New("required data is empty")
 	ErrPostgres     = errors.New("internal DB server error")
 	ErrAtoi         = errors.New("cant cast string to int")
+
+	ErrExportFormat = errors.New("unsupported export format")
+
+	ErrMailExists   = errors.New("mail is already taken")
+	ErrSameMail     = errors.New("new mail matches the current one")
+	ErrInvalidToken = errors.New("token is invalid or expired")
+
+	ErrInvalidRsvpStatus = errors.New("invalid rsvp status")
 )
@@ -8,13 +8,19 @@ type UseCase interface {
 	GetUserById(userId string) (*models.User, error)
 	///////
 	UpdateUserInfo(user *models.User) error
-	UpdateUserPassword(userId string, password string) error
+	UpdateUserPassword(userId string, password string, ip string, userAgent string) error
 	///////
-	GetSubscribers(userId string) ([]*models.User, error)
-	GetSubscribes(userId string) ([]*models.User, error)
-	GetVisitors(eventId string) ([]*models.User, error)
+	GetSubscribers(userId string, page *models.Page) ([]*models.User, string, error)
+	GetSubscribes(userId string, page *models.Page) ([]*models.User, string, error)
+	GetAttendees(eventId string, status string, page *models.Page) ([]*models.User, string, error)
 	///////
 	Subscribe(subscribedId string, subscriberId string) error
 	Unsubscribe(subscribedId string, subscriberId string) error
 	IsSubscribed(subscribedId string, subscriberId string) (bool, error)
+	///////
+	ExportUserData(userId string) (*models.UserExport, error)
+	///////
+	RequestEmailChange(userId string, mail string) (*models.EmailChange, error)
+	ConfirmEmailChange(token string, ip string, userAgent string) (*models.EmailChange, error)
+	CancelEmailChange(token string, ip string, userAgent string) (*models.EmailChange, error)
 }
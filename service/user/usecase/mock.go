@@ -19,24 +19,24 @@ func (m *UseCaseMock) UpdateUserInfo(user *models.User) error {
 	return args.Error(0)
 }
 
-func (m *UseCaseMock) UpdateUserPassword(userId string, password string) error {
-	args := m.Called(userId, password)
+func (m *UseCaseMock) UpdateUserPassword(userId string, password string, ip string, userAgent string) error {
+	args := m.Called(userId, password, ip, userAgent)
 	return args.Error(0)
 }
 
-func (m *UseCaseMock) GetSubscribers(userId string) ([]*models.User, error) {
-	args := m.Called(userId)
-	return args.Get(0).([]*models.User), args.Error(1)
+func (m *UseCaseMock) GetSubscribers(userId string, page *models.Page) ([]*models.User, string, error) {
+	args := m.Called(userId, page)
+	return args.Get(0).([]*models.User), args.String(1), args.Error(2)
 }
 
-func (m *UseCaseMock) GetSubscribes(userId string) ([]*models.User, error) {
-	args := m.Called(userId)
-	return args.Get(0).([]*models.User), args.Error(1)
+func (m *UseCaseMock) GetSubscribes(userId string, page *models.Page) ([]*models.User, string, error) {
+	args := m.Called(userId, page)
+	return args.Get(0).([]*models.User), args.String(1), args.Error(2)
 }
 
-func (m *UseCaseMock) GetVisitors(eventId string) ([]*models.User, error) {
-	args := m.Called(eventId)
-	return args.Get(0).([]*models.User), args.Error(1)
+func (m *UseCaseMock) GetAttendees(eventId string, status string, page *models.Page) ([]*models.User, string, error) {
+	args := m.Called(eventId, status, page)
+	return args.Get(0).([]*models.User), args.String(1), args.Error(2)
 }
 
 func (m *UseCaseMock) Subscribe(subscribedId string, subscriberId string) error {
@@ -53,3 +53,23 @@ func (m *UseCaseMock) IsSubscribed(subscribedId string, subscriberId string) (bo
 	args := m.Called(subscribedId, subscriberId)
 	return args.Get(0).(bool), args.Error(1)
 }
+
+func (m *UseCaseMock) ExportUserData(userId string) (*models.UserExport, error) {
+	args := m.Called(userId)
+	return args.Get(0).(*models.UserExport), args.Error(1)
+}
+
+func (m *UseCaseMock) RequestEmailChange(userId string, mail string) (*models.EmailChange, error) {
+	args := m.Called(userId, mail)
+	return args.Get(0).(*models.EmailChange), args.Error(1)
+}
+
+func (m *UseCaseMock) ConfirmEmailChange(token string, ip string, userAgent string) (*models.EmailChange, error) {
+	args := m.Called(token, ip, userAgent)
+	return args.Get(0).(*models.EmailChange), args.Error(1)
+}
+
+func (m *UseCaseMock) CancelEmailChange(token string, ip string, userAgent string) (*models.EmailChange, error) {
+	args := m.Called(token, ip, userAgent)
+	return args.Get(0).(*models.EmailChange), args.Error(1)
+}
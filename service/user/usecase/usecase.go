@@ -1,9 +1,11 @@
 package usecase
 
 import (
+	eventProto "backend/microservice/event/proto"
 	proto "backend/microservice/user/proto"
 	"backend/pkg/models"
 	"backend/pkg/utils"
+	eventUseCase "backend/service/event/usecase"
 	error2 "backend/service/user/error"
 	"context"
 )
@@ -12,13 +14,14 @@ const logMessage = "service:user:usecase:"
 
 type UseCase struct {
 	//UserRepositoryClient - это интерфейс, поэтому можно замокать
-	userRepo proto.RepositoryClient
-	//Потом будет eventRepo
+	userRepo  proto.RepositoryClient
+	eventRepo eventProto.RepositoryClient
 }
 
-func NewUseCase(userRepo proto.RepositoryClient) *UseCase {
+func NewUseCase(userRepo proto.RepositoryClient, eventRepo eventProto.RepositoryClient) *UseCase {
 	return &UseCase{
-		userRepo: userRepo,
+		userRepo:  userRepo,
+		eventRepo: eventRepo,
 	}
 }
 
@@ -36,13 +39,15 @@ func MakeProtoUser(u *models.User) *proto.User {
 
 func MakeModelUser(u *proto.User) *models.User {
 	return &models.User{
-		ID:       u.ID,
-		Name:     u.Name,
-		Surname:  u.Surname,
-		Mail:     u.Mail,
-		Password: u.Password,
-		About:    u.About,
-		ImgUrl:   u.ImgUrl,
+		ID:          u.ID,
+		Name:        u.Name,
+		Surname:     u.Surname,
+		Mail:        u.Mail,
+		Password:    u.Password,
+		About:       u.About,
+		ImgUrl:      u.ImgUrl,
+		Reputation:  u.Reputation,
+		ReviewCount: int(u.ReviewCount),
 	}
 }
 
@@ -69,74 +74,88 @@ func (a *UseCase) UpdateUserInfo(u *models.User) error {
 	return err
 }
 
-func (a *UseCase) UpdateUserPassword(userId string, password string) error {
+func (a *UseCase) UpdateUserPassword(userId string, password string, ip string, userAgent string) error {
 	if userId == "" || password == "" {
 		return error2.ErrEmptyData
 	}
-	hashedPassword := utils.CreatePasswordHash(password)
+	hashedPassword, err := utils.CreatePasswordHash(password)
+	if err != nil {
+		return err
+	}
 	in := &proto.UpdateUserPasswordRequest{
 		ID:       userId,
 		Password: hashedPassword,
 	}
-	_, err := a.userRepo.UpdateUserPassword(context.Background(), in)
+	_, err = a.userRepo.UpdateUserPassword(utils.ClientContext(context.Background(), ip, userAgent), in)
 	return err
 }
 
-func (a *UseCase) GetSubscribers(userId string) ([]*models.User, error) {
-	if userId == "" {
-		return nil, error2.ErrEmptyData
+func makeUsersRequest(id string, page *models.Page) *proto.UsersRequest {
+	in := &proto.UsersRequest{
+		ID: id,
 	}
-	in := &proto.UserId{
-		ID: userId,
-	}
-	out, err := a.userRepo.GetSubscribers(context.Background(), in)
-	if err != nil {
-		return nil, err
+	if page != nil {
+		in.Limit = int32(page.Limit)
+		in.Cursor = page.Cursor
 	}
+	return in
+}
+
+func makeModelUsers(out *proto.Users) []*models.User {
 	result := make([]*models.User, len(out.Users))
 	for i, protoUser := range out.Users {
 		result[i] = MakeModelUser(protoUser)
 		result[i].Password = ""
 	}
-	return result, nil
+	return result
 }
 
-func (a *UseCase) GetSubscribes(userId string) ([]*models.User, error) {
+func (a *UseCase) GetSubscribers(userId string, page *models.Page) ([]*models.User, string, error) {
 	if userId == "" {
-		return nil, error2.ErrEmptyData
-	}
-	in := &proto.UserId{
-		ID: userId,
+		return nil, "", error2.ErrEmptyData
 	}
-	out, err := a.userRepo.GetSubscribes(context.Background(), in)
+	out, err := a.userRepo.GetSubscribers(context.Background(), makeUsersRequest(userId, page))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	result := make([]*models.User, len(out.Users))
-	for i, protoUser := range out.Users {
-		result[i] = MakeModelUser(protoUser)
-		result[i].Password = ""
+	return makeModelUsers(out), out.NextCursor, nil
+}
+
+func (a *UseCase) GetSubscribes(userId string, page *models.Page) ([]*models.User, string, error) {
+	if userId == "" {
+		return nil, "", error2.ErrEmptyData
 	}
-	return result, nil
+	out, err := a.userRepo.GetSubscribes(context.Background(), makeUsersRequest(userId, page))
+	if err != nil {
+		return nil, "", err
+	}
+	return makeModelUsers(out), out.NextCursor, nil
 }
 
-func (a *UseCase) GetVisitors(eventId string) ([]*models.User, error) {
+//Без status - те, кто точно пойдёт
+func (a *UseCase) GetAttendees(eventId string, status string, page *models.Page) ([]*models.User, string, error) {
 	if eventId == "" {
-		return nil, error2.ErrEmptyData
+		return nil, "", error2.ErrEmptyData
 	}
-	in := &proto.EventId{
-		ID: eventId,
+	if status == "" {
+		status = models.RsvpGoing
 	}
-	out, err := a.userRepo.GetVisitors(context.Background(), in)
-	if err != nil {
-		return nil, err
+	if !models.IsValidRsvpStatus(status) {
+		return nil, "", error2.ErrInvalidRsvpStatus
 	}
-	result := make([]*models.User, len(out.Users))
-	for i, protoUser := range out.Users {
-		result[i] = MakeModelUser(protoUser)
-		result[i].Password = ""
+	in := &proto.AttendeesRequest{
+		EventId: eventId,
+		Status:  status,
+	}
+	if page != nil {
+		in.Limit = int32(page.Limit)
+		in.Cursor = page.Cursor
+	}
+	out, err := a.userRepo.GetAttendees(context.Background(), in)
+	if err != nil {
+		return nil, "", err
 	}
-	return result, nil
+	return makeModelUsers(out), out.NextCursor, nil
 }
 
 func (a *UseCase) Subscribe(subscribedId string, subscriberId string) error {
@@ -178,3 +197,109 @@ func (a *UseCase) IsSubscribed(subscribedId string, subscriberId string) (bool,
 	result := out.Result
 	return result, err
 }
+
+//Выгрузка содержит списки целиком, поэтому страницы запрашиваются до последней
+func (a *UseCase) allVisitedEvents(userId string) ([]*models.Event, error) {
+	var result []*models.Event
+	page := &models.Page{Limit: utils.MaxPageLimit}
+	for {
+		out, err := a.eventRepo.GetVisitedEvents(context.Background(), eventUseCase.MakeUserEventsRequest(userId, page))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, eventUseCase.MakeModelEvents(out)...)
+		if out.NextCursor == "" {
+			return result, nil
+		}
+		page = &models.Page{Limit: utils.MaxPageLimit, Cursor: out.NextCursor}
+	}
+}
+
+func (a *UseCase) allSubscribes(userId string) ([]*models.User, error) {
+	var result []*models.User
+	page := &models.Page{Limit: utils.MaxPageLimit}
+	for {
+		users, nextCursor, err := a.GetSubscribes(userId, page)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, users...)
+		if nextCursor == "" {
+			return result, nil
+		}
+		page = &models.Page{Limit: utils.MaxPageLimit, Cursor: nextCursor}
+	}
+}
+
+func (a *UseCase) ExportUserData(userId string) (*models.UserExport, error) {
+	u, err := a.GetUserById(userId)
+	if err != nil {
+		return nil, err
+	}
+	in := &eventProto.UserId{ID: userId}
+	createdEvents, err := a.eventRepo.GetAuthorEvents(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	favourites, err := a.allVisitedEvents(userId)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := a.allSubscribes(userId)
+	if err != nil {
+		return nil, err
+	}
+	return &models.UserExport{
+		User:          u,
+		CreatedEvents: eventUseCase.MakeModelEvents(createdEvents),
+		Favourites:    favourites,
+		Subscriptions: subscriptions,
+	}, nil
+}
+
+func makeModelEmailChange(out *proto.EmailChange) *models.EmailChange {
+	return &models.EmailChange{
+		UserId:      out.UserId,
+		OldMail:     out.OldMail,
+		NewMail:     out.NewMail,
+		Token:       out.Token,
+		CancelToken: out.CancelToken,
+	}
+}
+
+func (a *UseCase) RequestEmailChange(userId string, mail string) (*models.EmailChange, error) {
+	if userId == "" || mail == "" {
+		return nil, error2.ErrEmptyData
+	}
+	in := &proto.EmailChangeRequest{
+		UserId: userId,
+		Mail:   mail,
+	}
+	out, err := a.userRepo.RequestEmailChange(context.Background(), in)
+	if err != nil {
+		return nil, err
+	}
+	return makeModelEmailChange(out), nil
+}
+
+func (a *UseCase) ConfirmEmailChange(token string, ip string, userAgent string) (*models.EmailChange, error) {
+	if token == "" {
+		return nil, error2.ErrEmptyData
+	}
+	out, err := a.userRepo.ConfirmEmailChange(utils.ClientContext(context.Background(), ip, userAgent), &proto.EmailChangeToken{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	return makeModelEmailChange(out), nil
+}
+
+func (a *UseCase) CancelEmailChange(token string, ip string, userAgent string) (*models.EmailChange, error) {
+	if token == "" {
+		return nil, error2.ErrEmptyData
+	}
+	out, err := a.userRepo.CancelEmailChange(utils.ClientContext(context.Background(), ip, userAgent), &proto.EmailChangeToken{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	return makeModelEmailChange(out), nil
+}
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	eventGrpc "backend/microservice/event/proto"
+	eventRepository "backend/microservice/event/repository"
 	userGrpc "backend/microservice/user/proto"
 	"backend/pkg/models"
 	"backend/pkg/utils"
@@ -10,6 +12,7 @@ import (
 
 	//"backend/pkg/utils"
 	"backend/microservice/user/repository"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"strconv"
 	"testing"
@@ -46,7 +49,7 @@ var getUserByIdTests = []struct {
 func TestGetUserById(t *testing.T) {
 	for _, test := range getUserByIdTests {
 		repositoryMock := new(repository.RepositoryClientMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, nil)
 		in := &userGrpc.UserId{
 			ID: test.input,
 		}
@@ -90,7 +93,7 @@ var updateUserInfoTests = []struct {
 func TestUpdateUserInfo(t *testing.T) {
 	for _, test := range updateUserInfoTests {
 		repositoryMock := new(repository.RepositoryClientMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, nil)
 		in := MakeProtoUser(test.input)
 		repositoryMock.On("UpdateUserInfo", context.Background(), in).Return(&userGrpc.Empty{}, test.outputErr)
 		actualErr := useCaseTest.UpdateUserInfo(test.input)
@@ -127,13 +130,13 @@ var updateUserPasswordTests = []struct {
 func TestUpdateUserPassword(t *testing.T) {
 	for _, test := range updateUserPasswordTests {
 		repositoryMock := new(repository.RepositoryClientMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		in := &userGrpc.UpdateUserPasswordRequest{
-			ID:       test.userId,
-			Password: utils.CreatePasswordHash(test.password),
-		}
+		useCaseTest := NewUseCase(repositoryMock, nil)
+		in := mock.MatchedBy(func(in *userGrpc.UpdateUserPasswordRequest) bool {
+			match, _ := utils.CheckPasswordHash(test.password, in.Password)
+			return in.ID == test.userId && match
+		})
 		repositoryMock.On("UpdateUserPassword", context.Background(), in).Return(&userGrpc.Empty{}, test.outputErr)
-		actualErr := useCaseTest.UpdateUserPassword(test.userId, test.password)
+		actualErr := useCaseTest.UpdateUserPassword(test.userId, test.password, "", "")
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 	}
 }
@@ -167,12 +170,12 @@ var getSubscribersTests = []struct {
 func TestGetSubscribers(t *testing.T) {
 	for _, test := range getSubscribersTests {
 		repositoryMock := new(repository.RepositoryClientMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		in := &userGrpc.UserId{
+		useCaseTest := NewUseCase(repositoryMock, nil)
+		in := &userGrpc.UsersRequest{
 			ID: test.userId,
 		}
 		repositoryMock.On("GetSubscribers", context.Background(), in).Return(&userGrpc.Users{}, test.outputErr)
-		actualRes, actualErr := useCaseTest.GetSubscribers(test.userId)
+		actualRes, _, actualErr := useCaseTest.GetSubscribers(test.userId, nil)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 	}
@@ -207,18 +210,18 @@ var getSubscribesTests = []struct {
 func TestGetSubscribes(t *testing.T) {
 	for _, test := range getSubscribesTests {
 		repositoryMock := new(repository.RepositoryClientMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		in := &userGrpc.UserId{
+		useCaseTest := NewUseCase(repositoryMock, nil)
+		in := &userGrpc.UsersRequest{
 			ID: test.userId,
 		}
 		repositoryMock.On("GetSubscribes", context.Background(), in).Return(&userGrpc.Users{}, test.outputErr)
-		actualRes, actualErr := useCaseTest.GetSubscribes(test.userId)
+		actualRes, _, actualErr := useCaseTest.GetSubscribes(test.userId, nil)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 	}
 }
 
-var getVisitorsTests = []struct {
+var getAttendeesTests = []struct {
 	id        int
 	eventId   string
 	outputErr error
@@ -244,20 +247,34 @@ var getVisitorsTests = []struct {
 	},
 }
 
-func TestGetVisitors(t *testing.T) {
-	for _, test := range getVisitorsTests {
+func TestGetAttendees(t *testing.T) {
+	for _, test := range getAttendeesTests {
 		repositoryMock := new(repository.RepositoryClientMock)
-		useCaseTest := NewUseCase(repositoryMock)
-		in := &userGrpc.EventId{
-			ID: test.eventId,
+		useCaseTest := NewUseCase(repositoryMock, nil)
+		in := &userGrpc.AttendeesRequest{
+			EventId: test.eventId,
+			Status:  models.RsvpGoing,
+			Limit:   5,
+			Cursor:  "cursor",
 		}
-		repositoryMock.On("GetVisitors", context.Background(), in).Return(&userGrpc.Users{}, test.outputErr)
-		actualRes, actualErr := useCaseTest.GetVisitors(test.eventId)
+		repositoryMock.On("GetAttendees", context.Background(), in).Return(&userGrpc.Users{NextCursor: "next"}, test.outputErr)
+		actualRes, nextCursor, actualErr := useCaseTest.GetAttendees(test.eventId, "", &models.Page{Limit: 5, Cursor: "cursor"})
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 		require.Equal(t, test.outputRes, actualRes, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
+		if actualErr == nil {
+			require.Equal(t, "next", nextCursor)
+		}
 	}
 }
 
+func TestGetAttendeesInvalidStatus(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock, nil)
+	_, _, err := useCaseTest.GetAttendees("1", "maybe", nil)
+	require.Equal(t, error2.ErrInvalidRsvpStatus, err)
+	repositoryMock.AssertNumberOfCalls(t, "GetAttendees", 0)
+}
+
 var subscribeTests = []struct {
 	id           int
 	subscribedId string
@@ -287,7 +304,7 @@ var subscribeTests = []struct {
 func TestSubscribe(t *testing.T) {
 	for _, test := range subscribeTests {
 		repositoryMock := new(repository.RepositoryClientMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, nil)
 		in := &userGrpc.SubscribeRequest{
 			SubscribedId: test.subscribedId,
 			SubscriberId: test.subscriberId,
@@ -327,7 +344,7 @@ var unsubscribeTests = []struct {
 func TestUnsubscribe(t *testing.T) {
 	for _, test := range unsubscribeTests {
 		repositoryMock := new(repository.RepositoryClientMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, nil)
 		in := &userGrpc.SubscribeRequest{
 			SubscribedId: test.subscribedId,
 			SubscriberId: test.subscriberId,
@@ -378,7 +395,7 @@ var isSubscribedTests = []struct {
 func TestIsSubscribed(t *testing.T) {
 	for _, test := range isSubscribedTests {
 		repositoryMock := new(repository.RepositoryClientMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, nil)
 		in := &userGrpc.SubscribeRequest{
 			SubscribedId: test.subscribedId,
 			SubscriberId: test.subscriberId,
@@ -424,7 +441,7 @@ var updateUserInfoTests = []struct {
 func TestUpdateUserInfo(t *testing.T) {
 	for _, test := range updateUserInfoTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, nil)
 		repositoryMock.On("UpdateUserInfo", test.user).Return(test.outputErr)
 		actualErr := useCaseTest.UpdateUserInfo(test.user)
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
@@ -454,12 +471,85 @@ var updateUserPasswordTests = []struct {
 func TestUpdateUserPassword(t *testing.T) {
 	for _, test := range updateUserPasswordTests {
 		repositoryMock := new(mock.RepositoryMock)
-		useCaseTest := NewUseCase(repositoryMock)
+		useCaseTest := NewUseCase(repositoryMock, nil)
 		hashedPassword := utils.CreatePasswordHash(test.password)
 		repositoryMock.On("UpdateUserPassword", test.userId, hashedPassword).Return(test.outputErr)
-		actualErr := useCaseTest.UpdateUserPassword(test.userId, test.password)
+		actualErr := useCaseTest.UpdateUserPassword(test.userId, test.password, "", "")
 		require.Equal(t, test.outputErr, actualErr, logTestMessage+" "+strconv.Itoa(test.id)+" "+"error")
 	}
 }
 
 */
+
+func TestExportUserData(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	eventRepositoryMock := new(eventRepository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock, eventRepositoryMock)
+
+	userIn := &userGrpc.UserId{ID: "1"}
+	eventIn := &eventGrpc.UserId{ID: "1"}
+	repositoryMock.On("GetUserById", context.Background(), userIn).Return(&userGrpc.User{ID: "1", Password: "hash"}, nil)
+	eventRepositoryMock.On("GetAuthorEvents", context.Background(), eventIn).Return(&eventGrpc.Events{
+		Events: []*eventGrpc.Event{{ID: "2", ImgUrl: "event.png"}},
+	}, nil)
+	visitedIn := &eventGrpc.UserEventsRequest{UserId: "1", Limit: utils.MaxPageLimit}
+	eventRepositoryMock.On("GetVisitedEvents", context.Background(), visitedIn).Return(&eventGrpc.Events{}, nil)
+	//Подписки не уместились в одну страницу - выгрузка запрашивает следующую
+	subscribesIn := &userGrpc.UsersRequest{ID: "1", Limit: utils.MaxPageLimit}
+	repositoryMock.On("GetSubscribes", context.Background(), subscribesIn).Return(&userGrpc.Users{
+		Users:      []*userGrpc.User{{ID: "3", Password: "hash"}},
+		NextCursor: "next",
+	}, nil)
+	subscribesNextIn := &userGrpc.UsersRequest{ID: "1", Limit: utils.MaxPageLimit, Cursor: "next"}
+	repositoryMock.On("GetSubscribes", context.Background(), subscribesNextIn).Return(&userGrpc.Users{
+		Users: []*userGrpc.User{{ID: "2", Password: "hash"}},
+	}, nil)
+
+	export, err := useCaseTest.ExportUserData("1")
+	require.NoError(t, err)
+	require.Equal(t, "1", export.User.ID)
+	require.Equal(t, "", export.User.Password)
+	require.Len(t, export.CreatedEvents, 1)
+	require.Equal(t, "event.png", export.CreatedEvents[0].ImgUrl)
+	require.Len(t, export.Favourites, 0)
+	require.Len(t, export.Subscriptions, 2)
+	require.Equal(t, "", export.Subscriptions[0].Password)
+
+	_, err = useCaseTest.ExportUserData("")
+	require.Equal(t, error2.ErrEmptyData, err)
+}
+
+func TestRequestEmailChange(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock, nil)
+	in := &userGrpc.EmailChangeRequest{
+		UserId: "1",
+		Mail:   "new@mail.ru",
+	}
+	repositoryMock.On("RequestEmailChange", context.Background(), in).Return(&userGrpc.EmailChange{
+		UserId:      "1",
+		OldMail:     "old@mail.ru",
+		NewMail:     "new@mail.ru",
+		Token:       "token",
+		CancelToken: "cancel",
+	}, nil)
+	change, err := useCaseTest.RequestEmailChange("1", "new@mail.ru")
+	require.NoError(t, err)
+	require.Equal(t, "old@mail.ru", change.OldMail)
+	require.Equal(t, "cancel", change.CancelToken)
+
+	_, err = useCaseTest.RequestEmailChange("1", "")
+	require.Equal(t, error2.ErrEmptyData, err)
+}
+
+func TestConfirmEmailChange(t *testing.T) {
+	repositoryMock := new(repository.RepositoryClientMock)
+	useCaseTest := NewUseCase(repositoryMock, nil)
+	in := &userGrpc.EmailChangeToken{Token: "token"}
+	repositoryMock.On("ConfirmEmailChange", context.Background(), in).Return(&userGrpc.EmailChange{}, error2.ErrMailExists)
+	_, err := useCaseTest.ConfirmEmailChange("token", "", "")
+	require.Equal(t, error2.ErrMailExists, err)
+
+	_, err = useCaseTest.CancelEmailChange("", "", "")
+	require.Equal(t, error2.ErrEmptyData, err)
+}